@@ -0,0 +1,90 @@
+// Command clickstack-export writes Terraform configuration for the dashboards,
+// saved searches, alerts and webhooks that already exist in a ClickStack team,
+// so that objects built in the UI can be brought under Terraform. It writes one
+// .tf file per resource type plus imports.tf, whose import blocks adopt the
+// existing objects on the next `terraform apply`; review the output with
+// `terraform plan` first.
+//
+// It reads the same environment variables as the provider:
+//   - self-hosted ClickStack: CLICKSTACK_ENDPOINT and CLICKSTACK_API_KEY;
+//   - ClickStack on ClickHouse Cloud: CLICKSTACK_SERVICE_ID, CLICKHOUSE_ORG_ID
+//     and CLICKHOUSE_CLOUD_API_KEY / CLICKHOUSE_CLOUD_API_SECRET (and
+//     optionally CLICKHOUSE_API_URL).
+//
+// Usage:
+//
+//	go run ./cmd/clickstack-export -out ./clickstack [-team <team id>]
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "clickstack-export:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	out := flag.String("out", ".", "directory to write the .tf files to; existing files of the same name are overwritten")
+	team := flag.String("team", "", "team ID to export (multi-team deployments); defaults to the API key's team")
+	flag.Parse()
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	files, err := clickstack.GenerateConfig(context.Background(), c, *team)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(os.Stderr, "clickstack-export: nothing to export")
+		return nil
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		path := filepath.Join(*out, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil { //nolint:gosec // generated configuration is not secret
+			return err
+		}
+		fmt.Println(path)
+	}
+	return nil
+}
+
+// newClient builds a ClickStack client from the provider's environment
+// variables.
+func newClient() (*client.Client, error) {
+	if serviceID := os.Getenv("CLICKSTACK_SERVICE_ID"); serviceID != "" {
+		return client.NewCloud(
+			cmp.Or(os.Getenv("CLICKHOUSE_API_URL"), "https://api.clickhouse.cloud/v1"),
+			os.Getenv("CLICKHOUSE_ORG_ID"),
+			serviceID,
+			cmp.Or(os.Getenv("CLICKHOUSE_CLOUD_API_KEY"), os.Getenv("CLICKHOUSE_TOKEN_KEY")),
+			cmp.Or(os.Getenv("CLICKHOUSE_CLOUD_API_SECRET"), os.Getenv("CLICKHOUSE_TOKEN_SECRET")),
+			nil,
+		)
+	}
+	endpoint, apiKey := os.Getenv("CLICKSTACK_ENDPOINT"), os.Getenv("CLICKSTACK_API_KEY")
+	if endpoint == "" || apiKey == "" {
+		return nil, errors.New("set CLICKSTACK_ENDPOINT and CLICKSTACK_API_KEY, or CLICKSTACK_SERVICE_ID with the ClickHouse Cloud credentials")
+	}
+	return client.New(endpoint, apiKey, nil)
+}
@@ -0,0 +1,9 @@
+# Look up the connection a source should query through. The password is never
+# returned.
+data "clickhouse_clickstack_connection" "default" {
+  name = "Default"
+}
+
+output "connection_host" {
+  value = data.clickhouse_clickstack_connection.default.host
+}
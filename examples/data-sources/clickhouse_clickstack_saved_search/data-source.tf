@@ -0,0 +1,17 @@
+# Alert on a saved search that is managed elsewhere.
+data "clickhouse_clickstack_saved_search" "errors" {
+  name = "Production errors"
+}
+
+resource "clickhouse_clickstack_alert" "errors" {
+  saved_search_id = data.clickhouse_clickstack_saved_search.errors.id
+
+  channel = {
+    type       = "webhook"
+    webhook_id = data.clickhouse_clickstack_webhook.oncall.id
+  }
+
+  threshold      = 10
+  threshold_type = "above"
+  interval       = "5m"
+}
@@ -0,0 +1,9 @@
+# Look up a source created in the UI or another configuration by name, and use
+# its ID in a saved search or dashboard tile.
+data "clickhouse_clickstack_source" "logs" {
+  name = "Logs"
+}
+
+output "logs_source_id" {
+  value = data.clickhouse_clickstack_source.logs.id
+}
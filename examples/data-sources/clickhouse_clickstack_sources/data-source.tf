@@ -0,0 +1,8 @@
+# List every metric source of the team.
+data "clickhouse_clickstack_sources" "metrics" {
+  kind = "metric"
+}
+
+output "metric_source_ids" {
+  value = data.clickhouse_clickstack_sources.metrics.sources[*].id
+}
@@ -0,0 +1,6 @@
+# Read the settings of the API key's team.
+data "clickhouse_clickstack_team" "current" {}
+
+output "default_user_role_id" {
+  value = data.clickhouse_clickstack_team.current.default_user_role_id
+}
@@ -0,0 +1,6 @@
+# List the team's members and the email addresses of its admins.
+data "clickhouse_clickstack_team_members" "all" {}
+
+output "admin_emails" {
+  value = [for m in data.clickhouse_clickstack_team_members.all.members : m.email if m.role_name == "Admin"]
+}
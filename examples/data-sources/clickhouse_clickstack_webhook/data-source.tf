@@ -0,0 +1,6 @@
+# Webhook names are unique per service, so pass the service as well when
+# looking one up by name.
+data "clickhouse_clickstack_webhook" "oncall" {
+  name    = "oncall"
+  service = "slack"
+}
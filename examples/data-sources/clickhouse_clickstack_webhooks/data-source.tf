@@ -0,0 +1,8 @@
+# List all generic webhooks of the team. URLs are not part of the list.
+data "clickhouse_clickstack_webhooks" "generic" {
+  service = "generic"
+}
+
+output "generic_webhook_names" {
+  value = data.clickhouse_clickstack_webhooks.generic.webhooks[*].name
+}
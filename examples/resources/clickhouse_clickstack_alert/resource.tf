@@ -38,3 +38,20 @@ resource "clickhouse_clickstack_alert" "latency_band" {
 
   num_consecutive_windows = 2
 }
+
+# An alert on a dashboard tile, named by the tile's name. tile_id is resolved
+# on every plan, so the alert follows the tile when a dashboard edit gives it a
+# new id. The tile name must be unique on the dashboard.
+resource "clickhouse_clickstack_alert" "checkout_errors" {
+  dashboard_id = clickhouse_clickstack_dashboard.checkout.id
+  tile_name    = "Errors by severity"
+
+  channel = {
+    type       = "webhook"
+    webhook_id = clickhouse_clickstack_webhook.slack.id
+  }
+
+  threshold      = 50
+  threshold_type = "above"
+  interval       = "5m"
+}
@@ -0,0 +1,17 @@
+# Silence the alerts of every saved search tagged "database" during a planned
+# upgrade. The window is applied by the first apply after start_time, and the
+# silences expire on their own at end_time.
+resource "clickhouse_clickstack_alert_silence" "db_upgrade" {
+  saved_search_tags = ["database"]
+
+  start_time = "2026-11-01T02:00:00Z"
+  end_time   = "2026-11-01T06:00:00Z"
+  reason     = "ClickHouse 26.11 upgrade"
+}
+
+# Silence specific alerts from now until end_time.
+resource "clickhouse_clickstack_alert_silence" "checkout" {
+  alert_ids = [clickhouse_clickstack_alert.checkout_errors.id]
+  end_time  = "2026-10-20T08:00:00Z"
+  reason    = "Checkout service migration"
+}
@@ -0,0 +1,6 @@
+# API keys can be imported by the ID of the virtual member holding them.
+terraform import clickhouse_clickstack_api_key.otel_collector 65f0c0ffeecafef00dba5e10
+
+# For a key in a non-default team (multi-team / EE deployments), prefix the ID
+# with the team ID:
+terraform import clickhouse_clickstack_api_key.otel_collector 65f0c0ffeecafef00dba5e01/65f0c0ffeecafef00dba5e10
@@ -0,0 +1,25 @@
+# A service API key for an OpenTelemetry collector, with its own role. The key
+# never enters state: the ephemeral resource reads it at apply time and hands
+# it to a write-only argument.
+data "clickhouse_clickstack_role" "ingest" {
+  name = "Ingest"
+}
+
+resource "clickhouse_clickstack_api_key" "otel_collector" {
+  name    = "otel-collector"
+  role_id = data.clickhouse_clickstack_role.ingest.id
+}
+
+ephemeral "clickhouse_clickstack_api_key" "otel_collector" {
+  id = clickhouse_clickstack_api_key.otel_collector.id
+}
+
+resource "aws_secretsmanager_secret" "otel_collector" {
+  name = "clickstack/otel-collector"
+}
+
+resource "aws_secretsmanager_secret_version" "otel_collector" {
+  secret_id                = aws_secretsmanager_secret.otel_collector.id
+  secret_string_wo         = ephemeral.clickhouse_clickstack_api_key.otel_collector.key
+  secret_string_wo_version = 1
+}
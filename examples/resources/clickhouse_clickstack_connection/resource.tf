@@ -8,6 +8,24 @@ resource "clickhouse_clickstack_connection" "main" {
   # prometheus_endpoint = "http://prometheus:9090"
 }
 
+# Credentials taken from a clickhouse_service, so both rotate together: the
+# password is write-only (never stored in state) and is re-sent whenever
+# password_wo_version changes. Requires Terraform >= 1.11.
+resource "clickhouse_service" "observability" {
+  # ...
+  password_wo         = var.clickhouse_password
+  password_wo_version = var.clickhouse_password_version
+}
+
+resource "clickhouse_clickstack_connection" "observability" {
+  name     = "Observability service"
+  host     = "https://${clickhouse_service.observability.endpoints.https.host}:${clickhouse_service.observability.endpoints.https.port}"
+  username = "default"
+
+  password_wo         = var.clickhouse_password
+  password_wo_version = clickhouse_service.observability.password_wo_version
+}
+
 # Managing connections across multiple teams from one configuration. (Enterprise Only)
 #
 # A single provider (one API key) can manage connections in every team the key
@@ -40,5 +40,60 @@ resource "clickhouse_clickstack_dashboard" "collectors" {
   })
 }
 
+# The same kind of dashboard written with typed blocks instead of JSON. Each
+# tile is planned on its own, and geometry is checked at plan time.
+resource "clickhouse_clickstack_dashboard" "checkout" {
+  name = "Checkout"
+  tags = ["otel"]
+
+  variable {
+    name  = "service"
+    value = "checkout"
+  }
+
+  filter {
+    name       = "Severity"
+    expression = "SeverityText"
+    source_id  = var.logs_source_id
+  }
+
+  tile {
+    name         = "Errors by severity"
+    x            = 0
+    y            = 0
+    w            = 12
+    h            = 4
+    display_type = "stacked_bar"
+    source_id    = var.logs_source_id
+    where        = "ServiceName = '{{service}}'"
+    group_by     = "SeverityText"
+
+    select {
+      agg_fn = "count"
+      alias  = "Logs"
+    }
+  }
+
+  tile {
+    name         = "p95 request duration"
+    x            = 12
+    y            = 0
+    w            = 12
+    h            = 4
+    display_type = "line"
+    source_id    = var.traces_source_id
+    where        = "ServiceName = '{{service}}'"
+
+    select {
+      agg_fn           = "quantile"
+      level            = 0.95
+      value_expression = "Duration / 1e6"
+      alias            = "p95 (ms)"
+    }
+  }
+}
+
 variable "metrics_source_id" { type = string }
+variable "logs_source_id" { type = string }
+variable "traces_source_id" { type = string }
 variable "connection_id" { type = string }
@@ -24,6 +24,9 @@ resource "clickhouse_clickstack_source" "logs" {
   body_expression                = "Body"
   resource_attributes_expression = "ResourceAttributes"
   event_attributes_expression    = "LogAttributes"
+
+  # Check at plan time that the expressions above name columns of otel.otel_logs.
+  validate_columns = true
 }
 
 # A trace source correlated with the logs above.
@@ -71,3 +74,19 @@ resource "clickhouse_clickstack_source" "metrics" {
     histogram = "otel_metrics_histogram"
   }
 }
+
+# A log source over the default OTel collector schema. The preset fills in the
+# timestamp and every expression; attributes set here override it.
+resource "clickhouse_clickstack_source" "app_logs" {
+  name          = "App logs"
+  kind          = "log"
+  preset        = "otel_logs"
+  connection_id = clickhouse_clickstack_connection.main.id
+
+  from = {
+    database_name = "app"
+    table_name    = "otel_logs"
+  }
+
+  default_table_select_expression = "Timestamp, ServiceName, Body"
+}
@@ -0,0 +1,6 @@
+# A source set is imported by the IDs of its logs, traces, metrics and sessions
+# sources, comma-separated in that order.
+terraform import clickhouse_clickstack_source_set.otel 507f1f77bcf86cd799439011,507f1f77bcf86cd799439012,507f1f77bcf86cd799439013,507f1f77bcf86cd799439014
+
+# For sources in a non-default team, prefix the IDs with the team ID.
+terraform import clickhouse_clickstack_source_set.otel 65f0c0ffeecafef00dba5e01/507f1f77bcf86cd799439011,507f1f77bcf86cd799439012,507f1f77bcf86cd799439013,507f1f77bcf86cd799439014
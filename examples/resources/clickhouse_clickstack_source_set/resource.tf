@@ -0,0 +1,11 @@
+# Logs, traces, metrics and sessions sources over the tables the default OTel
+# collector exporter writes to the "otel" database, linked to each other.
+resource "clickhouse_clickstack_source_set" "otel" {
+  connection_id = clickhouse_clickstack_connection.main.id
+  database_name = "otel"
+  name_prefix   = "Prod "
+}
+
+output "logs_source_id" {
+  value = clickhouse_clickstack_source_set.otel.log_source_id
+}
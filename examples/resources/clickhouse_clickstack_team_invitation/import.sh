@@ -0,0 +1,6 @@
+# Pending invitations can be imported by the invitee's email address.
+terraform import clickhouse_clickstack_team_invitation.bob bob@example.com
+
+# For an invitation in a non-default team (multi-team / EE deployments), prefix
+# the email with the team ID:
+terraform import clickhouse_clickstack_team_invitation.bob 65f0c0ffeecafef00dba5e01/bob@example.com
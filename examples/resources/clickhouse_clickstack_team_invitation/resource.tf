@@ -0,0 +1,18 @@
+# Invite a new user. The join URL is exposed through the (sensitive)
+# `invite_url` attribute; once it is older than `expires_in` and still unused,
+# the next apply revokes it and issues a fresh one.
+data "clickhouse_clickstack_role" "member" {
+  name = "Member"
+}
+
+resource "clickhouse_clickstack_team_invitation" "bob" {
+  email      = "bob@example.com"
+  name       = "Bob"
+  role_id    = data.clickhouse_clickstack_role.member.id
+  expires_in = "168h"
+}
+
+output "bob_invite_url" {
+  value     = clickhouse_clickstack_team_invitation.bob.invite_url
+  sensitive = true
+}
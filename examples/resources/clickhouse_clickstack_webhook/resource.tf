@@ -13,15 +13,31 @@ resource "clickhouse_clickstack_webhook" "slack" {
 # bump headers_version (any new string) to force the secret to be re-sent after
 # you rotate it. Write-only attributes require Terraform >= 1.11.
 resource "clickhouse_clickstack_webhook" "generic" {
-  name        = "pagerduty"
+  name        = "opsgenie"
   service     = "generic"
-  url         = "https://events.pagerduty.com/v2/enqueue"
-  description = "Routes alerts to PagerDuty"
+  url         = "https://api.opsgenie.com/v2/alerts"
+  description = "Routes alerts to Opsgenie"
 
   headers = {
-    Authorization = var.pagerduty_token
+    Authorization = "GenieKey ${var.opsgenie_api_key}"
   }
   headers_version = "1"
 
-  body = jsonencode({ routing_key = "REPLACE", event_action = "trigger" })
+  # A ready-made Opsgenie alert body; use body instead to write your own.
+  body_template = {
+    type = "opsgenie"
+  }
+}
+
+# PagerDuty through the Events API v2. The routing key goes in the body.
+resource "clickhouse_clickstack_webhook" "pagerduty" {
+  name    = "pagerduty"
+  service = "generic"
+  url     = "https://events.pagerduty.com/v2/enqueue"
+
+  body_template = {
+    type        = "pagerduty"
+    routing_key = var.pagerduty_routing_key
+    severity    = "critical"
+  }
 }
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Dictionaries can be imported by specifying the service ID, database and dictionary name.
+terraform import clickhouse_dictionary.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/default/countries
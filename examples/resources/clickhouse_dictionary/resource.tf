@@ -0,0 +1,32 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_dictionary" "countries" {
+  service_id = clickhouse_service.svc.id
+  database   = "default"
+  name       = "countries"
+
+  attributes = [
+    { name = "code", type = "String" },
+    { name = "name", type = "String", default = "unknown" },
+  ]
+  primary_key = ["code"]
+
+  source = {
+    type = "clickhouse"
+    params = {
+      db    = "default"
+      table = "countries_src"
+    }
+  }
+
+  layout = {
+    type = "complex_key_hashed"
+  }
+
+  lifetime = {
+    min = 300
+    max = 600
+  }
+}
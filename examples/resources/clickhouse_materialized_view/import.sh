@@ -0,0 +1,3 @@
+#!/bin/bash
+# Materialized views can be imported by specifying the service ID, database and view name.
+terraform import clickhouse_materialized_view.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/default/events_per_minute_mv
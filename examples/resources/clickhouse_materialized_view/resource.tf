@@ -0,0 +1,15 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_materialized_view" "events_per_minute" {
+  service_id = clickhouse_service.svc.id
+  database   = "default"
+  name       = "events_per_minute_mv"
+  to_table   = "events_per_minute"
+  query      = <<-SQL
+    SELECT toStartOfMinute(timestamp) AS minute, count() AS events
+    FROM default.events
+    GROUP BY minute
+  SQL
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Named collections can be imported by specifying the service ID and collection name.
+terraform import clickhouse_named_collection.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/s3_logs
@@ -0,0 +1,3 @@
+#!/bin/bash
+# A Postgres CDC link can be imported by specifying the ClickHouse service ID, the ClickPipe ID and the Postgres service ID.
+terraform import clickhouse_postgres_cdc_link.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
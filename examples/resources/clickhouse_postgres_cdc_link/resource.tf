@@ -0,0 +1,23 @@
+resource "clickhouse_service" "analytics" {
+  ...
+}
+
+resource "clickhouse_postgres_service" "pg" {
+  ...
+}
+
+resource "clickhouse_postgres_cdc_link" "example" {
+  name                 = "orders-cdc"
+  service_id           = clickhouse_service.analytics.id
+  postgres_service_id  = clickhouse_postgres_service.pg.id
+  password             = clickhouse_postgres_service.pg.password
+  destination_database = "default"
+
+  table_mappings = [
+    {
+      source_schema_name = "public"
+      source_table       = "orders"
+      target_table       = "public_orders"
+    },
+  ]
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+# Postgres databases can be imported by specifying service_id/name.
+# The database is first read on the apply after import.
+terraform import clickhouse_postgres_database.app xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/app
@@ -0,0 +1,17 @@
+resource "clickhouse_postgres_role" "app" {
+  service_id         = clickhouse_postgres_service.example.id
+  superuser_password = clickhouse_postgres_service.example.password
+
+  name                = "app"
+  login               = true
+  password_wo         = var.app_db_password
+  password_wo_version = 1
+}
+
+resource "clickhouse_postgres_database" "app" {
+  service_id         = clickhouse_postgres_service.example.id
+  superuser_password = clickhouse_postgres_service.example.password
+
+  name  = "app"
+  owner = clickhouse_postgres_role.app.name
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+# Postgres extensions can be imported by specifying service_id/database/name.
+# The extension is first read on the apply after import.
+terraform import clickhouse_postgres_extension.vector xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/app/vector
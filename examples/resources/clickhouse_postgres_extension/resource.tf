@@ -0,0 +1,7 @@
+resource "clickhouse_postgres_extension" "vector" {
+  service_id         = clickhouse_postgres_service.example.id
+  superuser_password = clickhouse_postgres_service.example.password
+
+  database = clickhouse_postgres_database.app.name
+  name     = "vector"
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# A Postgres maintenance window can be imported by specifying the Postgres service ID.
+terraform import clickhouse_postgres_maintenance_window.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
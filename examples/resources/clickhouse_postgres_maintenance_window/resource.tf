@@ -0,0 +1,9 @@
+resource "clickhouse_postgres_service" "pg" {
+  ...
+}
+
+resource "clickhouse_postgres_maintenance_window" "example" {
+  service_id     = clickhouse_postgres_service.pg.id
+  weekday        = 0 # Sunday
+  start_hour_utc = 3
+}
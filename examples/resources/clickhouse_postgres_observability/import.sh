@@ -0,0 +1,3 @@
+#!/bin/bash
+# A Postgres observability export can be imported by specifying the Postgres service ID.
+terraform import clickhouse_postgres_observability.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
@@ -0,0 +1,65 @@
+resource "clickhouse_postgres_service" "pg" {
+  ...
+}
+
+resource "clickhouse_service" "observability" {
+  ...
+}
+
+resource "clickhouse_postgres_observability" "example" {
+  service_id             = clickhouse_postgres_service.pg.id
+  destination_service_id = clickhouse_service.observability.id
+  database               = "postgres_otel"
+}
+
+# Optionally make the exported logs and metrics searchable in ClickStack.
+resource "clickhouse_clickstack_connection" "observability" {
+  name     = "Observability"
+  host     = "https://${clickhouse_service.observability.endpoints.https.host}:8443"
+  username = "default"
+  password = var.clickhouse_password
+}
+
+locals {
+  pg_logs    = clickhouse_postgres_observability.example.clickstack_log_source
+  pg_metrics = clickhouse_postgres_observability.example.clickstack_metric_source
+}
+
+resource "clickhouse_clickstack_source" "pg_logs" {
+  name          = "app-db logs"
+  kind          = "log"
+  connection_id = clickhouse_clickstack_connection.observability.id
+
+  from = {
+    database_name = clickhouse_postgres_observability.example.database
+    table_name    = clickhouse_postgres_observability.example.logs_table
+  }
+
+  timestamp_value_expression           = local.pg_logs.timestamp_value_expression
+  displayed_timestamp_value_expression = local.pg_logs.displayed_timestamp_value_expression
+  default_table_select_expression      = local.pg_logs.default_table_select_expression
+  service_name_expression              = local.pg_logs.service_name_expression
+  severity_text_expression             = local.pg_logs.severity_text_expression
+  body_expression                      = local.pg_logs.body_expression
+  event_attributes_expression          = local.pg_logs.event_attributes_expression
+  resource_attributes_expression       = local.pg_logs.resource_attributes_expression
+}
+
+resource "clickhouse_clickstack_source" "pg_metrics" {
+  name          = "app-db metrics"
+  kind          = "metric"
+  connection_id = clickhouse_clickstack_connection.observability.id
+
+  from = {
+    database_name = clickhouse_postgres_observability.example.database
+  }
+
+  metric_tables = {
+    gauge = clickhouse_postgres_observability.example.metrics_gauge_table
+    sum   = clickhouse_postgres_observability.example.metrics_sum_table
+  }
+
+  timestamp_value_expression     = local.pg_metrics.timestamp_value_expression
+  resource_attributes_expression = local.pg_metrics.resource_attributes_expression
+  log_source_id                  = clickhouse_clickstack_source.pg_logs.id
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+# Postgres roles can be imported by specifying service_id/name.
+# The role is first read on the apply after import.
+terraform import clickhouse_postgres_role.app xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/app
@@ -0,0 +1,12 @@
+resource "clickhouse_postgres_role" "app" {
+  service_id         = clickhouse_postgres_service.example.id
+  superuser_password = clickhouse_postgres_service.example.password
+
+  name             = "app"
+  login            = true
+  connection_limit = 20
+
+  # Write-only (Terraform >= 1.11); bump the version to rotate.
+  password_wo         = var.app_db_password
+  password_wo_version = 1
+}
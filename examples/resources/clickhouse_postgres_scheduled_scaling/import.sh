@@ -0,0 +1,3 @@
+#!/bin/bash
+# A Postgres scaling schedule can be imported by specifying the Postgres service ID.
+terraform import clickhouse_postgres_scheduled_scaling.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
@@ -0,0 +1,26 @@
+resource "clickhouse_postgres_service" "pg" {
+  ...
+}
+
+resource "clickhouse_postgres_scheduled_scaling" "example" {
+  service_id = clickhouse_postgres_service.pg.id
+
+  entries = [
+    {
+      # Smaller instance overnight on weekdays.
+      name           = "Nights"
+      weekdays       = [1, 2, 3, 4, 5]
+      start_hour_utc = 20
+      end_hour_utc   = 7
+      size           = "m6gd.medium"
+    },
+    {
+      # Stopped all weekend.
+      name           = "Weekend"
+      weekdays       = [0, 6]
+      start_hour_utc = 0
+      end_hour_utc   = 24
+      stopped        = true
+    },
+  ]
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Quotas can be imported by specifying the service ID and the quota name.
+terraform import clickhouse_quota.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/tenant_quota
@@ -0,0 +1,24 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_quota" "tenant" {
+  service_id = clickhouse_service.svc.id
+  name       = "tenant_quota"
+  keyed_by   = "user_name"
+
+  intervals = [
+    {
+      duration_seconds   = 3600
+      max_queries        = 1000
+      max_execution_time = 600
+    },
+    {
+      duration_seconds = 86400
+      randomized       = true
+      max_read_bytes   = 1000000000000
+    },
+  ]
+
+  apply_to_roles = ["tenant_acme"]
+}
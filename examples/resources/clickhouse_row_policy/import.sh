@@ -0,0 +1,3 @@
+#!/bin/bash
+# Row policies can be imported by specifying the service ID, database, table and policy name.
+terraform import clickhouse_row_policy.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/default/events/tenant_acme
@@ -0,0 +1,13 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_row_policy" "tenant" {
+  service_id = clickhouse_service.svc.id
+  name       = "tenant_acme"
+  database   = "default"
+  table      = "events"
+  using      = "tenant_id = 'acme'"
+
+  apply_to_roles = ["tenant_acme"]
+}
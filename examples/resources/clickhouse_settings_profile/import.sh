@@ -0,0 +1,3 @@
+#!/bin/bash
+# Settings profiles can be imported by specifying the service ID and the profile name.
+terraform import clickhouse_settings_profile.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/tenant_limits
@@ -0,0 +1,24 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_settings_profile" "tenant" {
+  service_id       = clickhouse_service.svc.id
+  name             = "tenant_limits"
+  inherit_profiles = ["default"]
+
+  settings = [
+    {
+      name  = "max_memory_usage"
+      value = "10000000000"
+      max   = "20000000000"
+    },
+    {
+      name        = "readonly"
+      value       = "1"
+      writability = "CONST"
+    },
+  ]
+
+  apply_to_roles = ["tenant_acme"]
+}
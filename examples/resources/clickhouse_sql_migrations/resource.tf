@@ -0,0 +1,28 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+# Migrations read from ./migrations/0001_create_events.up.sql, ...
+resource "clickhouse_sql_migrations" "app" {
+  service_id = clickhouse_service.svc.id
+  directory  = "${path.module}/migrations"
+}
+
+# Migrations listed inline.
+resource "clickhouse_sql_migrations" "analytics" {
+  service_id = clickhouse_service.svc.id
+  table      = "analytics_migrations"
+
+  migrations = [
+    {
+      version  = "1"
+      up_sql   = "CREATE TABLE IF NOT EXISTS analytics.daily (day Date, events UInt64) ENGINE = SummingMergeTree ORDER BY day"
+      down_sql = "DROP TABLE IF EXISTS analytics.daily"
+    },
+    {
+      version  = "2"
+      up_sql   = "ALTER TABLE analytics.daily ADD COLUMN IF NOT EXISTS users UInt64"
+      down_sql = "ALTER TABLE analytics.daily DROP COLUMN IF EXISTS users"
+    },
+  ]
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Views can be imported by specifying the service ID, database and view name.
+terraform import clickhouse_view.example xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/default/daily_events
@@ -0,0 +1,14 @@
+resource "clickhouse_service" "svc" {
+  ...
+}
+
+resource "clickhouse_view" "daily_events" {
+  service_id = clickhouse_service.svc.id
+  database   = "default"
+  name       = "daily_events"
+  query      = <<-SQL
+    SELECT toDate(timestamp) AS day, count() AS events
+    FROM default.events
+    GROUP BY day
+  SQL
+}
@@ -7,12 +7,17 @@ import (
 	"time"
 )
 
+// DefaultQueryAPIURL is the base URL of the ClickHouse Cloud Query API used to
+// run SQL against a service when ClientConfig.QueryAPIURL is not set.
+const DefaultQueryAPIURL = "https://console-api.clickhouse.cloud"
+
 type ClientImpl struct {
-	BaseUrl        string
-	HttpClient     *http.Client
-	OrganizationId string
-	TokenKey       string
-	TokenSecret    string
+	BaseUrl         string
+	QueryAPIBaseUrl string
+	HttpClient      *http.Client
+	OrganizationId  string
+	TokenKey        string
+	TokenSecret     string
 
 	// Track if organization settings resource has been registered
 	orgResourceMutex      sync.Mutex
@@ -21,6 +26,7 @@ type ClientImpl struct {
 
 type ClientConfig struct {
 	ApiURL         string
+	QueryAPIURL    string
 	OrganizationID string
 	TokenKey       string
 	TokenSecret    string
@@ -43,9 +49,13 @@ func NewClient(config ClientConfig) (*ClientImpl, error) {
 	if config.Timeout == 0 {
 		config.Timeout = time.Minute * 5
 	}
+	if config.QueryAPIURL == "" {
+		config.QueryAPIURL = DefaultQueryAPIURL
+	}
 
 	client := &ClientImpl{
-		BaseUrl: config.ApiURL,
+		BaseUrl:         config.ApiURL,
+		QueryAPIBaseUrl: config.QueryAPIURL,
 		HttpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
@@ -11,7 +11,8 @@ import (
 
 func TestNewClient(t *testing.T) {
 	testClient := &ClientImpl{
-		BaseUrl: "https://api.clickhouse.cloud/v1",
+		BaseUrl:         "https://api.clickhouse.cloud/v1",
+		QueryAPIBaseUrl: DefaultQueryAPIURL,
 		HttpClient: &http.Client{
 			Timeout: time.Minute * 5,
 		},
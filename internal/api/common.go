@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/project"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
 )
 
 type ResponseWithResult[T any] struct {
@@ -44,12 +45,22 @@ var sensitiveBodyKeys = map[string]struct{}{
 var sensitiveBodyContainers = map[string]struct{}{
 	"secrets":     {},
 	"credentials": {},
+	// Named collection contents as returned by system.named_collections.
+	"collection": {},
+}
+
+// sensitiveSQLBodyKeys hold SQL statements sent to the Query API. Credentials
+// travel in string literals (named collection values, dictionary sources), so
+// the literals are masked while the rest of the statement stays readable.
+var sensitiveSQLBodyKeys = map[string]struct{}{
+	"sql": {},
 }
 
 // redactSensitiveBody returns body with values of known sensitive keys replaced
 // by a placeholder string. Walks JSON recursively; arrays and nested objects
 // are traversed. Containers named "secrets" or "credentials" have their entire
-// subtree replaced by a scalar placeholder.
+// subtree replaced by a scalar placeholder. SQL statements keep their shape
+// with string literals masked.
 //
 // Empty input is returned unchanged. Malformed JSON returns a generic placeholder
 // rather than the raw bytes so a logging path never leaks unredacted content.
@@ -101,6 +112,12 @@ func redactJSONValue(v any) any {
 				t[k] = redactedPlaceholder
 				continue
 			}
+			if _, ok := sensitiveSQLBodyKeys[k]; ok {
+				if statement, isString := child.(string); isString {
+					t[k] = sql.RedactStringLiterals(statement, redactedPlaceholder)
+					continue
+				}
+			}
 			t[k] = redactJSONValue(child)
 		}
 		return t
@@ -136,7 +153,7 @@ func (c *ClientImpl) getPostgresPath(postgresId string, path string) string {
 	return c.getOrgPath(fmt.Sprintf("/postgres/%s%s", postgresId, path))
 }
 
-func (c *ClientImpl) getQueryAPIPath(queryAPIBaseUrl string, serviceID string, format string) string { //nolint
+func (c *ClientImpl) getQueryAPIPath(queryAPIBaseUrl string, serviceID string, format string) string {
 	if format == "" {
 		panic("format can't be empty in getQueryAPIPath")
 	}
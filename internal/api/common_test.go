@@ -149,6 +149,16 @@ func TestRedactSensitiveBody(t *testing.T) {
 			input: `{"optional":null}`,
 			want:  `{"optional":null}`,
 		},
+		{
+			name:  "collection container redacted to scalar",
+			input: `{"name":"s3","collection":{"access_key_id":"AKIA","secret_access_key":"shh"}}`,
+			want:  `{"name":"s3","collection":"REDACTED"}`,
+		},
+		{
+			name:  "Query API statement keeps its shape with literals masked",
+			input: `{"sql":"CREATE NAMED COLLECTION ` + "`s3`" + ` AS url = 'https://b', secret_access_key = 'shh'"}`,
+			want:  `{"sql":"CREATE NAMED COLLECTION ` + "`s3`" + ` AS url = 'REDACTED', secret_access_key = 'REDACTED'"}`,
+		},
 		{
 			name:  "malformed JSON returns placeholder",
 			input: `{"password":`,
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// DictionaryAttribute is one column of a dictionary's structure. Key columns
+// are declared here as well and listed by name in Dictionary.PrimaryKey.
+type DictionaryAttribute struct {
+	Name         string
+	Type         string
+	Default      *string
+	Expression   *string
+	Hierarchical bool
+	Injective    bool
+	IsObjectID   bool
+}
+
+// DictionaryParameterized is a SOURCE or LAYOUT clause: a type such as
+// CLICKHOUSE or HASHED plus its parameters. Parameter values are sent as
+// string literals, which ClickHouse accepts for numeric parameters as well.
+type DictionaryParameterized struct {
+	Type   string
+	Params map[string]string
+}
+
+type Dictionary struct {
+	Database    string
+	Name        string
+	Attributes  []DictionaryAttribute
+	PrimaryKey  []string
+	Source      DictionaryParameterized
+	Layout      DictionaryParameterized
+	LifetimeMin int64
+	LifetimeMax int64
+	Comment     string
+}
+
+type dictionaryRow struct {
+	KeyNames       []string `json:"key.names"`
+	KeyTypes       []string `json:"key.types"`
+	AttributeNames []string `json:"attribute.names"`
+	AttributeTypes []string `json:"attribute.types"`
+	LifetimeMin    int64    `json:"lifetime_min"`
+	LifetimeMax    int64    `json:"lifetime_max"`
+	Comment        string   `json:"comment"`
+}
+
+func (p DictionaryParameterized) clause() string {
+	keys := make([]string, 0, len(p.Params))
+	for k := range p.Params {
+		keys = append(keys, k)
+	}
+	// Sorted so the statement, and therefore the request log, is stable.
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		params = append(params, strings.ToUpper(k)+" "+sql.QuoteString(p.Params[k]))
+	}
+	return strings.ToUpper(p.Type) + "(" + strings.Join(params, " ") + ")"
+}
+
+func (d Dictionary) definition() string {
+	columns := make([]string, 0, len(d.Attributes))
+	for _, a := range d.Attributes {
+		column := sql.QuoteIdentifier(a.Name) + " " + a.Type
+		if a.Default != nil {
+			column += " DEFAULT " + sql.QuoteString(*a.Default)
+		}
+		if a.Expression != nil {
+			column += " EXPRESSION " + *a.Expression
+		}
+		if a.Hierarchical {
+			column += " HIERARCHICAL"
+		}
+		if a.Injective {
+			column += " INJECTIVE"
+		}
+		if a.IsObjectID {
+			column += " IS_OBJECT_ID"
+		}
+		columns = append(columns, column)
+	}
+
+	keys := make([]string, 0, len(d.PrimaryKey))
+	for _, k := range d.PrimaryKey {
+		keys = append(keys, sql.QuoteIdentifier(k))
+	}
+
+	def := fmt.Sprintf(" (%s) PRIMARY KEY %s SOURCE(%s) LAYOUT(%s) LIFETIME(MIN %d MAX %d)",
+		strings.Join(columns, ", "), strings.Join(keys, ", "), d.Source.clause(), d.Layout.clause(), d.LifetimeMin, d.LifetimeMax)
+	if d.Comment != "" {
+		def += " COMMENT " + sql.QuoteString(d.Comment)
+	}
+	return def
+}
+
+func (c *ClientImpl) CreateDictionary(ctx context.Context, serviceID string, dictionary Dictionary) (*Dictionary, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE DICTIONARY "+sql.QuoteQualified(dictionary.Database, dictionary.Name)+dictionary.definition()); err != nil {
+		return nil, err
+	}
+
+	return c.GetDictionary(ctx, serviceID, dictionary.Database, dictionary.Name)
+}
+
+// GetDictionary returns nil, nil when the dictionary does not exist.
+//
+// system.dictionaries exposes the structure, lifetime and comment but not the
+// source and layout parameters (passwords in particular are never returned),
+// so the returned Dictionary leaves Source and Layout empty.
+func (c *ClientImpl) GetDictionary(ctx context.Context, serviceID string, database string, name string) (*Dictionary, error) {
+	rows, err := queryRows[dictionaryRow](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT `key.names`, `key.types`, `attribute.names`, `attribute.types`, lifetime_min, lifetime_max, comment FROM system.dictionaries WHERE database = %s AND name = %s SETTINGS output_format_json_quote_64bit_integers = 0",
+		sql.QuoteString(database), sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	dictionary := &Dictionary{
+		Database:    database,
+		Name:        name,
+		Attributes:  make([]DictionaryAttribute, 0, len(row.KeyNames)+len(row.AttributeNames)),
+		PrimaryKey:  row.KeyNames,
+		LifetimeMin: row.LifetimeMin,
+		LifetimeMax: row.LifetimeMax,
+		Comment:     row.Comment,
+	}
+	for i, n := range row.KeyNames {
+		dictionary.Attributes = append(dictionary.Attributes, DictionaryAttribute{Name: n, Type: elementAt(row.KeyTypes, i)})
+	}
+	for i, n := range row.AttributeNames {
+		dictionary.Attributes = append(dictionary.Attributes, DictionaryAttribute{Name: n, Type: elementAt(row.AttributeTypes, i)})
+	}
+
+	return dictionary, nil
+}
+
+// ReplaceDictionary redefines the dictionary with CREATE OR REPLACE. The new
+// definition is loaded on first use like a freshly created dictionary.
+func (c *ClientImpl) ReplaceDictionary(ctx context.Context, serviceID string, dictionary Dictionary) (*Dictionary, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE OR REPLACE DICTIONARY "+sql.QuoteQualified(dictionary.Database, dictionary.Name)+dictionary.definition()); err != nil {
+		return nil, err
+	}
+
+	return c.GetDictionary(ctx, serviceID, dictionary.Database, dictionary.Name)
+}
+
+func (c *ClientImpl) DeleteDictionary(ctx context.Context, serviceID string, database string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP DICTIONARY IF EXISTS "+sql.QuoteQualified(database, name))
+}
+
+func elementAt(s []string, i int) string {
+	if i < len(s) {
+		return s[i]
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateDictionary(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.Contains(sql, "FROM system.dictionaries") {
+			return `{"key.names":["id"],"key.types":["UInt64"],"attribute.names":["name"],"attribute.types":["String"],"lifetime_min":0,"lifetime_max":300,"comment":"countries"}`
+		}
+		return ""
+	})
+
+	unknown := "unknown"
+	got, err := client.CreateDictionary(context.Background(), "svc-1", Dictionary{
+		Database: "default",
+		Name:     "countries",
+		Attributes: []DictionaryAttribute{
+			{Name: "id", Type: "UInt64"},
+			{Name: "name", Type: "String", Default: &unknown, Injective: true},
+		},
+		PrimaryKey:  []string{"id"},
+		Source:      DictionaryParameterized{Type: "clickhouse", Params: map[string]string{"table": "countries_src", "db": "default"}},
+		Layout:      DictionaryParameterized{Type: "hashed"},
+		LifetimeMax: 300,
+		Comment:     "countries",
+	})
+	if err != nil {
+		t.Fatalf("CreateDictionary: %v", err)
+	}
+
+	wantSQL := "CREATE DICTIONARY `default`.`countries` (`id` UInt64, `name` String DEFAULT 'unknown' INJECTIVE) PRIMARY KEY `id` SOURCE(CLICKHOUSE(DB 'default' TABLE 'countries_src')) LAYOUT(HASHED()) LIFETIME(MIN 0 MAX 300) COMMENT 'countries'"
+	if diff := cmp.Diff(wantSQL, (*statements)[0]); diff != "" {
+		t.Errorf("CREATE statement mismatch (-want +got):\n%s", diff)
+	}
+
+	want := &Dictionary{
+		Database:    "default",
+		Name:        "countries",
+		Attributes:  []DictionaryAttribute{{Name: "id", Type: "UInt64"}, {Name: "name", Type: "String"}},
+		PrimaryKey:  []string{"id"},
+		LifetimeMax: 300,
+		Comment:     "countries",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CreateDictionary mismatch (-want +got):\n%s", diff)
+	}
+}
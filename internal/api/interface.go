@@ -47,6 +47,40 @@ type Client interface {
 	CreateQueryEndpoint(ctx context.Context, serviceID string, endpoint ServiceQueryEndpoint) (*ServiceQueryEndpoint, error)
 	DeleteQueryEndpoint(ctx context.Context, serviceID string) error
 
+	CreateSettingsProfile(ctx context.Context, serviceID string, profile SettingsProfile) (*SettingsProfile, error)
+	GetSettingsProfile(ctx context.Context, serviceID string, name string) (*SettingsProfile, error)
+	UpdateSettingsProfile(ctx context.Context, serviceID string, profile SettingsProfile) (*SettingsProfile, error)
+	DeleteSettingsProfile(ctx context.Context, serviceID string, name string) error
+	CreateQuota(ctx context.Context, serviceID string, quota Quota) (*Quota, error)
+	GetQuota(ctx context.Context, serviceID string, name string) (*Quota, error)
+	UpdateQuota(ctx context.Context, serviceID string, quota Quota) (*Quota, error)
+	DeleteQuota(ctx context.Context, serviceID string, name string) error
+	CreateRowPolicy(ctx context.Context, serviceID string, policy RowPolicy) (*RowPolicy, error)
+	GetRowPolicy(ctx context.Context, serviceID string, database string, table string, name string) (*RowPolicy, error)
+	UpdateRowPolicy(ctx context.Context, serviceID string, policy RowPolicy) (*RowPolicy, error)
+	DeleteRowPolicy(ctx context.Context, serviceID string, database string, table string, name string) error
+	CreateView(ctx context.Context, serviceID string, view View) (*View, error)
+	GetView(ctx context.Context, serviceID string, database string, name string) (*View, error)
+	ReplaceView(ctx context.Context, serviceID string, view View) (*View, error)
+	DeleteView(ctx context.Context, serviceID string, database string, name string) error
+	CreateMaterializedView(ctx context.Context, serviceID string, view MaterializedView) (*MaterializedView, error)
+	GetMaterializedView(ctx context.Context, serviceID string, database string, name string) (*MaterializedView, error)
+	UpdateMaterializedViewQuery(ctx context.Context, serviceID string, database string, name string, query string) (*MaterializedView, error)
+	DeleteMaterializedView(ctx context.Context, serviceID string, database string, name string) error
+	CreateDictionary(ctx context.Context, serviceID string, dictionary Dictionary) (*Dictionary, error)
+	GetDictionary(ctx context.Context, serviceID string, database string, name string) (*Dictionary, error)
+	ReplaceDictionary(ctx context.Context, serviceID string, dictionary Dictionary) (*Dictionary, error)
+	DeleteDictionary(ctx context.Context, serviceID string, database string, name string) error
+	CreateNamedCollection(ctx context.Context, serviceID string, collection NamedCollection) (*NamedCollection, error)
+	GetNamedCollection(ctx context.Context, serviceID string, name string) (*NamedCollection, error)
+	UpdateNamedCollection(ctx context.Context, serviceID string, name string, set map[string]string, deleted []string) (*NamedCollection, error)
+	DeleteNamedCollection(ctx context.Context, serviceID string, name string) error
+	RunReadOnlyQuery(ctx context.Context, serviceID string, query string, maxRows int) (*QueryResult, error)
+	EnsureMigrationsTable(ctx context.Context, serviceID string, table MigrationsTable) error
+	GetAppliedMigrations(ctx context.Context, serviceID string, table MigrationsTable) ([]AppliedMigration, error)
+	ApplyMigration(ctx context.Context, serviceID string, table MigrationsTable, version string, checksum string, statements []string) error
+	RevertMigration(ctx context.Context, serviceID string, table MigrationsTable, version string, statements []string) error
+
 	GetClickPipe(ctx context.Context, serviceId string, clickPipeId string) (*ClickPipe, error)
 	CreateClickPipe(ctx context.Context, serviceId string, clickPipe ClickPipe) (*ClickPipe, error)
 	UpdateClickPipe(ctx context.Context, serviceId string, clickPipeId string, request ClickPipeUpdate) (*ClickPipe, error)
@@ -86,7 +120,23 @@ type Client interface {
 	RestorePostgres(ctx context.Context, sourceId string, body PostgresRestoreRequest) (*Postgres, error)
 	SetPostgresPassword(ctx context.Context, postgresId string, body PostgresPassword) (*PostgresPassword, error)
 	CreatePostgresReadReplica(ctx context.Context, sourceId string, body PostgresReadReplicaRequest) (*Postgres, error)
+	PromotePostgres(ctx context.Context, postgresId string) (*Postgres, error)
 	GetPostgresConfig(ctx context.Context, postgresId string) (*PostgresConfig, error)
 	ReplacePostgresConfig(ctx context.Context, postgresId string, body PostgresConfig) (*PostgresConfigUpdateResponse, error)
+	GetPostgresBackupConfiguration(ctx context.Context, postgresId string) (*PostgresBackupConfiguration, error)
+	UpdatePostgresBackupConfiguration(ctx context.Context, postgresId string, body PostgresBackupConfiguration) (*PostgresBackupConfiguration, error)
+	ListPostgresBackups(ctx context.Context, postgresId string) (*PostgresBackups, error)
 	GetPostgresCaCertificates(ctx context.Context, postgresId string) ([]byte, error)
+	CheckPostgresUpgrade(ctx context.Context, postgresId string, body PostgresUpgradeRequest) (*PostgresUpgradeCheck, error)
+	UpgradePostgres(ctx context.Context, postgresId string, body PostgresUpgradeRequest) (*Postgres, error)
+	GetPostgresMaintenanceWindow(ctx context.Context, postgresId string) (*PostgresMaintenanceWindow, error)
+	UpdatePostgresMaintenanceWindow(ctx context.Context, postgresId string, body PostgresMaintenanceWindow) (*PostgresMaintenanceWindow, error)
+	DeletePostgresMaintenanceWindow(ctx context.Context, postgresId string) error
+	ChangePostgresState(ctx context.Context, postgresId string, body PostgresStateUpdate) (*Postgres, error)
+	GetPostgresScalingSchedule(ctx context.Context, postgresId string) (*PostgresScalingSchedule, error)
+	UpdatePostgresScalingSchedule(ctx context.Context, postgresId string, body PostgresScalingScheduleUpdate) (*PostgresScalingSchedule, error)
+	DeletePostgresScalingSchedule(ctx context.Context, postgresId string) error
+	GetPostgresObservability(ctx context.Context, postgresId string) (*PostgresObservability, error)
+	UpdatePostgresObservability(ctx context.Context, postgresId string, body PostgresObservability) (*PostgresObservability, error)
+	DeletePostgresObservability(ctx context.Context, postgresId string) error
 }
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// NamedCollectionHiddenValue is what system.named_collections reports in place
+// of a value the caller is not allowed to see.
+const NamedCollectionHiddenValue = "[HIDDEN]"
+
+// NamedCollection is a set of key/value pairs referenced by name from table
+// engines, table functions and dictionary sources. Values are always sent as
+// strings; ClickHouse converts them where a number is expected.
+type NamedCollection struct {
+	Name   string            `json:"name"`
+	Params map[string]string `json:"collection"`
+}
+
+// namedCollectionAssignments renders key = 'value' pairs sorted by key so the
+// statement is stable.
+func namedCollectionAssignments(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	assignments := make([]string, 0, len(keys))
+	for _, k := range keys {
+		assignments = append(assignments, sql.QuoteIdentifier(k)+" = "+sql.QuoteString(params[k]))
+	}
+	return strings.Join(assignments, ", ")
+}
+
+// redactNamedCollectionError strips collection values from err. ClickHouse
+// quotes the offending part of a statement in syntax errors, which would
+// otherwise surface secrets in diagnostics.
+func redactNamedCollectionError(err error, params map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	for _, v := range params {
+		if v != "" {
+			msg = strings.ReplaceAll(msg, v, redactedPlaceholder)
+		}
+	}
+	if msg == err.Error() {
+		return err
+	}
+	return errors.New(msg)
+}
+
+func (c *ClientImpl) CreateNamedCollection(ctx context.Context, serviceID string, collection NamedCollection) (*NamedCollection, error) {
+	if len(collection.Params) == 0 {
+		return nil, fmt.Errorf("named collection %q needs at least one key", collection.Name)
+	}
+	query := "CREATE NAMED COLLECTION " + sql.QuoteIdentifier(collection.Name) + " AS " + namedCollectionAssignments(collection.Params)
+	if err := c.execQuery(ctx, serviceID, query); err != nil {
+		return nil, redactNamedCollectionError(err, collection.Params)
+	}
+
+	return c.GetNamedCollection(ctx, serviceID, collection.Name)
+}
+
+// GetNamedCollection returns nil, nil when the collection does not exist.
+// Values the current user may not display are reported as
+// NamedCollectionHiddenValue.
+func (c *ClientImpl) GetNamedCollection(ctx context.Context, serviceID string, name string) (*NamedCollection, error) {
+	rows, err := queryRows[NamedCollection](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT name, collection FROM system.named_collections WHERE name = %s",
+		sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	if rows[0].Params == nil {
+		rows[0].Params = map[string]string{}
+	}
+
+	return &rows[0], nil
+}
+
+// UpdateNamedCollection sets the given keys and removes the deleted ones in a
+// single ALTER NAMED COLLECTION, so the collection is never observed half
+// updated.
+func (c *ClientImpl) UpdateNamedCollection(ctx context.Context, serviceID string, name string, set map[string]string, deleted []string) (*NamedCollection, error) {
+	if len(set) > 0 || len(deleted) > 0 {
+		query := "ALTER NAMED COLLECTION " + sql.QuoteIdentifier(name)
+		if len(set) > 0 {
+			query += " SET " + namedCollectionAssignments(set)
+		}
+		if len(deleted) > 0 {
+			keys := make([]string, 0, len(deleted))
+			for _, k := range deleted {
+				keys = append(keys, sql.QuoteIdentifier(k))
+			}
+			query += " DELETE " + strings.Join(keys, ", ")
+		}
+		if err := c.execQuery(ctx, serviceID, query); err != nil {
+			return nil, redactNamedCollectionError(err, set)
+		}
+	}
+
+	return c.GetNamedCollection(ctx, serviceID, name)
+}
+
+func (c *ClientImpl) DeleteNamedCollection(ctx context.Context, serviceID string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP NAMED COLLECTION IF EXISTS "+sql.QuoteIdentifier(name))
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateNamedCollection(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.Contains(sql, "FROM system.named_collections") {
+			return `{"name":"s3_logs","collection":{"url":"https://bucket.s3.amazonaws.com/","secret_access_key":"[HIDDEN]"}}`
+		}
+		return ""
+	})
+
+	got, err := client.CreateNamedCollection(context.Background(), "svc-1", NamedCollection{
+		Name:   "s3_logs",
+		Params: map[string]string{"url": "https://bucket.s3.amazonaws.com/", "secret_access_key": "it's secret"},
+	})
+	if err != nil {
+		t.Fatalf("CreateNamedCollection: %v", err)
+	}
+
+	wantSQL := "CREATE NAMED COLLECTION `s3_logs` AS `secret_access_key` = 'it\\'s secret', `url` = 'https://bucket.s3.amazonaws.com/'"
+	if diff := cmp.Diff(wantSQL, (*statements)[0]); diff != "" {
+		t.Errorf("CREATE statement mismatch (-want +got):\n%s", diff)
+	}
+	want := &NamedCollection{Name: "s3_logs", Params: map[string]string{"url": "https://bucket.s3.amazonaws.com/", "secret_access_key": NamedCollectionHiddenValue}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CreateNamedCollection mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateNamedCollection(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.Contains(sql, "FROM system.named_collections") {
+			return `{"name":"s3_logs","collection":{"url":"https://other/"}}`
+		}
+		return ""
+	})
+
+	if _, err := client.UpdateNamedCollection(context.Background(), "svc-1", "s3_logs", map[string]string{"url": "https://other/"}, []string{"format"}); err != nil {
+		t.Fatalf("UpdateNamedCollection: %v", err)
+	}
+
+	want := "ALTER NAMED COLLECTION `s3_logs` SET `url` = 'https://other/' DELETE `format`"
+	if got := (*statements)[0]; got != want {
+		t.Errorf("ALTER statement = %q; want %q", got, want)
+	}
+}
+
+func TestRedactNamedCollectionError(t *testing.T) {
+	err := redactNamedCollectionError(errors.New("status: 400, body: Syntax error near 'hunter2'"), map[string]string{"password": "hunter2"})
+	if strings.Contains(err.Error(), "hunter2") {
+		t.Errorf("error still contains the secret: %v", err)
+	}
+}
@@ -102,8 +102,9 @@ func (c *ClientImpl) CreatePostgres(ctx context.Context, body PostgresCreate) (*
 	return &resp.Result, resp.Result.Password, nil
 }
 
-// UpdatePostgres PATCHes size / haType / tags. Other fields would be rejected
-// by the server; PostgresUpdate's shape enforces this.
+// UpdatePostgres PATCHes size / haType / tags / ipAccessList /
+// privateEndpointIds. Other fields would be rejected by the server;
+// PostgresUpdate's shape enforces this.
 func (c *ClientImpl) UpdatePostgres(ctx context.Context, postgresId string, body PostgresUpdate) (*Postgres, error) {
 	rb, err := json.Marshal(body)
 	if err != nil {
@@ -362,6 +363,301 @@ func (c *ClientImpl) ReplacePostgresConfig(ctx context.Context, postgresId strin
 	return &resp.Result, nil
 }
 
+// ---------------------------------------------------------------------------
+// BACKUPS
+// ---------------------------------------------------------------------------
+
+// GetPostgresBackupConfiguration returns the instance's backup retention and
+// daily backup window.
+func (c *ClientImpl) GetPostgresBackupConfiguration(ctx context.Context, postgresId string) (*PostgresBackupConfiguration, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getPostgresPath(postgresId, "/backupConfiguration"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresBackupConfiguration]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresBackupConfiguration: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// UpdatePostgresBackupConfiguration PATCHes the backup settings; nil fields
+// are left unchanged server-side.
+func (c *ClientImpl) UpdatePostgresBackupConfiguration(ctx context.Context, postgresId string, body PostgresBackupConfiguration) (*PostgresBackupConfiguration, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresBackupConfiguration: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, c.getPostgresPath(postgresId, "/backupConfiguration"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresBackupConfiguration]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresBackupConfiguration: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// ListPostgresBackups returns the instance's backups and its point-in-time
+// restore window.
+func (c *ClientImpl) ListPostgresBackups(ctx context.Context, postgresId string) (*PostgresBackups, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getPostgresPath(postgresId, "/backups"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresBackups]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresBackups: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// ---------------------------------------------------------------------------
+// MAJOR VERSION UPGRADE / MAINTENANCE WINDOW
+// ---------------------------------------------------------------------------
+
+// CheckPostgresUpgrade asks the server whether the instance can be upgraded to
+// body.PostgresVersion in place. It changes nothing.
+func (c *ClientImpl) CheckPostgresUpgrade(ctx context.Context, postgresId string, body PostgresUpgradeRequest) (*PostgresUpgradeCheck, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresUpgradeRequest: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, c.getPostgresPath(postgresId, "/upgradeCheck"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresUpgradeCheck]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresUpgradeCheck: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// UpgradePostgres starts an in-place major version upgrade. The instance
+// passes through the upgrading state and reports the new postgresVersion once
+// done, so callers wait with WaitForPostgresMatch. Not retried on 5xx: a
+// retry of an upgrade that was accepted is rejected while it runs.
+func (c *ClientImpl) UpgradePostgres(ctx context.Context, postgresId string, body PostgresUpgradeRequest) (*Postgres, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresUpgradeRequest: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, c.getPostgresPath(postgresId, "/upgrade"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequestWithStatus(ctx, req, false, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[Postgres]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Postgres: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// GetPostgresMaintenanceWindow returns the instance's weekly maintenance
+// window; a 404 means none is set.
+func (c *ClientImpl) GetPostgresMaintenanceWindow(ctx context.Context, postgresId string) (*PostgresMaintenanceWindow, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getPostgresPath(postgresId, "/maintenanceWindow"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresMaintenanceWindow]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresMaintenanceWindow: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// UpdatePostgresMaintenanceWindow sets (PUT) the maintenance window.
+func (c *ClientImpl) UpdatePostgresMaintenanceWindow(ctx context.Context, postgresId string, body PostgresMaintenanceWindow) (*PostgresMaintenanceWindow, error) {
+	body.Duration = 0 // server-fixed
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresMaintenanceWindow: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, c.getPostgresPath(postgresId, "/maintenanceWindow"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresMaintenanceWindow]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresMaintenanceWindow: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// DeletePostgresMaintenanceWindow clears the maintenance window, after which
+// patching is scheduled by the server.
+func (c *ClientImpl) DeletePostgresMaintenanceWindow(ctx context.Context, postgresId string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.getPostgresPath(postgresId, "/maintenanceWindow"), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, req)
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// STOP / START / SCALING SCHEDULE
+// ---------------------------------------------------------------------------
+
+// ChangePostgresState stops or starts the instance (body.Command is one of
+// PostgresStateCommandStart / PostgresStateCommandStop). The change is
+// asynchronous; callers wait with WaitForPostgresState.
+func (c *ClientImpl) ChangePostgresState(ctx context.Context, postgresId string, body PostgresStateUpdate) (*Postgres, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresStateUpdate: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, c.getPostgresPath(postgresId, "/state"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[Postgres]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Postgres: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// GetPostgresScalingSchedule returns the instance's scaling schedule; a 404
+// means none is set.
+func (c *ClientImpl) GetPostgresScalingSchedule(ctx context.Context, postgresId string) (*PostgresScalingSchedule, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getPostgresPath(postgresId, "/scalingSchedule"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresScalingSchedule]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresScalingSchedule: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// UpdatePostgresScalingSchedule replaces (POST) the whole scaling schedule.
+func (c *ClientImpl) UpdatePostgresScalingSchedule(ctx context.Context, postgresId string, body PostgresScalingScheduleUpdate) (*PostgresScalingSchedule, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresScalingScheduleUpdate: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, c.getPostgresPath(postgresId, "/scalingSchedule"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresScalingSchedule]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresScalingSchedule: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// DeletePostgresScalingSchedule clears the scaling schedule. The instance
+// keeps whatever size and state the last active window left it in.
+func (c *ClientImpl) DeletePostgresScalingSchedule(ctx context.Context, postgresId string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.getPostgresPath(postgresId, "/scalingSchedule"), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, req)
+	return err
+}
+
+// ---------------------------------------------------------------------------
+// OBSERVABILITY EXPORT
+// ---------------------------------------------------------------------------
+
+// GetPostgresObservability returns the instance's log / metric export
+// settings; a 404 means export was never configured.
+func (c *ClientImpl) GetPostgresObservability(ctx context.Context, postgresId string) (*PostgresObservability, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getPostgresPath(postgresId, "/observability"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresObservability]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresObservability: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// UpdatePostgresObservability replaces (PUT) the export settings. The
+// response carries the server-assigned table names.
+func (c *ClientImpl) UpdatePostgresObservability(ctx context.Context, postgresId string, body PostgresObservability) (*PostgresObservability, error) {
+	rb, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode PostgresObservability: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, c.getPostgresPath(postgresId, "/observability"), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[PostgresObservability]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PostgresObservability: %w", err)
+	}
+	return &resp.Result, nil
+}
+
+// DeletePostgresObservability stops the export. Tables already written in
+// the destination service are left in place.
+func (c *ClientImpl) DeletePostgresObservability(ctx context.Context, postgresId string) error {
+	req, err := http.NewRequest(http.MethodDelete, c.getPostgresPath(postgresId, "/observability"), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(ctx, req)
+	return err
+}
+
 // ---------------------------------------------------------------------------
 // RESTORE / READ REPLICA
 // ---------------------------------------------------------------------------
@@ -388,6 +684,27 @@ func (c *ClientImpl) RestorePostgres(ctx context.Context, sourceId string, body
 	return &resp.Result, nil
 }
 
+// PromotePostgres promotes a read replica to a standalone primary. The
+// instance keeps its ID and hostname; is_primary flips once the server
+// finishes the promotion, so callers wait with WaitForPostgresMatch. Not
+// retried on 5xx: a promotion that already went through would be rejected on
+// retry because the instance is no longer a replica.
+func (c *ClientImpl) PromotePostgres(ctx context.Context, postgresId string) (*Postgres, error) {
+	req, err := http.NewRequest(http.MethodPost, c.getPostgresPath(postgresId, "/promote"), nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := c.doRequestWithStatus(ctx, req, false, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	resp := ResponseWithResult[Postgres]{}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Postgres: %w", err)
+	}
+	return &resp.Result, nil
+}
+
 // CreatePostgresReadReplica creates a read replica of the source primary.
 // A fresh primary rejects replicas with a 400 until its first base backup
 // completes (an unpredictable window observed from 0s to several minutes);
@@ -11,12 +11,22 @@ import (
 const (
 	PostgresStateCreating          = "creating"
 	PostgresStateRestarting        = "restarting"
+	PostgresStateUpgrading         = "upgrading"
 	PostgresStateRunning           = "running"
 	PostgresStateReplayingWal      = "replaying_wal"
 	PostgresStateRestoringBackup   = "restoring_backup"
 	PostgresStateFinalizingRestore = "finalizing_restore"
 	PostgresStateUnavailable       = "unavailable"
 	PostgresStateDeleting          = "deleting"
+	PostgresStateStopping          = "stopping"
+	PostgresStateStopped           = "stopped"
+	PostgresStateStarting          = "starting"
+)
+
+// Commands accepted by PATCH /postgres/{id}/state.
+const (
+	PostgresStateCommandStart = "start"
+	PostgresStateCommandStop  = "stop"
 )
 
 // PgConfigMap mirrors the server's `pgConfig` / `pgBouncerConfig` shape
@@ -65,6 +75,9 @@ type Postgres struct {
 	Username         string `json:"username,omitempty"`
 	Password         string `json:"password,omitempty"`
 	Tags             []Tag  `json:"tags,omitempty"`
+
+	IpAccessList       []IpAccess `json:"ipAccessList,omitempty"`
+	PrivateEndpointIds []string   `json:"privateEndpointIds,omitempty"`
 }
 
 // PostgresListItem is the abbreviated GET /postgres response item. Modeled
@@ -100,19 +113,28 @@ type PostgresCreate struct {
 	Tags            []Tag       `json:"tags,omitempty"`
 	PgConfig        PgConfigMap `json:"pgConfig,omitempty"`
 	PgBouncerConfig PgConfigMap `json:"pgBouncerConfig,omitempty"`
+
+	IpAccessList       []IpAccess `json:"ipAccessList,omitempty"`
+	PrivateEndpointIds []string   `json:"privateEndpointIds,omitempty"`
 }
 
 // PostgresUpdate is the PATCH /postgres/{id} body. Server accepts ONLY
-// size / haType / tags; `name` is intentionally absent (no field in the
-// server schema). Tags is *[]Tag so callers can distinguish:
+// size / haType / tags / ipAccessList / privateEndpointIds; `name` is
+// intentionally absent (no field in the server schema). Tags is *[]Tag so
+// callers can distinguish:
 //
 //	nil       -> field omitted; server leaves existing tags alone
 //	&[]Tag{}  -> server clears all tags
 //	&[]Tag{…} -> server replaces with these
+//
+// IpAccessList / PrivateEndpointIds are add/remove diffs, same as on
+// ServiceUpdate; nil leaves the current entries alone.
 type PostgresUpdate struct {
-	Size   string `json:"size,omitempty"`
-	HaType string `json:"haType,omitempty"`
-	Tags   *[]Tag `json:"tags,omitempty"`
+	Size               string                    `json:"size,omitempty"`
+	HaType             string                    `json:"haType,omitempty"`
+	Tags               *[]Tag                    `json:"tags,omitempty"`
+	IpAccessList       *IpAccessUpdate           `json:"ipAccessList,omitempty"`
+	PrivateEndpointIds *PrivateEndpointIdsUpdate `json:"privateEndpointIds,omitempty"`
 }
 
 // PostgresRestoreRequest is the POST /postgres/{id}/restoredService body. Same
@@ -156,3 +178,109 @@ type PostgresConfigUpdateResponse struct {
 	PgBouncerConfig PgConfigMap `json:"pgBouncerConfig"`
 	Message         string      `json:"message,omitempty"`
 }
+
+// PostgresBackupConfiguration is the GET/PATCH /postgres/{id}/backupConfiguration
+// body. Pointer fields so a PATCH can leave a setting alone (nil → omitted).
+// BackupWindowStart is the UTC start of the daily backup window as "HH:MM".
+type PostgresBackupConfiguration struct {
+	RetentionDays     *int64  `json:"retentionDays,omitempty"`
+	BackupWindowStart *string `json:"backupWindowStart,omitempty"`
+}
+
+// PostgresBackup is one completed (or in-progress) base backup.
+type PostgresBackup struct {
+	Id         string `json:"id"`
+	Status     string `json:"status"`
+	StartedAt  string `json:"startedAt"`
+	FinishedAt string `json:"finishedAt,omitempty"`
+}
+
+// PostgresBackups is the GET /postgres/{id}/backups response. The restorable
+// window for point-in-time restore runs from EarliestRestoreTarget to
+// LatestRestoreTarget (RFC3339); both are empty until the first backup
+// completes.
+type PostgresBackups struct {
+	EarliestRestoreTarget string           `json:"earliestRestoreTarget,omitempty"`
+	LatestRestoreTarget   string           `json:"latestRestoreTarget,omitempty"`
+	Backups               []PostgresBackup `json:"backups"`
+}
+
+// PostgresUpgradeRequest is the body of POST /postgres/{id}/upgradeCheck and
+// POST /postgres/{id}/upgrade: the major version to move to.
+type PostgresUpgradeRequest struct {
+	PostgresVersion string `json:"postgresVersion"`
+}
+
+// PostgresUpgradeCheck is the POST /postgres/{id}/upgradeCheck response.
+// Issues lists what blocks the upgrade (unsupported extensions, prepared
+// transactions, …); it is empty when Compatible is true.
+type PostgresUpgradeCheck struct {
+	Compatible bool     `json:"compatible"`
+	Issues     []string `json:"issues,omitempty"`
+}
+
+// PostgresMaintenanceWindowAllowedStartHoursUtc is the server-side allowed set
+// for a Postgres maintenance window's `startHourUtc`: any whole hour.
+var PostgresMaintenanceWindowAllowedStartHoursUtc = []int64{
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23,
+}
+
+// PostgresMaintenanceWindow is the GET/PUT /postgres/{id}/maintenanceWindow
+// shape: a weekly window in which minor version patching is applied.
+// Duration is server-fixed (hours) and ignored on PUT.
+type PostgresMaintenanceWindow struct {
+	Weekday      int `json:"weekday"`
+	StartHourUtc int `json:"startHourUtc"`
+	Duration     int `json:"duration,omitempty"`
+}
+
+// PostgresStateUpdate is the PATCH /postgres/{id}/state body.
+type PostgresStateUpdate struct {
+	Command string `json:"command"`
+}
+
+// PostgresScalingScheduleEntry is one weekly window of a Postgres scaling
+// schedule. The window fields have the same shape and semantics as
+// AutoScalingScheduleEntry's; while the window is active the instance runs at
+// Size, or is stopped when Stopped is true.
+type PostgresScalingScheduleEntry struct {
+	// ID is server-generated; empty when sent in a POST request.
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Weekdays     []int  `json:"weekdays"`
+	StartHourUtc int    `json:"startHourUtc"`
+	EndHourUtc   int    `json:"endHourUtc"`
+	Size         string `json:"size,omitempty"`
+	Stopped      bool   `json:"stopped,omitempty"`
+	// IsActiveNow is server-computed and only present in GET responses.
+	IsActiveNow bool `json:"isActiveNow,omitempty"`
+}
+
+// PostgresScalingSchedule is the GET/POST /postgres/{id}/scalingSchedule
+// response. Outside every window the instance runs at its own size.
+type PostgresScalingSchedule struct {
+	Entries       []PostgresScalingScheduleEntry `json:"entries"`
+	ActiveEntryID string                         `json:"activeEntryId,omitempty"`
+}
+
+// PostgresScalingScheduleUpdate is the POST request body. It replaces the
+// full schedule for the instance.
+type PostgresScalingScheduleUpdate struct {
+	Entries []PostgresScalingScheduleEntry `json:"entries"`
+}
+
+// PostgresObservability is the GET/PUT /postgres/{id}/observability body:
+// which signals the instance exports, and where to. Logs land in one table
+// in the OpenTelemetry logs layout, metrics in OpenTelemetry gauge and sum
+// tables; the table names are server-assigned and ignored on PUT.
+type PostgresObservability struct {
+	LogsEnabled          bool   `json:"logsEnabled"`
+	MetricsEnabled       bool   `json:"metricsEnabled"`
+	DestinationServiceID string `json:"destinationServiceId"`
+	// Database in the destination service; the server picks one when empty.
+	Database string `json:"database,omitempty"`
+
+	LogsTable         string `json:"logsTable,omitempty"`
+	MetricsGaugeTable string `json:"metricsGaugeTable,omitempty"`
+	MetricsSumTable   string `json:"metricsSumTable,omitempty"`
+}
@@ -155,6 +155,20 @@ func TestPostgresUpdate_PopulatedTagsList(t *testing.T) {
 	}
 }
 
+func TestPostgresUpdate_NetworkAccessDiffs(t *testing.T) {
+	body, err := json.Marshal(PostgresUpdate{
+		IpAccessList:       &IpAccessUpdate{Add: []IpAccess{{Source: "10.0.0.0/8", Description: "vpc"}}},
+		PrivateEndpointIds: &PrivateEndpointIdsUpdate{Remove: []string{"vpce-1"}},
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	want := `{"ipAccessList":{"add":[{"source":"10.0.0.0/8","description":"vpc"}]},"privateEndpointIds":{"remove":["vpce-1"]}}`
+	if string(body) != want {
+		t.Errorf("got %s\nwant %s", body, want)
+	}
+}
+
 func TestPostgres_OmitsEmptyOptionalFields(t *testing.T) {
 	// Hostname, ConnectionString, Username, Password have omitempty so a
 	// zero-value string gets omitted from outgoing JSON.
@@ -719,6 +719,50 @@ func TestReplacePostgresConfig_AcceptsEmptyMaps(t *testing.T) {
 	}
 }
 
+// ----- Backups -------------------------------------------------------------
+
+func TestUpdatePostgresBackupConfiguration_SendsOnlySetFields(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/backupConfiguration"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %q; want PATCH", r.Method)
+		}
+		if r.URL.Path != expectedPath {
+			t.Errorf("path = %q; want %q", r.URL.Path, expectedPath)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"retentionDays":14}` {
+			t.Errorf("body = %s; want only retentionDays", body)
+		}
+		_, _ = w.Write([]byte(`{"result":{"retentionDays":14,"backupWindowStart":"02:00"}}`))
+	})
+	retention := int64(14)
+	got, err := client.UpdatePostgresBackupConfiguration(context.Background(), testPostgresID, PostgresBackupConfiguration{RetentionDays: &retention})
+	if err != nil {
+		t.Fatalf("UpdatePostgresBackupConfiguration: %v", err)
+	}
+	if got.BackupWindowStart == nil || *got.BackupWindowStart != "02:00" {
+		t.Errorf("BackupWindowStart = %v; want 02:00", got.BackupWindowStart)
+	}
+}
+
+func TestListPostgresBackups_HappyPath(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/backups"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != expectedPath {
+			t.Errorf("path = %q; want %q", r.URL.Path, expectedPath)
+		}
+		_, _ = w.Write([]byte(`{"result":{"earliestRestoreTarget":"2026-06-01T00:10:00Z","latestRestoreTarget":"2026-06-08T12:00:00Z","backups":[{"id":"b-1","status":"completed","startedAt":"2026-06-01T00:00:00Z","finishedAt":"2026-06-01T00:10:00Z"}]}}`))
+	})
+	got, err := client.ListPostgresBackups(context.Background(), testPostgresID)
+	if err != nil {
+		t.Fatalf("ListPostgresBackups: %v", err)
+	}
+	if got.EarliestRestoreTarget != "2026-06-01T00:10:00Z" || len(got.Backups) != 1 || got.Backups[0].Id != "b-1" {
+		t.Errorf("unexpected result: %+v", got)
+	}
+}
+
 // ----- Restore + Read Replica ---------------------------------------------
 
 func TestRestorePostgres_HappyPath(t *testing.T) {
@@ -743,6 +787,187 @@ func TestRestorePostgres_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPromotePostgres_HappyPath(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/promote"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %q; want POST", r.Method)
+		}
+		if r.URL.Path != expectedPath {
+			t.Errorf("path = %q; want %q", r.URL.Path, expectedPath)
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[Postgres]{Result: Postgres{Id: testPostgresID, IsPrimary: false}})
+	})
+	got, err := client.PromotePostgres(context.Background(), testPostgresID)
+	if err != nil {
+		t.Fatalf("PromotePostgres: %v", err)
+	}
+	if got.Id != testPostgresID {
+		t.Errorf("Id = %q; want %q", got.Id, testPostgresID)
+	}
+}
+
+func TestPromotePostgres_DoesNotRetryServerError(t *testing.T) {
+	var calls atomic.Int32
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, err := client.PromotePostgres(context.Background(), testPostgresID); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("calls = %d; want 1 (promotion must not be retried)", n)
+	}
+}
+
+func TestCheckPostgresUpgrade_HappyPath(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/upgradeCheck"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != expectedPath {
+			t.Errorf("request = %s %s; want POST %s", r.Method, r.URL.Path, expectedPath)
+		}
+		var body PostgresUpgradeRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.PostgresVersion != "18" {
+			t.Errorf("postgresVersion = %q; want 18", body.PostgresVersion)
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[PostgresUpgradeCheck]{Result: PostgresUpgradeCheck{Issues: []string{"extension foo is not available"}}})
+	})
+	got, err := client.CheckPostgresUpgrade(context.Background(), testPostgresID, PostgresUpgradeRequest{PostgresVersion: "18"})
+	if err != nil {
+		t.Fatalf("CheckPostgresUpgrade: %v", err)
+	}
+	if got.Compatible || len(got.Issues) != 1 {
+		t.Errorf("got %+v; want incompatible with one issue", got)
+	}
+}
+
+func TestUpgradePostgres_DoesNotRetryServerError(t *testing.T) {
+	var calls atomic.Int32
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, err := client.UpgradePostgres(context.Background(), testPostgresID, PostgresUpgradeRequest{PostgresVersion: "18"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("calls = %d; want 1 (upgrade must not be retried)", n)
+	}
+}
+
+func TestUpdatePostgresMaintenanceWindow_OmitsDuration(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/maintenanceWindow"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != expectedPath {
+			t.Errorf("request = %s %s; want PUT %s", r.Method, r.URL.Path, expectedPath)
+		}
+		var raw map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		if _, ok := raw["duration"]; ok {
+			t.Errorf("duration is server-fixed and must not be sent: %v", raw)
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[PostgresMaintenanceWindow]{Result: PostgresMaintenanceWindow{Weekday: 2, StartHourUtc: 3, Duration: 4}})
+	})
+	got, err := client.UpdatePostgresMaintenanceWindow(context.Background(), testPostgresID, PostgresMaintenanceWindow{Weekday: 2, StartHourUtc: 3, Duration: 9})
+	if err != nil {
+		t.Fatalf("UpdatePostgresMaintenanceWindow: %v", err)
+	}
+	if got.Duration != 4 {
+		t.Errorf("Duration = %d; want 4", got.Duration)
+	}
+}
+
+func TestChangePostgresState_SendsCommand(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/state"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != expectedPath {
+			t.Errorf("request = %s %s; want PATCH %s", r.Method, r.URL.Path, expectedPath)
+		}
+		var body PostgresStateUpdate
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Command != PostgresStateCommandStop {
+			t.Errorf("command = %q; want %q", body.Command, PostgresStateCommandStop)
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[Postgres]{Result: Postgres{Id: testPostgresID, State: PostgresStateStopping}})
+	})
+	got, err := client.ChangePostgresState(context.Background(), testPostgresID, PostgresStateUpdate{Command: PostgresStateCommandStop})
+	if err != nil {
+		t.Fatalf("ChangePostgresState: %v", err)
+	}
+	if got.State != PostgresStateStopping {
+		t.Errorf("State = %q; want %q", got.State, PostgresStateStopping)
+	}
+}
+
+func TestUpdatePostgresScalingSchedule_RoundTrip(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/scalingSchedule"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != expectedPath {
+			t.Errorf("request = %s %s; want POST %s", r.Method, r.URL.Path, expectedPath)
+		}
+		var raw map[string][]map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		entries := raw["entries"]
+		if len(entries) != 2 {
+			t.Fatalf("entries = %v; want 2", entries)
+		}
+		if _, ok := entries[0]["stopped"]; ok {
+			t.Errorf("a resize entry must not send stopped: %v", entries[0])
+		}
+		if _, ok := entries[1]["size"]; ok {
+			t.Errorf("a stop entry must not send size: %v", entries[1])
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[PostgresScalingSchedule]{Result: PostgresScalingSchedule{
+			Entries: []PostgresScalingScheduleEntry{
+				{ID: "e1", Name: "business", Weekdays: []int{1, 2, 3, 4, 5}, StartHourUtc: 8, EndHourUtc: 18, Size: "m6gd.large"},
+				{ID: "e2", Name: "weekend", Weekdays: []int{0, 6}, StartHourUtc: 0, EndHourUtc: 24, Stopped: true},
+			},
+		}})
+	})
+	got, err := client.UpdatePostgresScalingSchedule(context.Background(), testPostgresID, PostgresScalingScheduleUpdate{Entries: []PostgresScalingScheduleEntry{
+		{Name: "business", Weekdays: []int{1, 2, 3, 4, 5}, StartHourUtc: 8, EndHourUtc: 18, Size: "m6gd.large"},
+		{Name: "weekend", Weekdays: []int{0, 6}, StartHourUtc: 0, EndHourUtc: 24, Stopped: true},
+	}})
+	if err != nil {
+		t.Fatalf("UpdatePostgresScalingSchedule: %v", err)
+	}
+	if len(got.Entries) != 2 || got.Entries[0].ID != "e1" || !got.Entries[1].Stopped {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestUpdatePostgresObservability_RoundTrip(t *testing.T) {
+	expectedPath := testPostgresInstancePath + "/observability"
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != expectedPath {
+			t.Errorf("request = %s %s; want PUT %s", r.Method, r.URL.Path, expectedPath)
+		}
+		var raw map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		if _, ok := raw["database"]; ok {
+			t.Errorf("an unset database must be omitted so the server picks one: %v", raw)
+		}
+		if raw["metricsEnabled"] != false {
+			t.Errorf("metricsEnabled = %v; want an explicit false", raw["metricsEnabled"])
+		}
+		_ = json.NewEncoder(w).Encode(ResponseWithResult[PostgresObservability]{Result: PostgresObservability{
+			LogsEnabled:          true,
+			DestinationServiceID: "ch-1",
+			Database:             "postgres_observability",
+			LogsTable:            "pg_logs",
+		}})
+	})
+	got, err := client.UpdatePostgresObservability(context.Background(), testPostgresID, PostgresObservability{LogsEnabled: true, DestinationServiceID: "ch-1"})
+	if err != nil {
+		t.Fatalf("UpdatePostgresObservability: %v", err)
+	}
+	if got.Database != "postgres_observability" || got.LogsTable != "pg_logs" {
+		t.Errorf("got %+v", got)
+	}
+}
+
 func TestCreatePostgresReadReplica_HappyPath(t *testing.T) {
 	expectedPath := "/organizations/org-1/postgres/primary-id/readReplica"
 	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// queryResultFormat carries the column names and types ahead of the rows,
+// which is what the clickhouse_query data source needs to type its output.
+const queryResultFormat = "JSONCompactEachRowWithNamesAndTypes"
+
+type QueryColumn struct {
+	Name string
+	Type string
+}
+
+// QueryResult is the output of RunReadOnlyQuery. Each row holds one raw JSON
+// value per column, in column order.
+type QueryResult struct {
+	Columns []QueryColumn
+	Rows    [][]json.RawMessage
+}
+
+// RunReadOnlyQuery runs a user-supplied SELECT with readonly = 1 and returns
+// at most maxRows rows. The query is wrapped in a subquery so the row limit
+// and settings apply regardless of what it contains; a result with more rows
+// is an error rather than silently truncated.
+func (c *ClientImpl) RunReadOnlyQuery(ctx context.Context, serviceID string, query string, maxRows int) (*QueryResult, error) {
+	if err := sql.CheckReadOnlyQuery(query); err != nil {
+		return nil, err
+	}
+
+	// The newline keeps a trailing -- comment from swallowing the parenthesis.
+	wrapped := fmt.Sprintf(
+		"SELECT * FROM (%s\n) LIMIT %d SETTINGS readonly = 1, output_format_json_quote_64bit_integers = 0, output_format_json_quote_decimals = 0",
+		sql.TrimStatement(query), maxRows+1,
+	)
+	req, err := c.newQueryAPIRequest(serviceID, wrapped, queryResultFormat)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := decodeQueryResult(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) > maxRows {
+		return nil, fmt.Errorf("query returned more than %d rows; add a LIMIT or raise the row limit", maxRows)
+	}
+	return result, nil
+}
+
+func decodeQueryResult(body []byte) (*QueryResult, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+
+	var names, types []string
+	if err := decoder.Decode(&names); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &QueryResult{Columns: []QueryColumn{}, Rows: [][]json.RawMessage{}}, nil
+		}
+		return nil, fmt.Errorf("unable to decode query column names: %w", err)
+	}
+	if err := decoder.Decode(&types); err != nil {
+		return nil, fmt.Errorf("unable to decode query column types: %w", err)
+	}
+	if len(names) != len(types) {
+		return nil, fmt.Errorf("query returned %d column names but %d types", len(names), len(types))
+	}
+
+	result := &QueryResult{Columns: make([]QueryColumn, len(names)), Rows: make([][]json.RawMessage, 0)}
+	for i := range names {
+		result.Columns[i] = QueryColumn{Name: names[i], Type: types[i]}
+	}
+	for {
+		var row []json.RawMessage
+		if err := decoder.Decode(&row); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to decode query result row: %w", err)
+		}
+		if len(row) != len(names) {
+			return nil, fmt.Errorf("query result row has %d values, want %d", len(row), len(names))
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
+
+// UnwrapColumnType strips the Nullable and LowCardinality wrappers that do not
+// change how a value is represented.
+func UnwrapColumnType(t string) string {
+	for {
+		switch {
+		case strings.HasPrefix(t, "Nullable(") && strings.HasSuffix(t, ")"):
+			t = t[len("Nullable(") : len(t)-1]
+		case strings.HasPrefix(t, "LowCardinality(") && strings.HasSuffix(t, ")"):
+			t = t[len("LowCardinality(") : len(t)-1]
+		default:
+			return t
+		}
+	}
+}
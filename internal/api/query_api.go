@@ -0,0 +1,121 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// queryAPIFormat is the output format requested from the Query API. One JSON
+// object per row keeps decoding streaming-friendly and independent of column
+// order.
+const queryAPIFormat = "JSONEachRow"
+
+type queryAPIRequest struct {
+	SQL string `json:"sql"`
+}
+
+func (c *ClientImpl) newQueryAPIRequest(serviceID string, sql string, format string) (*http.Request, error) {
+	rb, err := json.Marshal(queryAPIRequest{SQL: sql})
+	if err != nil {
+		return nil, err
+	}
+
+	return http.NewRequest(http.MethodPost, c.getQueryAPIPath(c.QueryAPIBaseUrl, serviceID, format), bytes.NewReader(rb))
+}
+
+// runQuery sends a read-only statement to the service through the Query API
+// and returns the raw JSONEachRow response. Reads are idempotent, so transient
+// failures are retried.
+func (c *ClientImpl) runQuery(ctx context.Context, serviceID string, sql string) ([]byte, error) {
+	req, err := c.newQueryAPIRequest(serviceID, sql, queryAPIFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(ctx, req)
+}
+
+// execQuery sends a DDL statement to the service through the Query API. It is
+// not retried on 5xx: the statement may already have been applied, and
+// re-running a CREATE would then fail with a misleading "already exists".
+func (c *ClientImpl) execQuery(ctx context.Context, serviceID string, sql string) error {
+	req, err := c.newQueryAPIRequest(serviceID, sql, queryAPIFormat)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequestWithStatus(ctx, req, false, http.StatusOK)
+	return err
+}
+
+// queryRows runs sql and decodes each JSONEachRow line into a T.
+func queryRows[T any](ctx context.Context, c *ClientImpl, serviceID string, sql string) ([]T, error) {
+	body, err := c.runQuery(ctx, serviceID, sql)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSONEachRow[T](body)
+}
+
+func decodeJSONEachRow[T any](body []byte) ([]T, error) {
+	rows := make([]T, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var row T
+		if err := json.Unmarshal(line, &row); err != nil {
+			return nil, fmt.Errorf("unable to decode query result row: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read query result: %w", err)
+	}
+
+	return rows, nil
+}
+
+// SQLGrantees lists the SQL users and roles an access entity (settings
+// profile, quota, row policy) applies to. ClickHouse stores both in a single
+// apply_to_list; they are told apart on read by looking the names up in
+// system.roles.
+type SQLGrantees struct {
+	Users []string `json:"users"`
+	Roles []string `json:"roles"`
+}
+
+// granteesSelect splits apply_to_list into the users and roles columns
+// decoded by SQLGrantees. It needs granteesWith in the same query.
+const (
+	granteesWith   = "WITH (SELECT groupArray(name) FROM system.roles) AS role_names "
+	granteesSelect = "arrayFilter(x -> NOT has(role_names, x), apply_to_list) AS users, arrayFilter(x -> has(role_names, x), apply_to_list) AS roles"
+)
+
+// toClause renders the TO clause of a CREATE or ALTER statement. An empty
+// list is omitted on CREATE and becomes TO NONE on ALTER so a previously
+// assigned grantee is removed.
+func (g SQLGrantees) toClause(alter bool) string {
+	names := make([]string, 0, len(g.Users)+len(g.Roles))
+	for _, n := range append(append([]string{}, g.Users...), g.Roles...) {
+		names = append(names, sql.QuoteIdentifier(n))
+	}
+	if len(names) == 0 {
+		if alter {
+			return " TO NONE"
+		}
+		return ""
+	}
+	return " TO " + strings.Join(names, ", ")
+}
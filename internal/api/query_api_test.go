@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newQueryAPITestClient returns a client whose Query API points at a test
+// server. respond is called with each SQL statement received and returns the
+// JSONEachRow body to send back; every statement is also recorded in the
+// returned slice.
+func newQueryAPITestClient(t *testing.T, respond func(sql string) string) (*ClientImpl, *[]string) {
+	t.Helper()
+	return newQueryAPITestClientWithFormat(t, queryAPIFormat, respond)
+}
+
+// newQueryAPITestClientWithFormat is newQueryAPITestClient for requests made
+// with another output format.
+func newQueryAPITestClientWithFormat(t *testing.T, format string, respond func(sql string) string) (*ClientImpl, *[]string) {
+	t.Helper()
+	statements := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %q; want POST", r.Method)
+		}
+		if r.URL.Path != "/.api/services/svc-1/query" {
+			t.Errorf("path = %q; want /.api/services/svc-1/query", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("format"); got != format {
+			t.Errorf("format = %q; want %s", got, format)
+		}
+		var req queryAPIRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		statements = append(statements, req.SQL)
+		_, _ = w.Write([]byte(respond(req.SQL)))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(ClientConfig{
+		ApiURL:         server.URL,
+		QueryAPIURL:    server.URL,
+		OrganizationID: "org-1",
+		TokenKey:       "key",
+		TokenSecret:    "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return client, &statements
+}
+
+func TestDecodeJSONEachRow(t *testing.T) {
+	type row struct {
+		Name  string  `json:"name"`
+		Value *string `json:"value"`
+	}
+	one := "1"
+
+	got, err := decodeJSONEachRow[row]([]byte("{\"name\":\"a\",\"value\":\"1\"}\n\n{\"name\":\"b\",\"value\":null}\n"))
+	if err != nil {
+		t.Fatalf("decodeJSONEachRow: %v", err)
+	}
+	want := []row{{Name: "a", Value: &one}, {Name: "b"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("decodeJSONEachRow mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = decodeJSONEachRow[row](nil)
+	if err != nil {
+		t.Fatalf("decodeJSONEachRow(empty): %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("decodeJSONEachRow(empty) = %#v; want empty non-nil slice", got)
+	}
+
+	if _, err := decodeJSONEachRow[row]([]byte("not json")); err == nil {
+		t.Errorf("decodeJSONEachRow(malformed): expected error, got nil")
+	}
+}
+
+func TestExecQuery_NoRetryOnServerError(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(ClientConfig{ApiURL: server.URL, QueryAPIURL: server.URL, OrganizationID: "org-1", TokenKey: "key", TokenSecret: "secret"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if err := client.execQuery(context.Background(), "svc-1", "DROP QUOTA q"); err == nil {
+		t.Fatalf("execQuery: expected error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("execQuery made %d requests; want 1", calls)
+	}
+}
+
+func TestSQLGrantees_toClause(t *testing.T) {
+	tests := []struct {
+		name     string
+		grantees SQLGrantees
+		alter    bool
+		want     string
+	}{
+		{name: "users then roles", grantees: SQLGrantees{Users: []string{"alice"}, Roles: []string{"tenant`a"}}, want: " TO `alice`, `tenant\\`a`"},
+		{name: "empty on create is omitted", want: ""},
+		{name: "empty on alter clears grantees", alter: true, want: " TO NONE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.grantees.toClause(tt.alter); got != tt.want {
+				t.Errorf("toClause() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// statementWith returns the first recorded statement starting with prefix.
+func statementWith(t *testing.T, statements []string, prefix string) string {
+	t.Helper()
+	for _, s := range statements {
+		if strings.HasPrefix(s, prefix) {
+			return s
+		}
+	}
+	t.Fatalf("no statement starting with %q in %q", prefix, statements)
+	return ""
+}
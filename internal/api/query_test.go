@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRunReadOnlyQuery(t *testing.T) {
+	body := "[\"name\",\"n\"]\n[\"Nullable(String)\",\"UInt64\"]\n[\"a\",18446744073709551615]\n[null,2]\n"
+	client, statements := newQueryAPITestClientWithFormat(t, queryResultFormat, func(string) string { return body })
+
+	got, err := client.RunReadOnlyQuery(context.Background(), "svc-1", "SELECT name, n FROM t -- all\n;", 2)
+	if err != nil {
+		t.Fatalf("RunReadOnlyQuery: %v", err)
+	}
+
+	wantSQL := "SELECT * FROM (SELECT name, n FROM t -- all\n) LIMIT 3 SETTINGS readonly = 1, output_format_json_quote_64bit_integers = 0, output_format_json_quote_decimals = 0"
+	if diff := cmp.Diff(wantSQL, (*statements)[0]); diff != "" {
+		t.Errorf("statement mismatch (-want +got):\n%s", diff)
+	}
+	want := &QueryResult{
+		Columns: []QueryColumn{{Name: "name", Type: "Nullable(String)"}, {Name: "n", Type: "UInt64"}},
+		Rows: [][]json.RawMessage{
+			{json.RawMessage(`"a"`), json.RawMessage(`18446744073709551615`)},
+			{json.RawMessage(`null`), json.RawMessage(`2`)},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RunReadOnlyQuery mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunReadOnlyQuery_RowLimit(t *testing.T) {
+	body := "[\"n\"]\n[\"UInt8\"]\n[1]\n[2]\n"
+	client, _ := newQueryAPITestClientWithFormat(t, queryResultFormat, func(string) string { return body })
+
+	_, err := client.RunReadOnlyQuery(context.Background(), "svc-1", "SELECT number AS n FROM numbers(2)", 1)
+	if err == nil || !strings.Contains(err.Error(), "more than 1 rows") {
+		t.Errorf("RunReadOnlyQuery error = %v; want row limit error", err)
+	}
+}
+
+func TestRunReadOnlyQuery_RejectsDDL(t *testing.T) {
+	client, statements := newQueryAPITestClientWithFormat(t, queryResultFormat, func(string) string { return "" })
+
+	if _, err := client.RunReadOnlyQuery(context.Background(), "svc-1", "DROP TABLE t", 10); err == nil {
+		t.Error("RunReadOnlyQuery(DROP TABLE) succeeded; want error")
+	}
+	if len(*statements) != 0 {
+		t.Errorf("statements sent = %v; want none", *statements)
+	}
+}
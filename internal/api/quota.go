@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+const (
+	QuotaKeyUserName              = "user_name"
+	QuotaKeyIPAddress             = "ip_address"
+	QuotaKeyClientKey             = "client_key"
+	QuotaKeyClientKeyOrUserName   = "client_key_or_user_name"
+	QuotaKeyClientKeyOrIPAddress  = "client_key_or_ip_address"
+	quotaKeyClauseClientKeyOrUser = "client_key, user_name"
+	quotaKeyClauseClientKeyOrIP   = "client_key, ip_address"
+)
+
+// QuotaKeys are the values the provider accepts for keyed_by. system.quotas
+// reports the composite keys as two-element keys arrays; see quotaKeyedBy.
+var QuotaKeys = []string{
+	QuotaKeyUserName,
+	QuotaKeyIPAddress,
+	QuotaKeyClientKey,
+	QuotaKeyClientKeyOrUserName,
+	QuotaKeyClientKeyOrIPAddress,
+}
+
+// QuotaInterval holds the limits for one tracking interval. A nil limit is not
+// enforced; an interval with no limits at all is tracked only.
+type QuotaInterval struct {
+	DurationSeconds  uint32   `json:"duration"`
+	Randomized       bool     `json:"-"`
+	MaxQueries       *int64   `json:"max_queries"`
+	MaxQuerySelects  *int64   `json:"max_query_selects"`
+	MaxQueryInserts  *int64   `json:"max_query_inserts"`
+	MaxErrors        *int64   `json:"max_errors"`
+	MaxResultRows    *int64   `json:"max_result_rows"`
+	MaxResultBytes   *int64   `json:"max_result_bytes"`
+	MaxReadRows      *int64   `json:"max_read_rows"`
+	MaxReadBytes     *int64   `json:"max_read_bytes"`
+	MaxWrittenBytes  *int64   `json:"max_written_bytes"`
+	MaxExecutionTime *float64 `json:"max_execution_time"`
+}
+
+type Quota struct {
+	Name string
+	// KeyedBy is empty for a quota shared by everyone it applies to.
+	KeyedBy   string
+	Intervals []QuotaInterval
+	Grantees  SQLGrantees
+}
+
+type quotaRow struct {
+	SQLGrantees
+	Keys []string `json:"keys"`
+}
+
+type quotaLimitsRow struct {
+	QuotaInterval
+	IsRandomizedInterval uint8 `json:"is_randomized_interval"`
+}
+
+func (i QuotaInterval) limitsClause() string {
+	limits := make([]string, 0)
+	addInt := func(name string, v *int64) {
+		if v != nil {
+			limits = append(limits, fmt.Sprintf("%s = %d", name, *v))
+		}
+	}
+	addInt("queries", i.MaxQueries)
+	addInt("query_selects", i.MaxQuerySelects)
+	addInt("query_inserts", i.MaxQueryInserts)
+	addInt("errors", i.MaxErrors)
+	addInt("result_rows", i.MaxResultRows)
+	addInt("result_bytes", i.MaxResultBytes)
+	addInt("read_rows", i.MaxReadRows)
+	addInt("read_bytes", i.MaxReadBytes)
+	addInt("written_bytes", i.MaxWrittenBytes)
+	if i.MaxExecutionTime != nil {
+		limits = append(limits, "execution_time = "+strconv.FormatFloat(*i.MaxExecutionTime, 'f', -1, 64))
+	}
+
+	if len(limits) == 0 {
+		return "TRACKING ONLY"
+	}
+	return "MAX " + strings.Join(limits, ", ")
+}
+
+// quotaKeyedBy maps the keys column of system.quotas back to a keyed_by
+// value: KEYED BY client_key, user_name reads back as ["client_key",
+// "user_name"], for instance.
+func quotaKeyedBy(keys []string) string {
+	switch {
+	case len(keys) == 0:
+		return ""
+	case len(keys) == 1:
+		return keys[0]
+	case len(keys) == 2 && keys[0] == QuotaKeyClientKey && keys[1] == QuotaKeyUserName:
+		return QuotaKeyClientKeyOrUserName
+	case len(keys) == 2 && keys[0] == QuotaKeyClientKey && keys[1] == QuotaKeyIPAddress:
+		return QuotaKeyClientKeyOrIPAddress
+	default:
+		return strings.Join(keys, ", ")
+	}
+}
+
+func intervalPrefix(durationSeconds uint32, randomized bool) string {
+	if randomized {
+		return fmt.Sprintf("FOR RANDOMIZED INTERVAL %d second", durationSeconds)
+	}
+	return fmt.Sprintf("FOR INTERVAL %d second", durationSeconds)
+}
+
+// removedIntervals returns the intervals of current that desired no longer
+// declares. Intervals are identified by their duration.
+func removedIntervals(current, desired []QuotaInterval) []QuotaInterval {
+	removed := make([]QuotaInterval, 0)
+	for _, c := range current {
+		found := false
+		for _, d := range desired {
+			if d.DurationSeconds == c.DurationSeconds {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, c)
+		}
+	}
+	return removed
+}
+
+// definitionClause renders the quota after CREATE/ALTER QUOTA <name>. dropped
+// intervals (ALTER only) are cleared with NO LIMITS.
+func (q Quota) definitionClause(alter bool, dropped []QuotaInterval) string {
+	var b strings.Builder
+	switch q.KeyedBy {
+	case "":
+		if alter {
+			b.WriteString(" NOT KEYED")
+		}
+	case QuotaKeyClientKeyOrUserName:
+		b.WriteString(" KEYED BY " + quotaKeyClauseClientKeyOrUser)
+	case QuotaKeyClientKeyOrIPAddress:
+		b.WriteString(" KEYED BY " + quotaKeyClauseClientKeyOrIP)
+	default:
+		b.WriteString(" KEYED BY " + q.KeyedBy)
+	}
+
+	intervals := make([]string, 0, len(dropped)+len(q.Intervals))
+	for _, i := range dropped {
+		intervals = append(intervals, intervalPrefix(i.DurationSeconds, i.Randomized)+" NO LIMITS")
+	}
+	for _, i := range q.Intervals {
+		intervals = append(intervals, intervalPrefix(i.DurationSeconds, i.Randomized)+" "+i.limitsClause())
+	}
+	if len(intervals) > 0 {
+		b.WriteString(" " + strings.Join(intervals, ", "))
+	}
+
+	b.WriteString(q.Grantees.toClause(alter))
+	return b.String()
+}
+
+func (c *ClientImpl) CreateQuota(ctx context.Context, serviceID string, quota Quota) (*Quota, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE QUOTA "+sql.QuoteIdentifier(quota.Name)+quota.definitionClause(false, nil)); err != nil {
+		return nil, err
+	}
+
+	return c.GetQuota(ctx, serviceID, quota.Name)
+}
+
+// GetQuota returns nil, nil when no quota with the given name exists.
+func (c *ClientImpl) GetQuota(ctx context.Context, serviceID string, name string) (*Quota, error) {
+	quotas, err := queryRows[quotaRow](ctx, c, serviceID, fmt.Sprintf(
+		"%sSELECT keys, %s FROM system.quotas WHERE name = %s",
+		granteesWith, granteesSelect, sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+
+	// 64-bit integers are quoted by default in JSON output formats; ask for
+	// plain numbers so they decode straight into *int64.
+	limits, err := queryRows[quotaLimitsRow](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT * FROM system.quota_limits WHERE quota_name = %s ORDER BY duration SETTINGS output_format_json_quote_64bit_integers = 0",
+		sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &Quota{
+		Name:      name,
+		Intervals: make([]QuotaInterval, 0, len(limits)),
+		Grantees:  quotas[0].SQLGrantees,
+	}
+	quota.KeyedBy = quotaKeyedBy(quotas[0].Keys)
+	for _, l := range limits {
+		interval := l.QuotaInterval
+		interval.Randomized = l.IsRandomizedInterval != 0
+		quota.Intervals = append(quota.Intervals, interval)
+	}
+
+	return quota, nil
+}
+
+// UpdateQuota brings an existing quota in line with the given definition in
+// a single ALTER QUOTA. Naming an interval replaces all of its limits, so only
+// intervals that are no longer declared need an explicit NO LIMITS.
+func (c *ClientImpl) UpdateQuota(ctx context.Context, serviceID string, quota Quota) (*Quota, error) {
+	current, err := c.GetQuota(ctx, serviceID, quota.Name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("quota %q not found", quota.Name)
+	}
+
+	if err := c.execQuery(ctx, serviceID, "ALTER QUOTA "+sql.QuoteIdentifier(quota.Name)+quota.definitionClause(true, removedIntervals(current.Intervals, quota.Intervals))); err != nil {
+		return nil, err
+	}
+
+	return c.GetQuota(ctx, serviceID, quota.Name)
+}
+
+func (c *ClientImpl) DeleteQuota(ctx context.Context, serviceID string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP QUOTA IF EXISTS "+sql.QuoteIdentifier(name))
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetQuota(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		switch {
+		case strings.Contains(sql, "FROM system.quotas"):
+			return `{"keys":["client_key","user_name"],"users":[],"roles":["tenant_a"]}`
+		case strings.Contains(sql, "FROM system.quota_limits"):
+			return `{"quota_name":"tenant","duration":3600,"is_randomized_interval":1,"max_queries":1000,"max_query_selects":null,"max_query_inserts":null,"max_errors":null,"max_result_rows":null,"max_result_bytes":null,"max_read_rows":null,"max_read_bytes":null,"max_execution_time":12.5,"max_written_bytes":null}`
+		}
+		return ""
+	})
+
+	got, err := client.GetQuota(context.Background(), "svc-1", "tenant")
+	if err != nil {
+		t.Fatalf("GetQuota: %v", err)
+	}
+
+	maxQueries, maxExecutionTime := int64(1000), 12.5
+	want := &Quota{
+		Name:      "tenant",
+		KeyedBy:   QuotaKeyClientKeyOrUserName,
+		Intervals: []QuotaInterval{{DurationSeconds: 3600, Randomized: true, MaxQueries: &maxQueries, MaxExecutionTime: &maxExecutionTime}},
+		Grantees:  SQLGrantees{Users: []string{}, Roles: []string{"tenant_a"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetQuota mismatch (-want +got):\n%s", diff)
+	}
+	if !strings.HasSuffix((*statements)[1], "SETTINGS output_format_json_quote_64bit_integers = 0") {
+		t.Errorf("limits query does not disable 64-bit quoting: %q", (*statements)[1])
+	}
+}
+
+func TestQuotaKeyedBy(t *testing.T) {
+	cases := []struct {
+		keys []string
+		want string
+	}{
+		{nil, ""},
+		{[]string{"user_name"}, QuotaKeyUserName},
+		{[]string{"client_key"}, QuotaKeyClientKey},
+		{[]string{"client_key", "user_name"}, QuotaKeyClientKeyOrUserName},
+		{[]string{"client_key", "ip_address"}, QuotaKeyClientKeyOrIPAddress},
+	}
+	for _, c := range cases {
+		if got := quotaKeyedBy(c.keys); got != c.want {
+			t.Errorf("quotaKeyedBy(%v) = %q, want %q", c.keys, got, c.want)
+		}
+	}
+}
+
+func TestCreateQuota(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+
+	maxQueries, maxExecutionTime := int64(100), 0.5
+	_, err := client.CreateQuota(context.Background(), "svc-1", Quota{
+		Name:    "tenant",
+		KeyedBy: QuotaKeyIPAddress,
+		Intervals: []QuotaInterval{
+			{DurationSeconds: 60, MaxQueries: &maxQueries, MaxExecutionTime: &maxExecutionTime},
+			{DurationSeconds: 86400, Randomized: true},
+		},
+		Grantees: SQLGrantees{Users: []string{"alice"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateQuota: %v", err)
+	}
+
+	want := "CREATE QUOTA `tenant` KEYED BY ip_address FOR INTERVAL 60 second MAX queries = 100, execution_time = 0.5, FOR RANDOMIZED INTERVAL 86400 second TRACKING ONLY TO `alice`"
+	if diff := cmp.Diff(want, (*statements)[0]); diff != "" {
+		t.Errorf("CREATE statement mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateQuota_SingleStatement(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		switch {
+		case strings.Contains(sql, "FROM system.quotas"):
+			return `{"keys":["user_name"],"users":["alice"],"roles":[]}`
+		case strings.Contains(sql, "FROM system.quota_limits"):
+			return `{"duration":60,"is_randomized_interval":0,"max_queries":10}
+{"duration":3600,"is_randomized_interval":1,"max_queries":100}`
+		}
+		return ""
+	})
+
+	maxErrors := int64(5)
+	_, err := client.UpdateQuota(context.Background(), "svc-1", Quota{
+		Name:      "tenant",
+		Intervals: []QuotaInterval{{DurationSeconds: 60, MaxErrors: &maxErrors}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateQuota: %v", err)
+	}
+
+	alters := make([]string, 0)
+	for _, s := range *statements {
+		if strings.HasPrefix(s, "ALTER") {
+			alters = append(alters, s)
+		}
+	}
+	want := []string{
+		"ALTER QUOTA `tenant` NOT KEYED FOR RANDOMIZED INTERVAL 3600 second NO LIMITS, FOR INTERVAL 60 second MAX errors = 5 TO NONE",
+	}
+	if diff := cmp.Diff(want, alters); diff != "" {
+		t.Errorf("ALTER statements mismatch (-want +got):\n%s", diff)
+	}
+}
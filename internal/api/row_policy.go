@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// RowPolicy filters the rows of Database.Table that the grantees can SELECT.
+// The same Name may be reused on different tables, so a policy is identified
+// by all three.
+type RowPolicy struct {
+	Name     string `json:"short_name"`
+	Database string `json:"database"`
+	Table    string `json:"table"`
+	// Using is the filter condition as ClickHouse stores it. The server
+	// re-formats the expression, so it rarely matches the text sent on create.
+	Using       string      `json:"select_filter"`
+	Restrictive bool        `json:"-"`
+	Grantees    SQLGrantees `json:"-"`
+}
+
+type rowPolicyRow struct {
+	RowPolicy
+	SQLGrantees
+	IsRestrictive uint8 `json:"is_restrictive"`
+}
+
+func (p RowPolicy) target() string {
+	return sql.QuoteIdentifier(p.Name) + " ON " + sql.QuoteIdentifier(p.Database) + "." + sql.QuoteIdentifier(p.Table)
+}
+
+func (p RowPolicy) definitionClause(alter bool) string {
+	kind := "PERMISSIVE"
+	if p.Restrictive {
+		kind = "RESTRICTIVE"
+	}
+	return " AS " + kind + " FOR SELECT USING " + p.Using + p.Grantees.toClause(alter)
+}
+
+func (c *ClientImpl) CreateRowPolicy(ctx context.Context, serviceID string, policy RowPolicy) (*RowPolicy, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE ROW POLICY "+policy.target()+policy.definitionClause(false)); err != nil {
+		return nil, err
+	}
+
+	return c.GetRowPolicy(ctx, serviceID, policy.Database, policy.Table, policy.Name)
+}
+
+// GetRowPolicy returns nil, nil when no such policy exists on the table.
+func (c *ClientImpl) GetRowPolicy(ctx context.Context, serviceID string, database string, table string, name string) (*RowPolicy, error) {
+	rows, err := queryRows[rowPolicyRow](ctx, c, serviceID, fmt.Sprintf(
+		"%sSELECT short_name, database, table, select_filter, is_restrictive, %s FROM system.row_policies WHERE short_name = %s AND database = %s AND table = %s",
+		granteesWith, granteesSelect, sql.QuoteString(name), sql.QuoteString(database), sql.QuoteString(table),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	policy := rows[0].RowPolicy
+	policy.Restrictive = rows[0].IsRestrictive != 0
+	policy.Grantees = rows[0].SQLGrantees
+	return &policy, nil
+}
+
+func (c *ClientImpl) UpdateRowPolicy(ctx context.Context, serviceID string, policy RowPolicy) (*RowPolicy, error) {
+	if err := c.execQuery(ctx, serviceID, "ALTER ROW POLICY "+policy.target()+policy.definitionClause(true)); err != nil {
+		return nil, err
+	}
+
+	return c.GetRowPolicy(ctx, serviceID, policy.Database, policy.Table, policy.Name)
+}
+
+func (c *ClientImpl) DeleteRowPolicy(ctx context.Context, serviceID string, database string, table string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP ROW POLICY IF EXISTS "+RowPolicy{Name: name, Database: database, Table: table}.target())
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateRowPolicy(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.Contains(sql, "FROM system.row_policies") {
+			return `{"short_name":"tenant_a","database":"default","table":"events","select_filter":"tenant_id = 'a'","is_restrictive":1,"users":[],"roles":["tenant_a"]}`
+		}
+		return ""
+	})
+
+	policy := RowPolicy{
+		Name:        "tenant_a",
+		Database:    "default",
+		Table:       "events",
+		Using:       "tenant_id = 'a'",
+		Restrictive: true,
+		Grantees:    SQLGrantees{Users: []string{}, Roles: []string{"tenant_a"}},
+	}
+	got, err := client.CreateRowPolicy(context.Background(), "svc-1", policy)
+	if err != nil {
+		t.Fatalf("CreateRowPolicy: %v", err)
+	}
+
+	wantSQL := "CREATE ROW POLICY `tenant_a` ON `default`.`events` AS RESTRICTIVE FOR SELECT USING tenant_id = 'a' TO `tenant_a`"
+	if diff := cmp.Diff(wantSQL, (*statements)[0]); diff != "" {
+		t.Errorf("CREATE statement mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&policy, got); diff != "" {
+		t.Errorf("CreateRowPolicy mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeleteRowPolicy(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+
+	if err := client.DeleteRowPolicy(context.Background(), "svc-1", "default", "events", "tenant_a"); err != nil {
+		t.Fatalf("DeleteRowPolicy: %v", err)
+	}
+
+	want := "DROP ROW POLICY IF EXISTS `tenant_a` ON `default`.`events`"
+	if got := (*statements)[0]; got != want {
+		t.Errorf("DROP statement = %q; want %q", got, want)
+	}
+}
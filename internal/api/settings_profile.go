@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+const (
+	SettingWritabilityWritable             = "WRITABLE"
+	SettingWritabilityConst                = "CONST"
+	SettingWritabilityChangeableInReadonly = "CHANGEABLE_IN_READONLY"
+)
+
+var SettingWritabilities = []string{
+	SettingWritabilityWritable,
+	SettingWritabilityConst,
+	SettingWritabilityChangeableInReadonly,
+}
+
+// SettingsProfileSetting is a single setting constraint in a settings profile.
+// Values are kept as strings: ClickHouse reports them as strings in
+// system.settings_profile_elements regardless of the setting type.
+type SettingsProfileSetting struct {
+	Name        string
+	Value       *string
+	Min         *string
+	Max         *string
+	Writability *string
+}
+
+type SettingsProfile struct {
+	Name            string
+	Settings        []SettingsProfileSetting
+	InheritProfiles []string
+	Grantees        SQLGrantees
+}
+
+type settingsProfileElementRow struct {
+	SettingName    *string `json:"setting_name"`
+	Value          *string `json:"value"`
+	Min            *string `json:"min"`
+	Max            *string `json:"max"`
+	Writability    *string `json:"writability"`
+	InheritProfile *string `json:"inherit_profile"`
+}
+
+// settingsClause renders the SETTINGS clause. Inherited profiles come first so
+// the explicit settings override them, matching how ClickHouse applies
+// elements in order.
+func (p SettingsProfile) settingsClause(alter bool) string {
+	elements := make([]string, 0, len(p.InheritProfiles)+len(p.Settings))
+	for _, parent := range p.InheritProfiles {
+		elements = append(elements, "PROFILE "+sql.QuoteString(parent))
+	}
+	for _, s := range p.Settings {
+		element := sql.QuoteIdentifier(s.Name)
+		if s.Value != nil {
+			element += " = " + sql.QuoteString(*s.Value)
+		}
+		if s.Min != nil {
+			element += " MIN " + sql.QuoteString(*s.Min)
+		}
+		if s.Max != nil {
+			element += " MAX " + sql.QuoteString(*s.Max)
+		}
+		if s.Writability != nil {
+			element += " " + *s.Writability
+		}
+		elements = append(elements, element)
+	}
+
+	if len(elements) == 0 {
+		if alter {
+			return " SETTINGS NONE"
+		}
+		return ""
+	}
+	return " SETTINGS " + strings.Join(elements, ", ")
+}
+
+func (c *ClientImpl) CreateSettingsProfile(ctx context.Context, serviceID string, profile SettingsProfile) (*SettingsProfile, error) {
+	query := "CREATE SETTINGS PROFILE " + sql.QuoteIdentifier(profile.Name) + profile.settingsClause(false) + profile.Grantees.toClause(false)
+	if err := c.execQuery(ctx, serviceID, query); err != nil {
+		return nil, err
+	}
+
+	return c.GetSettingsProfile(ctx, serviceID, profile.Name)
+}
+
+// GetSettingsProfile returns nil, nil when no profile with the given name exists.
+func (c *ClientImpl) GetSettingsProfile(ctx context.Context, serviceID string, name string) (*SettingsProfile, error) {
+	profiles, err := queryRows[SQLGrantees](ctx, c, serviceID, fmt.Sprintf(
+		"%sSELECT %s FROM system.settings_profiles WHERE name = %s",
+		granteesWith, granteesSelect, sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, nil
+	}
+
+	elements, err := queryRows[settingsProfileElementRow](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT setting_name, value, min, max, writability, inherit_profile FROM system.settings_profile_elements WHERE profile_name = %s ORDER BY index",
+		sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &SettingsProfile{
+		Name:            name,
+		Settings:        make([]SettingsProfileSetting, 0),
+		InheritProfiles: make([]string, 0),
+		Grantees:        profiles[0],
+	}
+	for _, e := range elements {
+		if e.InheritProfile != nil {
+			profile.InheritProfiles = append(profile.InheritProfiles, *e.InheritProfile)
+		}
+		if e.SettingName != nil {
+			profile.Settings = append(profile.Settings, SettingsProfileSetting{
+				Name:        *e.SettingName,
+				Value:       e.Value,
+				Min:         e.Min,
+				Max:         e.Max,
+				Writability: e.Writability,
+			})
+		}
+	}
+
+	return profile, nil
+}
+
+// UpdateSettingsProfile replaces the settings and grantees of an existing
+// profile in place. ALTER keeps the profile ID, so users and roles that
+// reference the profile keep working.
+func (c *ClientImpl) UpdateSettingsProfile(ctx context.Context, serviceID string, profile SettingsProfile) (*SettingsProfile, error) {
+	query := "ALTER SETTINGS PROFILE " + sql.QuoteIdentifier(profile.Name) + profile.settingsClause(true) + profile.Grantees.toClause(true)
+	if err := c.execQuery(ctx, serviceID, query); err != nil {
+		return nil, err
+	}
+
+	return c.GetSettingsProfile(ctx, serviceID, profile.Name)
+}
+
+func (c *ClientImpl) DeleteSettingsProfile(ctx context.Context, serviceID string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP SETTINGS PROFILE IF EXISTS "+sql.QuoteIdentifier(name))
+}
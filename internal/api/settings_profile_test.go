@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCreateSettingsProfile(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		switch {
+		case strings.Contains(sql, "FROM system.settings_profiles"):
+			return `{"users":["alice"],"roles":["tenant_a"]}`
+		case strings.Contains(sql, "FROM system.settings_profile_elements"):
+			return `{"setting_name":null,"value":null,"min":null,"max":null,"writability":null,"inherit_profile":"default"}` + "\n" +
+				`{"setting_name":"max_memory_usage","value":"10000000000","min":null,"max":"20000000000","writability":"CONST","inherit_profile":null}`
+		}
+		return ""
+	})
+
+	value, maxValue, writability := "10000000000", "20000000000", SettingWritabilityConst
+	profile := SettingsProfile{
+		Name:            "tenant",
+		Settings:        []SettingsProfileSetting{{Name: "max_memory_usage", Value: &value, Max: &maxValue, Writability: &writability}},
+		InheritProfiles: []string{"default"},
+		Grantees:        SQLGrantees{Users: []string{"alice"}, Roles: []string{"tenant_a"}},
+	}
+
+	got, err := client.CreateSettingsProfile(context.Background(), "svc-1", profile)
+	if err != nil {
+		t.Fatalf("CreateSettingsProfile: %v", err)
+	}
+
+	wantSQL := "CREATE SETTINGS PROFILE `tenant` SETTINGS PROFILE 'default', `max_memory_usage` = '10000000000' MAX '20000000000' CONST TO `alice`, `tenant_a`"
+	if diff := cmp.Diff(wantSQL, (*statements)[0]); diff != "" {
+		t.Errorf("CREATE statement mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&profile, got); diff != "" {
+		t.Errorf("CreateSettingsProfile mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSettingsProfile_NotFound(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+
+	got, err := client.GetSettingsProfile(context.Background(), "svc-1", "it's")
+	if err != nil {
+		t.Fatalf("GetSettingsProfile: %v", err)
+	}
+	if got != nil {
+		t.Errorf("GetSettingsProfile = %#v; want nil", got)
+	}
+	if !strings.Contains((*statements)[0], `WHERE name = 'it\'s'`) {
+		t.Errorf("name is not escaped in %q", (*statements)[0])
+	}
+}
+
+func TestUpdateSettingsProfile_ClearsSettingsAndGrantees(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+
+	if _, err := client.UpdateSettingsProfile(context.Background(), "svc-1", SettingsProfile{Name: "tenant"}); err != nil {
+		t.Fatalf("UpdateSettingsProfile: %v", err)
+	}
+
+	want := "ALTER SETTINGS PROFILE `tenant` SETTINGS NONE TO NONE"
+	if got := statementWith(t, *statements, "ALTER"); got != want {
+		t.Errorf("ALTER statement = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// MigrationsTable is the bookkeeping table recording which migrations have
+// been applied to a service. Cluster, when set, adds ON CLUSTER to the
+// statements that create and modify it.
+type MigrationsTable struct {
+	Database string
+	Name     string
+	Cluster  string
+}
+
+type AppliedMigration struct {
+	Version  string `json:"version"`
+	Checksum string `json:"checksum"`
+}
+
+func (t MigrationsTable) qualified() string {
+	return sql.QuoteQualified(t.Database, t.Name)
+}
+
+func (t MigrationsTable) onCluster() string {
+	if t.Cluster == "" {
+		return ""
+	}
+	return " ON CLUSTER " + sql.QuoteIdentifier(t.Cluster)
+}
+
+// EnsureMigrationsTable creates the bookkeeping table if it does not exist.
+func (c *ClientImpl) EnsureMigrationsTable(ctx context.Context, serviceID string, table MigrationsTable) error {
+	return c.execQuery(ctx, serviceID, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s%s (version String, checksum String, applied_at DateTime DEFAULT now()) ENGINE = MergeTree ORDER BY version",
+		table.qualified(), table.onCluster(),
+	))
+}
+
+// GetAppliedMigrations returns the applied migrations ordered by version. A
+// missing bookkeeping table means nothing has been applied yet.
+func (c *ClientImpl) GetAppliedMigrations(ctx context.Context, serviceID string, table MigrationsTable) ([]AppliedMigration, error) {
+	exists, err := queryRows[struct {
+		Result uint8 `json:"result"`
+	}](ctx, c, serviceID, "EXISTS TABLE "+table.qualified())
+	if err != nil {
+		return nil, err
+	}
+	if len(exists) == 0 || exists[0].Result == 0 {
+		return []AppliedMigration{}, nil
+	}
+
+	rows, err := queryRows[AppliedMigration](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT version, argMax(checksum, applied_at) AS checksum FROM %s GROUP BY version ORDER BY version",
+		table.qualified(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ApplyMigration runs statements one by one and then records version. The
+// Query API runs one statement per request, so a failure part way leaves the
+// earlier statements applied and the version unrecorded.
+func (c *ClientImpl) ApplyMigration(ctx context.Context, serviceID string, table MigrationsTable, version string, checksum string, statements []string) error {
+	for i, stmt := range statements {
+		if err := c.execQuery(ctx, serviceID, stmt); err != nil {
+			return fmt.Errorf("migration %s, statement %d: %w", version, i+1, err)
+		}
+	}
+
+	return c.execQuery(ctx, serviceID, fmt.Sprintf(
+		"INSERT INTO %s (version, checksum) VALUES (%s, %s)",
+		table.qualified(), sql.QuoteString(version), sql.QuoteString(checksum),
+	))
+}
+
+// RevertMigration runs the down statements of version and removes it from the
+// bookkeeping table.
+func (c *ClientImpl) RevertMigration(ctx context.Context, serviceID string, table MigrationsTable, version string, statements []string) error {
+	for i, stmt := range statements {
+		if err := c.execQuery(ctx, serviceID, stmt); err != nil {
+			return fmt.Errorf("reverting migration %s, statement %d: %w", version, i+1, err)
+		}
+	}
+
+	return c.execQuery(ctx, serviceID, fmt.Sprintf(
+		"ALTER TABLE %s%s DELETE WHERE version = %s SETTINGS mutations_sync = 2",
+		table.qualified(), table.onCluster(), sql.QuoteString(version),
+	))
+}
+
+// MigrationChecksum is the checksum recorded for a migration's up script.
+// Whitespace at the ends is ignored so a trailing newline in a file does not
+// count as an edit.
+func MigrationChecksum(upSQL string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(upSQL)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetAppliedMigrations(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.HasPrefix(sql, "EXISTS TABLE") {
+			return `{"result":1}`
+		}
+		return "{\"version\":\"001\",\"checksum\":\"a\"}\n{\"version\":\"002\",\"checksum\":\"b\"}\n"
+	})
+
+	got, err := client.GetAppliedMigrations(context.Background(), "svc-1", MigrationsTable{Database: "default", Name: "schema_migrations"})
+	if err != nil {
+		t.Fatalf("GetAppliedMigrations: %v", err)
+	}
+	want := []AppliedMigration{{Version: "001", Checksum: "a"}, {Version: "002", Checksum: "b"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetAppliedMigrations mismatch (-want +got):\n%s", diff)
+	}
+	if (*statements)[0] != "EXISTS TABLE `default`.`schema_migrations`" {
+		t.Errorf("first statement = %q", (*statements)[0])
+	}
+}
+
+func TestGetAppliedMigrations_NoTable(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return `{"result":0}` })
+
+	got, err := client.GetAppliedMigrations(context.Background(), "svc-1", MigrationsTable{Database: "default", Name: "schema_migrations"})
+	if err != nil {
+		t.Fatalf("GetAppliedMigrations: %v", err)
+	}
+	if len(got) != 0 || len(*statements) != 1 {
+		t.Errorf("got %v after %d statements; want nothing after 1", got, len(*statements))
+	}
+}
+
+func TestApplyAndRevertMigration(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+	table := MigrationsTable{Database: "default", Name: "schema_migrations", Cluster: "default"}
+
+	if err := client.ApplyMigration(context.Background(), "svc-1", table, "001", "abc", []string{"CREATE TABLE t (x UInt8) ENGINE = MergeTree ORDER BY x"}); err != nil {
+		t.Fatalf("ApplyMigration: %v", err)
+	}
+	if err := client.RevertMigration(context.Background(), "svc-1", table, "001", []string{"DROP TABLE t"}); err != nil {
+		t.Fatalf("RevertMigration: %v", err)
+	}
+
+	want := []string{
+		"CREATE TABLE t (x UInt8) ENGINE = MergeTree ORDER BY x",
+		"INSERT INTO `default`.`schema_migrations` (version, checksum) VALUES ('001', 'abc')",
+		"DROP TABLE t",
+		"ALTER TABLE `default`.`schema_migrations` ON CLUSTER `default` DELETE WHERE version = '001' SETTINGS mutations_sync = 2",
+	}
+	if diff := cmp.Diff(want, *statements); diff != "" {
+		t.Errorf("statements mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+const (
+	tableEngineView             = "View"
+	tableEngineMaterializedView = "MaterializedView"
+)
+
+// View is a plain (non-materialized) view. Query is the SELECT it wraps.
+type View struct {
+	Database string
+	Name     string
+	Query    string
+}
+
+// MaterializedView writes the result of Query for every block inserted into
+// its source table into ToDatabase.ToTable. Only the TO form is supported:
+// views with an implicit inner table cannot be re-pointed or have their query
+// modified safely.
+type MaterializedView struct {
+	Database   string
+	Name       string
+	ToDatabase string
+	ToTable    string
+	Query      string
+}
+
+type systemTableRow struct {
+	Engine           string `json:"engine"`
+	AsSelect         string `json:"as_select"`
+	CreateTableQuery string `json:"create_table_query"`
+}
+
+// getViewTable reads the system.tables row for database.name. It returns nil,
+// nil when the table does not exist and an error when it exists with another
+// engine, so a view resource never silently adopts a regular table.
+func (c *ClientImpl) getViewTable(ctx context.Context, serviceID, database, name, engine string) (*systemTableRow, error) {
+	rows, err := queryRows[systemTableRow](ctx, c, serviceID, fmt.Sprintf(
+		"SELECT engine, as_select, create_table_query FROM system.tables WHERE database = %s AND name = %s",
+		sql.QuoteString(database), sql.QuoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	if rows[0].Engine != engine {
+		return nil, fmt.Errorf("%s.%s is a %s, not a %s", database, name, rows[0].Engine, engine)
+	}
+	return &rows[0], nil
+}
+
+func (c *ClientImpl) CreateView(ctx context.Context, serviceID string, view View) (*View, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE VIEW "+sql.QuoteQualified(view.Database, view.Name)+" AS "+view.Query); err != nil {
+		return nil, err
+	}
+
+	return c.GetView(ctx, serviceID, view.Database, view.Name)
+}
+
+// GetView returns nil, nil when the view does not exist.
+func (c *ClientImpl) GetView(ctx context.Context, serviceID string, database string, name string) (*View, error) {
+	row, err := c.getViewTable(ctx, serviceID, database, name, tableEngineView)
+	if err != nil || row == nil {
+		return nil, err
+	}
+
+	return &View{Database: database, Name: name, Query: row.AsSelect}, nil
+}
+
+// ReplaceView swaps the view's query in place with CREATE OR REPLACE, so
+// readers never observe the view missing.
+func (c *ClientImpl) ReplaceView(ctx context.Context, serviceID string, view View) (*View, error) {
+	if err := c.execQuery(ctx, serviceID, "CREATE OR REPLACE VIEW "+sql.QuoteQualified(view.Database, view.Name)+" AS "+view.Query); err != nil {
+		return nil, err
+	}
+
+	return c.GetView(ctx, serviceID, view.Database, view.Name)
+}
+
+func (c *ClientImpl) DeleteView(ctx context.Context, serviceID string, database string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP VIEW IF EXISTS "+sql.QuoteQualified(database, name))
+}
+
+func (c *ClientImpl) CreateMaterializedView(ctx context.Context, serviceID string, view MaterializedView) (*MaterializedView, error) {
+	query := fmt.Sprintf("CREATE MATERIALIZED VIEW %s TO %s AS %s",
+		sql.QuoteQualified(view.Database, view.Name), sql.QuoteQualified(view.ToDatabase, view.ToTable), view.Query)
+	if err := c.execQuery(ctx, serviceID, query); err != nil {
+		return nil, err
+	}
+
+	return c.GetMaterializedView(ctx, serviceID, view.Database, view.Name)
+}
+
+// GetMaterializedView returns nil, nil when the view does not exist.
+func (c *ClientImpl) GetMaterializedView(ctx context.Context, serviceID string, database string, name string) (*MaterializedView, error) {
+	row, err := c.getViewTable(ctx, serviceID, database, name, tableEngineMaterializedView)
+	if err != nil || row == nil {
+		return nil, err
+	}
+
+	view := &MaterializedView{Database: database, Name: name, Query: row.AsSelect}
+	toDatabase, toTable, ok := parseMaterializedViewTarget(row.CreateTableQuery)
+	if !ok {
+		return nil, fmt.Errorf("materialized view %s.%s has no TO table; only materialized views writing to an explicit table are supported", database, name)
+	}
+	view.ToDatabase, view.ToTable = toDatabase, toTable
+	if view.ToDatabase == "" {
+		view.ToDatabase = database
+	}
+
+	return view, nil
+}
+
+// UpdateMaterializedViewQuery changes the SELECT of an existing materialized
+// view with ALTER TABLE ... MODIFY QUERY. Unlike dropping and re-creating the
+// view, no block inserted into the source table in the meantime is missed.
+func (c *ClientImpl) UpdateMaterializedViewQuery(ctx context.Context, serviceID string, database string, name string, query string) (*MaterializedView, error) {
+	if err := c.execQuery(ctx, serviceID, "ALTER TABLE "+sql.QuoteQualified(database, name)+" MODIFY QUERY "+query); err != nil {
+		return nil, err
+	}
+
+	return c.GetMaterializedView(ctx, serviceID, database, name)
+}
+
+func (c *ClientImpl) DeleteMaterializedView(ctx context.Context, serviceID string, database string, name string) error {
+	return c.execQuery(ctx, serviceID, "DROP VIEW IF EXISTS "+sql.QuoteQualified(database, name))
+}
+
+// parseMaterializedViewTarget extracts the TO table from a
+// "CREATE MATERIALIZED VIEW db.name [REFRESH ...] TO db.target ..." statement
+// as reported in system.tables.create_table_query.
+func parseMaterializedViewTarget(createQuery string) (string, string, bool) {
+	const prefix = "CREATE MATERIALIZED VIEW"
+	s := strings.TrimSpace(createQuery)
+	if !strings.HasPrefix(strings.ToUpper(s), prefix) {
+		return "", "", false
+	}
+	_, _, rest, ok := sql.ReadQualifiedIdentifier(s[len(prefix):])
+	if !ok {
+		return "", "", false
+	}
+
+	// Walk keywords up to TO, stopping at the column list or AS SELECT.
+	for {
+		word, after, ok := sql.ReadIdentifier(rest)
+		if !ok || strings.EqualFold(word, "AS") {
+			return "", "", false
+		}
+		if strings.EqualFold(word, "TO") {
+			database, table, _, ok := sql.ReadQualifiedIdentifier(after)
+			return database, table, ok
+		}
+		rest = after
+	}
+}
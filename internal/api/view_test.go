@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMaterializedViewTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantDB    string
+		wantTable string
+		wantOK    bool
+	}{
+		{
+			name:      "qualified target with column list",
+			query:     "CREATE MATERIALIZED VIEW default.events_mv TO default.events_hourly\n(\n    `hour` DateTime,\n    `c` UInt64\n)\nAS SELECT toStartOfHour(ts) AS hour, count() AS c FROM default.events GROUP BY hour",
+			wantDB:    "default",
+			wantTable: "events_hourly",
+			wantOK:    true,
+		},
+		{
+			name:      "quoted identifiers",
+			query:     "CREATE MATERIALIZED VIEW `my db`.`mv` TO `other db`.`tgt-1` AS SELECT 1",
+			wantDB:    "other db",
+			wantTable: "tgt-1",
+			wantOK:    true,
+		},
+		{
+			name:      "refreshable view",
+			query:     "CREATE MATERIALIZED VIEW default.mv REFRESH EVERY 1 HOUR TO default.tgt AS SELECT 1",
+			wantDB:    "default",
+			wantTable: "tgt",
+			wantOK:    true,
+		},
+		{
+			name:  "implicit inner table",
+			query: "CREATE MATERIALIZED VIEW default.mv (`a` UInt8) ENGINE = MergeTree ORDER BY a AS SELECT 1 AS a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, table, ok := parseMaterializedViewTarget(tt.query)
+			if ok != tt.wantOK || db != tt.wantDB || table != tt.wantTable {
+				t.Errorf("parseMaterializedViewTarget() = %q, %q, %v; want %q, %q, %v", db, table, ok, tt.wantDB, tt.wantTable, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMaterializedViewLifecycle(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(sql string) string {
+		if strings.Contains(sql, "FROM system.tables") {
+			return `{"engine":"MaterializedView","as_select":"SELECT count() AS c FROM default.events","create_table_query":"CREATE MATERIALIZED VIEW default.mv TO default.tgt (` + "`c`" + ` UInt64) AS SELECT count() AS c FROM default.events"}`
+		}
+		return ""
+	})
+	ctx := context.Background()
+
+	got, err := client.CreateMaterializedView(ctx, "svc-1", MaterializedView{
+		Database: "default", Name: "mv", ToDatabase: "default", ToTable: "tgt", Query: "SELECT count() AS c FROM default.events",
+	})
+	if err != nil {
+		t.Fatalf("CreateMaterializedView: %v", err)
+	}
+	want := &MaterializedView{Database: "default", Name: "mv", ToDatabase: "default", ToTable: "tgt", Query: "SELECT count() AS c FROM default.events"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CreateMaterializedView mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := client.UpdateMaterializedViewQuery(ctx, "svc-1", "default", "mv", "SELECT 2 AS c"); err != nil {
+		t.Fatalf("UpdateMaterializedViewQuery: %v", err)
+	}
+
+	wantStatements := []string{
+		"CREATE MATERIALIZED VIEW `default`.`mv` TO `default`.`tgt` AS SELECT count() AS c FROM default.events",
+		"ALTER TABLE `default`.`mv` MODIFY QUERY SELECT 2 AS c",
+	}
+	gotStatements := []string{statementWith(t, *statements, "CREATE"), statementWith(t, *statements, "ALTER")}
+	if diff := cmp.Diff(wantStatements, gotStatements); diff != "" {
+		t.Errorf("statements mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetView_WrongEngine(t *testing.T) {
+	client, _ := newQueryAPITestClient(t, func(string) string {
+		return `{"engine":"MergeTree","as_select":"","create_table_query":"CREATE TABLE default.events ..."}`
+	})
+
+	if _, err := client.GetView(context.Background(), "svc-1", "default", "events"); err == nil {
+		t.Fatalf("GetView: expected error for a MergeTree table, got nil")
+	}
+}
+
+func TestReplaceView(t *testing.T) {
+	client, statements := newQueryAPITestClient(t, func(string) string { return "" })
+
+	if _, err := client.ReplaceView(context.Background(), "svc-1", View{Database: "default", Name: "v", Query: "SELECT 1"}); err != nil {
+		t.Fatalf("ReplaceView: %v", err)
+	}
+	if got, want := (*statements)[0], "CREATE OR REPLACE VIEW `default`.`v` AS SELECT 1"; got != want {
+		t.Errorf("statement = %q; want %q", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	upstreamdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	upstreamephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -23,7 +24,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &clickhouseProvider{}
+	_ provider.Provider                       = &clickhouseProvider{}
+	_ provider.ProviderWithEphemeralResources = &clickhouseProvider{}
 )
 
 //go:embed README.md
@@ -44,6 +46,7 @@ type clickhouseProvider struct {
 
 type clickhouseProviderModel struct {
 	ApiUrl              types.String `tfsdk:"api_url"`
+	QueryApiUrl         types.String `tfsdk:"query_api_url"`
 	OrganizationID      types.String `tfsdk:"organization_id"`
 	TokenKey            types.String `tfsdk:"token_key"`
 	TokenSecret         types.String `tfsdk:"token_secret"`
@@ -66,6 +69,10 @@ func (p *clickhouseProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: "API URL of the ClickHouse OpenAPI the provider will interact with. Alternatively, can be configured using the `CLICKHOUSE_API_URL` environment variable. Only specify if you have a specific deployment of the ClickHouse OpenAPI you want to run against.",
 				Optional:    true,
 			},
+			"query_api_url": schema.StringAttribute{
+				Description: "Base URL of the ClickHouse Cloud Query API used by resources that run SQL against a service (e.g. clickhouse_settings_profile). Alternatively, can be configured using the `CLICKHOUSE_QUERY_API_URL` environment variable. Only specify if you have a specific deployment of the Query API you want to run against.",
+				Optional:    true,
+			},
 			"organization_id": schema.StringAttribute{
 				Description: "ID of the organization the provider will create services under. Alternatively, can be configured using the `CLICKHOUSE_ORG_ID` environment variable.",
 				Optional:    true,
@@ -123,6 +130,15 @@ func (p *clickhouseProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.QueryApiUrl.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query_api_url"),
+			"Unknown ClickHouse Query API URL",
+			"The provider cannot create the ClickHouse OpenAPI client as there is an unknown configuration value for the Query API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the CLICKHOUSE_QUERY_API_URL environment variable.",
+		)
+	}
+
 	if config.OrganizationID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("organization_id"),
@@ -176,6 +192,7 @@ func (p *clickhouseProvider) Configure(ctx context.Context, req provider.Configu
 	// with Terraform configuration value if set.
 
 	apiUrl := os.Getenv("CLICKHOUSE_API_URL")
+	queryApiUrl := os.Getenv("CLICKHOUSE_QUERY_API_URL")
 	organizationId := os.Getenv("CLICKHOUSE_ORG_ID")
 	// Read credentials from env: prefer new CLICKHOUSE_CLOUD_API_{KEY,SECRET},
 	// fall back to legacy CLICKHOUSE_TOKEN_{KEY,SECRET}.
@@ -196,6 +213,10 @@ func (p *clickhouseProvider) Configure(ctx context.Context, req provider.Configu
 		apiUrl = "https://api.clickhouse.cloud/v1"
 	}
 
+	if !config.QueryApiUrl.IsNull() {
+		queryApiUrl = config.QueryApiUrl.ValueString()
+	}
+
 	if !config.OrganizationID.IsNull() {
 		organizationId = config.OrganizationID.ValueString()
 	}
@@ -284,6 +305,7 @@ func (p *clickhouseProvider) Configure(ctx context.Context, req provider.Configu
 	if cloudConfigured || !clickstackConfigured {
 		clientConfig := api.ClientConfig{
 			ApiURL:         apiUrl,
+			QueryAPIURL:    queryApiUrl,
 			OrganizationID: organizationId,
 			TokenKey:       tokenKey,
 			TokenSecret:    tokenSecret,
@@ -380,10 +402,11 @@ func (p *clickhouseProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
-	// Make the client container available during DataSource and Resource
-	// type Configure methods.
+	// Make the client container available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = data
 	resp.ResourceData = data
+	resp.EphemeralResourceData = data
 }
 
 // configSelected reports whether a provider attribute was explicitly set to a
@@ -431,3 +454,12 @@ func (p *clickhouseProvider) Resources(_ context.Context) []func() upstreamresou
 	}
 	return out
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *clickhouseProvider) EphemeralResources(_ context.Context) []func() upstreamephemeral.EphemeralResource {
+	var out []func() upstreamephemeral.EphemeralResource
+	for _, sp := range p.servicePackages {
+		out = append(out, sp.EphemeralResources()...)
+	}
+	return out
+}
@@ -2,6 +2,7 @@ package clickhouse
 
 import (
 	upstreamdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	upstreamephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	upstreamresource "github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
@@ -29,16 +30,24 @@ func (servicePackage) Resources() []func() upstreamresource.Resource {
 		resource.NewClickPipeCdcInfrastructureResource,
 		resource.NewClickPipeReversePrivateEndpointResource,
 		resource.NewClickPipeReversePrivateEndpointCustomPrivateDNSResource,
+		resource.NewDictionaryResource,
+		resource.NewMaterializedViewResource,
+		resource.NewNamedCollectionResource,
 		resource.NewOrganizationSettingsResource,
 		resource.NewPrivateEndpointRegistrationResource,
+		resource.NewQuotaResource,
 		resource.NewRoleResource,
 		resource.NewRoleAssignmentResource,
+		resource.NewRowPolicyResource,
 		resource.NewServicePrivateEndpointsAttachmentResource,
 		resource.NewServiceScheduledScalingResource,
 		resource.NewServiceTransparentDataEncryptionKeyAssociationResource,
 		resource.NewServiceUpgradeWindowResource,
+		resource.NewSettingsProfileResource,
+		resource.NewSQLMigrationsResource,
 		resource.NewUDFResource,
 		resource.NewUDFAttachmentResource,
+		resource.NewViewResource,
 	}
 }
 
@@ -51,5 +60,10 @@ func (servicePackage) DataSources() []func() upstreamdatasource.DataSource {
 		datasource.NewUserDataSource,
 		datasource.NewServiceDataSource,
 		datasource.NewServicesDataSource,
+		datasource.NewQueryDataSource,
 	}
 }
+
+func (servicePackage) EphemeralResources() []func() upstreamephemeral.EphemeralResource {
+	return nil
+}
@@ -0,0 +1,270 @@
+package datasource
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+//go:embed descriptions/query.md
+var queryDataSourceDescription string
+
+const defaultQueryRowLimit = 1000
+
+var (
+	_ datasource.DataSource                   = &queryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &queryDataSource{}
+)
+
+func NewQueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+type queryDataSource struct {
+	client api.Client
+}
+
+type queryDataSourceModel struct {
+	ServiceID  types.String  `tfsdk:"service_id"`
+	SQL        types.String  `tfsdk:"sql"`
+	Parameters types.Map     `tfsdk:"parameters"`
+	RowLimit   types.Int64   `tfsdk:"row_limit"`
+	Columns    types.List    `tfsdk:"columns"`
+	Rows       types.Dynamic `tfsdk:"rows"`
+}
+
+var queryColumnType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	},
+}
+
+func (d *queryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This data source requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	d.client = providerData.API
+}
+
+func (d *queryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+func (d *queryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: queryDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Description: "ClickHouse Cloud service ID to run the query against.",
+				Required:    true,
+			},
+			"sql": schema.StringAttribute{
+				Description: "A single SELECT statement. Use `{name:Type}` placeholders for values from `parameters`.",
+				Required:    true,
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Values for the `{name:Type}` placeholders in `sql`. Values are sent as string literals cast to the placeholder type; `{name:Identifier}` placeholders become quoted identifiers.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"row_limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of rows the query may return. Reading fails when the result is larger. Defaults to %d.", defaultQueryRowLimit),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100000),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Description: "Columns of the result, in order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Column name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "ClickHouse data type of the column.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"rows": schema.DynamicAttribute{
+				Description: "Result rows as a list of objects keyed by column name. Numeric columns are numbers, `Bool` columns are booleans and all other columns are strings; arrays, maps and tuples are JSON-encoded.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *queryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config queryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SQL.IsNull() || config.SQL.IsUnknown() {
+		return
+	}
+
+	if err := sql.CheckReadOnlyQuery(config.SQL.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sql"), "Invalid query", err.Error())
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data queryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]string{}
+	if !data.Parameters.IsNull() {
+		resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &params, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	query, err := sql.BindParameters(data.SQL.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid query parameters", err.Error())
+		return
+	}
+
+	rowLimit := defaultQueryRowLimit
+	if !data.RowLimit.IsNull() {
+		rowLimit = int(data.RowLimit.ValueInt64())
+	}
+
+	result, err := d.client.RunReadOnlyQuery(ctx, data.ServiceID.ValueString(), query, rowLimit)
+	if err != nil {
+		resp.Diagnostics.AddError("Error running query", err.Error())
+		return
+	}
+
+	columns, rows, diags := queryResultToValues(result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Columns = columns
+	data.Rows = rows
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// queryResultToValues converts a query result into the columns list and the
+// rows value. Every row object has the same attribute types, derived from the
+// ClickHouse column types, so rows is a list rather than a tuple.
+func queryResultToValues(result *api.QueryResult) (types.List, types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	columnValues := make([]attr.Value, 0, len(result.Columns))
+	attrTypes := make(map[string]attr.Type, len(result.Columns))
+	for _, c := range result.Columns {
+		columnValues = append(columnValues, types.ObjectValueMust(queryColumnType.AttrTypes, map[string]attr.Value{
+			"name": types.StringValue(c.Name),
+			"type": types.StringValue(c.Type),
+		}))
+		attrTypes[c.Name] = queryColumnAttrType(c.Type)
+	}
+	columns, d := types.ListValue(queryColumnType, columnValues)
+	diags.Append(d...)
+
+	rowType := types.ObjectType{AttrTypes: attrTypes}
+	rowValues := make([]attr.Value, 0, len(result.Rows))
+	for i, row := range result.Rows {
+		values := make(map[string]attr.Value, len(row))
+		for j, raw := range row {
+			c := result.Columns[j]
+			v, err := queryColumnValue(attrTypes[c.Name], raw)
+			if err != nil {
+				diags.AddError("Error decoding query result", fmt.Sprintf("row %d, column %q: %s", i, c.Name, err))
+				return columns, types.DynamicNull(), diags
+			}
+			values[c.Name] = v
+		}
+		obj, d := types.ObjectValue(attrTypes, values)
+		diags.Append(d...)
+		rowValues = append(rowValues, obj)
+	}
+	rows, d := types.ListValue(rowType, rowValues)
+	diags.Append(d...)
+
+	return columns, types.DynamicValue(rows), diags
+}
+
+func queryColumnAttrType(columnType string) attr.Type {
+	t := api.UnwrapColumnType(columnType)
+	switch {
+	case t == "Bool":
+		return types.BoolType
+	case strings.HasPrefix(t, "Int"), strings.HasPrefix(t, "UInt"), strings.HasPrefix(t, "Float"), strings.HasPrefix(t, "Decimal"):
+		return types.NumberType
+	default:
+		return types.StringType
+	}
+}
+
+func queryColumnValue(t attr.Type, raw json.RawMessage) (attr.Value, error) {
+	isNull := string(raw) == "null"
+	switch t {
+	case types.BoolType:
+		if isNull {
+			return types.BoolNull(), nil
+		}
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, err
+		}
+		return types.BoolValue(b), nil
+	case types.NumberType:
+		if isNull {
+			return types.NumberNull(), nil
+		}
+		// Numbers are parsed from the raw text so 64-bit and wider integers
+		// keep their exact value.
+		n, ok := new(big.Float).SetPrec(256).SetString(strings.Trim(string(raw), `"`))
+		if !ok {
+			return nil, fmt.Errorf("invalid number %s", raw)
+		}
+		return types.NumberValue(n), nil
+	default:
+		if isNull {
+			return types.StringNull(), nil
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			// Arrays, maps and tuples are kept as JSON.
+			return types.StringValue(string(raw)), nil
+		}
+		return types.StringValue(s), nil
+	}
+}
@@ -0,0 +1,51 @@
+package datasource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+)
+
+func TestQueryResultToValues_TypesColumns(t *testing.T) {
+	result := &api.QueryResult{
+		Columns: []api.QueryColumn{
+			{Name: "name", Type: "LowCardinality(String)"},
+			{Name: "n", Type: "Nullable(UInt64)"},
+			{Name: "enabled", Type: "Bool"},
+			{Name: "tags", Type: "Array(String)"},
+		},
+		Rows: [][]json.RawMessage{
+			{json.RawMessage(`"a"`), json.RawMessage(`18446744073709551615`), json.RawMessage(`true`), json.RawMessage(`["x","y"]`)},
+			{json.RawMessage(`"b"`), json.RawMessage(`null`), json.RawMessage(`false`), json.RawMessage(`[]`)},
+		},
+	}
+
+	columns, rows, diags := queryResultToValues(result)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(columns.Elements()) != 4 {
+		t.Fatalf("len(columns) = %d; want 4", len(columns.Elements()))
+	}
+
+	list, ok := rows.UnderlyingValue().(types.List)
+	if !ok || len(list.Elements()) != 2 {
+		t.Fatalf("rows = %s; want a list of 2 objects", rows)
+	}
+	first := list.Elements()[0].(types.Object).Attributes()
+	if got := first["n"].(types.Number).ValueBigFloat().Text('f', 0); got != "18446744073709551615" {
+		t.Errorf("n = %s; want 18446744073709551615", got)
+	}
+	if got := first["enabled"].(types.Bool).ValueBool(); !got {
+		t.Errorf("enabled = %v; want true", got)
+	}
+	if got := first["tags"].(types.String).ValueString(); got != `["x","y"]` {
+		t.Errorf("tags = %q; want JSON array", got)
+	}
+	if second := list.Elements()[1].(types.Object).Attributes(); !second["n"].IsNull() {
+		t.Errorf("second n = %s; want null", second["n"])
+	}
+}
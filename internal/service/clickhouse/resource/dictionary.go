@@ -0,0 +1,415 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &DictionaryResource{}
+	_ resource.ResourceWithConfigure      = &DictionaryResource{}
+	_ resource.ResourceWithImportState    = &DictionaryResource{}
+	_ resource.ResourceWithValidateConfig = &DictionaryResource{}
+)
+
+//go:embed descriptions/dictionary.md
+var dictionaryResourceDescription string
+
+func NewDictionaryResource() resource.Resource {
+	return &DictionaryResource{}
+}
+
+type DictionaryResource struct {
+	client api.Client
+}
+
+func (r *DictionaryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dictionary"
+}
+
+func dictionaryParameterizedAttribute(description, typeDescription, paramsDescription string, sensitive bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: typeDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"params": schema.MapAttribute{
+				Description: paramsDescription,
+				Optional:    true,
+				Sensitive:   sensitive,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *DictionaryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := databaseObjectAttributes("dictionary")
+	attributes["attributes"] = schema.ListNestedAttribute{
+		Description: "Structure of the dictionary: the key columns followed by the attribute columns.",
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Column name.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"type": schema.StringAttribute{
+					Description: "ClickHouse data type of the column, e.g. `UInt64` or `String`.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"default": schema.StringAttribute{
+					Description: "Value returned for keys missing from the source.",
+					Optional:    true,
+				},
+				"expression": schema.StringAttribute{
+					Description: "Expression ClickHouse evaluates on the source to compute the column.",
+					Optional:    true,
+				},
+				"hierarchical": schema.BoolAttribute{
+					Description: "Whether the column holds the parent key of a hierarchy.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+				"injective": schema.BoolAttribute{
+					Description: "Whether the key to value mapping is injective, allowing GROUP BY optimizations.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+				"is_object_id": schema.BoolAttribute{
+					Description: "Whether the column is a MongoDB ObjectID.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+	attributes["primary_key"] = schema.ListAttribute{
+		Description: "Names of the key columns, which must also be declared in `attributes`. Layouts such as `complex_key_hashed` accept more than one.",
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+	attributes["source"] = dictionaryParameterizedAttribute(
+		"Where the dictionary loads its data from (the `SOURCE` clause).",
+		"Source type, e.g. `clickhouse`, `postgresql` or `http`.",
+		"Source parameters, e.g. `{ name = \"my_collection\", table = \"rates\" }`. Prefer referencing a named collection over inlining credentials. ClickHouse does not return source parameters, so changes made outside Terraform are not detected.",
+		true,
+	)
+	attributes["layout"] = dictionaryParameterizedAttribute(
+		"How the dictionary is stored in memory (the `LAYOUT` clause).",
+		"Layout type, e.g. `flat`, `hashed` or `complex_key_hashed`.",
+		"Layout parameters, e.g. `{ size_in_cells = \"1000000\" }` for cache layouts.",
+		false,
+	)
+	attributes["lifetime"] = schema.SingleNestedAttribute{
+		Description: "Reload interval in seconds; ClickHouse reloads at a random point within the range. When omitted, the dictionary is never reloaded.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Int64Attribute{
+				Description: "Lower bound of the reload interval in seconds.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max": schema.Int64Attribute{
+				Description: "Upper bound of the reload interval in seconds.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtLeastSumOf(path.MatchRelative().AtParent().AtName("min")),
+				},
+			},
+		},
+	}
+	attributes["comment"] = schema.StringAttribute{
+		Description: "Comment attached to the dictionary.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: dictionaryResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *DictionaryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *DictionaryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_dictionary", &resp.Diagnostics)
+}
+
+func (r *DictionaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.DictionaryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dictionary, diags := dictionaryFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateDictionary(ctx, plan.ServiceID.ValueString(), dictionary)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating dictionary", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating dictionary", fmt.Sprintf("dictionary %s.%s was not found after creation", dictionary.Database, dictionary.Name))
+		return
+	}
+
+	plan.ID = databaseObjectID(plan.ServiceID, plan.Database, plan.Name)
+	resp.Diagnostics.Append(applyDictionaryToState(ctx, created, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DictionaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_dictionary", &resp.Diagnostics)
+	var state models.DictionaryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dictionary, err := r.client.GetDictionary(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dictionary", err.Error())
+		return
+	}
+	if dictionary == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = databaseObjectID(state.ServiceID, state.Database, state.Name)
+	resp.Diagnostics.Append(applyDictionaryToState(ctx, dictionary, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DictionaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DictionaryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dictionary, diags := dictionaryFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ClickHouse has no ALTER DICTIONARY; every change redefines it in place.
+	updated, err := r.client.ReplaceDictionary(ctx, plan.ServiceID.ValueString(), dictionary)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating dictionary", err.Error())
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error updating dictionary", fmt.Sprintf("dictionary %s.%s was not found after replacing it", dictionary.Database, dictionary.Name))
+		return
+	}
+
+	resp.Diagnostics.Append(applyDictionaryToState(ctx, updated, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DictionaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DictionaryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDictionary(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting dictionary", err.Error())
+	}
+}
+
+func (r *DictionaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDatabaseObject(ctx, "dictionary", req, resp)
+}
+
+func dictionaryFromModel(ctx context.Context, m models.DictionaryResourceModel) (api.Dictionary, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	dictionary := api.Dictionary{
+		Database:   m.Database.ValueString(),
+		Name:       m.Name.ValueString(),
+		Attributes: make([]api.DictionaryAttribute, 0),
+		PrimaryKey: make([]string, 0),
+		Comment:    m.Comment.ValueString(),
+	}
+
+	var attributes []models.DictionaryAttributeModel
+	diags.Append(m.Attributes.ElementsAs(ctx, &attributes, false)...)
+	for _, a := range attributes {
+		dictionary.Attributes = append(dictionary.Attributes, api.DictionaryAttribute{
+			Name:         a.Name.ValueString(),
+			Type:         a.Type.ValueString(),
+			Default:      a.Default.ValueStringPointer(),
+			Expression:   a.Expression.ValueStringPointer(),
+			Hierarchical: a.Hierarchical.ValueBool(),
+			Injective:    a.Injective.ValueBool(),
+			IsObjectID:   a.IsObjectID.ValueBool(),
+		})
+	}
+	diags.Append(m.PrimaryKey.ElementsAs(ctx, &dictionary.PrimaryKey, false)...)
+
+	source, d := dictionaryParameterizedFromModel(ctx, m.Source)
+	diags.Append(d...)
+	dictionary.Source = source
+	layout, d := dictionaryParameterizedFromModel(ctx, m.Layout)
+	diags.Append(d...)
+	dictionary.Layout = layout
+
+	if !m.Lifetime.IsNull() && !m.Lifetime.IsUnknown() {
+		var lifetime models.DictionaryLifetimeModel
+		diags.Append(m.Lifetime.As(ctx, &lifetime, basetypes.ObjectAsOptions{})...)
+		dictionary.LifetimeMin = lifetime.Min.ValueInt64()
+		dictionary.LifetimeMax = lifetime.Max.ValueInt64()
+	}
+
+	return dictionary, diags
+}
+
+func dictionaryParameterizedFromModel(ctx context.Context, o types.Object) (api.DictionaryParameterized, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var m models.DictionaryParameterizedModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+
+	p := api.DictionaryParameterized{Type: m.Type.ValueString(), Params: map[string]string{}}
+	if !m.Params.IsNull() && !m.Params.IsUnknown() {
+		diags.Append(m.Params.ElementsAs(ctx, &p.Params, false)...)
+	}
+	return p, diags
+}
+
+// applyDictionaryToState maps a dictionary read from system.dictionaries into
+// state. ClickHouse only reports column names and types, so the configured
+// attributes are kept while those match and rebuilt from the server otherwise.
+// Source and layout are not reported at all and always come from state.
+func applyDictionaryToState(ctx context.Context, dictionary *api.Dictionary, state *models.DictionaryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prior []models.DictionaryAttributeModel
+	if !state.Attributes.IsNull() && !state.Attributes.IsUnknown() {
+		diags.Append(state.Attributes.ElementsAs(ctx, &prior, false)...)
+	}
+	if !dictionaryColumnsMatch(prior, dictionary.Attributes) {
+		values := make([]attr.Value, 0, len(dictionary.Attributes))
+		for _, a := range dictionary.Attributes {
+			values = append(values, models.DictionaryAttributeModel{
+				Name:         types.StringValue(a.Name),
+				Type:         types.StringValue(a.Type),
+				Default:      types.StringPointerValue(a.Default),
+				Expression:   types.StringPointerValue(a.Expression),
+				Hierarchical: types.BoolValue(a.Hierarchical),
+				Injective:    types.BoolValue(a.Injective),
+				IsObjectID:   types.BoolValue(a.IsObjectID),
+			}.ObjectValue())
+		}
+		list, d := types.ListValue(models.DictionaryAttributeModel{}.ObjectType(), values)
+		diags.Append(d...)
+		state.Attributes = list
+	}
+
+	primaryKey, d := types.ListValueFrom(ctx, types.StringType, dictionary.PrimaryKey)
+	diags.Append(d...)
+	state.PrimaryKey = primaryKey
+
+	if dictionary.LifetimeMin == 0 && dictionary.LifetimeMax == 0 && (state.Lifetime.IsNull() || state.Lifetime.IsUnknown()) {
+		state.Lifetime = types.ObjectNull(models.DictionaryLifetimeModel{}.ObjectType().AttrTypes)
+	} else {
+		state.Lifetime = models.DictionaryLifetimeModel{
+			Min: types.Int64Value(dictionary.LifetimeMin),
+			Max: types.Int64Value(dictionary.LifetimeMax),
+		}.ObjectValue()
+	}
+
+	if dictionary.Comment == "" && state.Comment.IsNull() {
+		state.Comment = types.StringNull()
+	} else {
+		state.Comment = types.StringValue(dictionary.Comment)
+	}
+
+	if state.Source.IsUnknown() {
+		state.Source = types.ObjectNull(models.DictionaryParameterizedModel{}.ObjectType().AttrTypes)
+	}
+	if state.Layout.IsUnknown() {
+		state.Layout = types.ObjectNull(models.DictionaryParameterizedModel{}.ObjectType().AttrTypes)
+	}
+
+	return diags
+}
+
+// dictionaryColumnsMatch reports whether the configured attributes declare the
+// same columns, in any order, as ClickHouse reports.
+func dictionaryColumnsMatch(prior []models.DictionaryAttributeModel, actual []api.DictionaryAttribute) bool {
+	if len(prior) == 0 || len(prior) != len(actual) {
+		return false
+	}
+	columns := make(map[string]string, len(actual))
+	for _, a := range actual {
+		columns[a.Name] = a.Type
+	}
+	for _, p := range prior {
+		t, ok := columns[p.Name.ValueString()]
+		if !ok || t != p.Type.ValueString() {
+			return false
+		}
+	}
+	return true
+}
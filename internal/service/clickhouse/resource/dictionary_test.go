@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+)
+
+func TestApplyDictionaryToState(t *testing.T) {
+	ctx := context.Background()
+	configured := func(name, typ string) attr.Value {
+		return models.DictionaryAttributeModel{
+			Name:         types.StringValue(name),
+			Type:         types.StringValue(typ),
+			Default:      types.StringValue("unknown"),
+			Expression:   types.StringNull(),
+			Hierarchical: types.BoolValue(false),
+			Injective:    types.BoolValue(false),
+			IsObjectID:   types.BoolValue(false),
+		}.ObjectValue()
+	}
+	attributes := types.ListValueMust(models.DictionaryAttributeModel{}.ObjectType(), []attr.Value{
+		configured("name", "String"),
+		configured("code", "String"),
+	})
+
+	dictionary := &api.Dictionary{
+		Attributes: []api.DictionaryAttribute{{Name: "code", Type: "String"}, {Name: "name", Type: "String"}},
+		PrimaryKey: []string{"code"},
+	}
+
+	t.Run("matching columns keep configuration", func(t *testing.T) {
+		state := models.DictionaryResourceModel{Attributes: attributes, Lifetime: types.ObjectNull(models.DictionaryLifetimeModel{}.ObjectType().AttrTypes)}
+		if diags := applyDictionaryToState(ctx, dictionary, &state); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !state.Attributes.Equal(attributes) {
+			t.Errorf("attributes = %s, want configured list", state.Attributes)
+		}
+		if !state.Lifetime.IsNull() || !state.Comment.IsNull() {
+			t.Errorf("lifetime = %s, comment = %s; want both null", state.Lifetime, state.Comment)
+		}
+	})
+
+	t.Run("changed type is read from the server", func(t *testing.T) {
+		changed := *dictionary
+		changed.Attributes = []api.DictionaryAttribute{{Name: "code", Type: "String"}, {Name: "name", Type: "LowCardinality(String)"}}
+		state := models.DictionaryResourceModel{Attributes: attributes}
+		if diags := applyDictionaryToState(ctx, &changed, &state); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		var got []models.DictionaryAttributeModel
+		state.Attributes.ElementsAs(ctx, &got, false)
+		if len(got) != 2 || got[0].Name.ValueString() != "code" || got[1].Type.ValueString() != "LowCardinality(String)" {
+			t.Errorf("attributes = %s, want server columns", state.Attributes)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &MaterializedViewResource{}
+	_ resource.ResourceWithConfigure      = &MaterializedViewResource{}
+	_ resource.ResourceWithImportState    = &MaterializedViewResource{}
+	_ resource.ResourceWithValidateConfig = &MaterializedViewResource{}
+)
+
+//go:embed descriptions/materialized_view.md
+var materializedViewResourceDescription string
+
+func NewMaterializedViewResource() resource.Resource {
+	return &MaterializedViewResource{}
+}
+
+type MaterializedViewResource struct {
+	client api.Client
+}
+
+func (r *MaterializedViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_materialized_view"
+}
+
+func (r *MaterializedViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := databaseObjectAttributes("materialized view")
+	attributes["to_database"] = schema.StringAttribute{
+		Description: "Database of the table the view writes to. Defaults to `database`.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["to_table"] = schema.StringAttribute{
+		Description: "Table the view writes to (the `TO` clause). The table must exist and have columns matching the query's output.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["query"] = viewQueryAttribute("SELECT query run on every block inserted into the source table. Changes are applied in place with `ALTER TABLE ... MODIFY QUERY`; differences in whitespace and keyword case are not reported as changes.")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: materializedViewResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *MaterializedViewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *MaterializedViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_materialized_view", &resp.Diagnostics)
+}
+
+func (r *MaterializedViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.MaterializedViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toDatabase := plan.Database.ValueString()
+	if !plan.ToDatabase.IsNull() {
+		toDatabase = plan.ToDatabase.ValueString()
+	}
+
+	view, err := r.client.CreateMaterializedView(ctx, plan.ServiceID.ValueString(), api.MaterializedView{
+		Database:   plan.Database.ValueString(),
+		Name:       plan.Name.ValueString(),
+		ToDatabase: toDatabase,
+		ToTable:    plan.ToTable.ValueString(),
+		Query:      plan.Query.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating materialized view", err.Error())
+		return
+	}
+	if view == nil {
+		resp.Diagnostics.AddError("Error creating materialized view", fmt.Sprintf("materialized view %s.%s was not found after creation", plan.Database.ValueString(), plan.Name.ValueString()))
+		return
+	}
+
+	plan.ID = databaseObjectID(plan.ServiceID, plan.Database, plan.Name)
+	applyMaterializedViewToState(view, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MaterializedViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_materialized_view", &resp.Diagnostics)
+	var state models.MaterializedViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.client.GetMaterializedView(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading materialized view", err.Error())
+		return
+	}
+	if view == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = databaseObjectID(state.ServiceID, state.Database, state.Name)
+	applyMaterializedViewToState(view, &state)
+	state.Query = readViewQuery(state.Query, view.Query)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MaterializedViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.MaterializedViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything but the query forces replacement, so an update is always a
+	// query change.
+	view, err := r.client.UpdateMaterializedViewQuery(ctx, plan.ServiceID.ValueString(), plan.Database.ValueString(), plan.Name.ValueString(), plan.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating materialized view query", err.Error())
+		return
+	}
+	if view == nil {
+		resp.Diagnostics.AddError("Error updating materialized view query", fmt.Sprintf("materialized view %s.%s no longer exists", plan.Database.ValueString(), plan.Name.ValueString()))
+		return
+	}
+
+	applyMaterializedViewToState(view, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MaterializedViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.MaterializedViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMaterializedView(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting materialized view", err.Error())
+	}
+}
+
+func (r *MaterializedViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDatabaseObject(ctx, "materialized view", req, resp)
+}
+
+// applyMaterializedViewToState maps a materialized view read from ClickHouse
+// into state. to_database stays null when it was omitted and the view writes
+// to its own database.
+func applyMaterializedViewToState(view *api.MaterializedView, state *models.MaterializedViewResourceModel) {
+	if state.ToDatabase.IsNull() && view.ToDatabase == state.Database.ValueString() {
+		state.ToDatabase = types.StringNull()
+	} else {
+		state.ToDatabase = types.StringValue(view.ToDatabase)
+	}
+	state.ToTable = types.StringValue(view.ToTable)
+}
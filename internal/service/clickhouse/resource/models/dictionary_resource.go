@@ -0,0 +1,101 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type DictionaryAttributeModel struct {
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Default      types.String `tfsdk:"default"`
+	Expression   types.String `tfsdk:"expression"`
+	Hierarchical types.Bool   `tfsdk:"hierarchical"`
+	Injective    types.Bool   `tfsdk:"injective"`
+	IsObjectID   types.Bool   `tfsdk:"is_object_id"`
+}
+
+func (d DictionaryAttributeModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":         types.StringType,
+			"type":         types.StringType,
+			"default":      types.StringType,
+			"expression":   types.StringType,
+			"hierarchical": types.BoolType,
+			"injective":    types.BoolType,
+			"is_object_id": types.BoolType,
+		},
+	}
+}
+
+func (d DictionaryAttributeModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(d.ObjectType().AttrTypes, map[string]attr.Value{
+		"name":         d.Name,
+		"type":         d.Type,
+		"default":      d.Default,
+		"expression":   d.Expression,
+		"hierarchical": d.Hierarchical,
+		"injective":    d.Injective,
+		"is_object_id": d.IsObjectID,
+	})
+}
+
+// DictionaryParameterizedModel backs both the source and the layout blocks.
+type DictionaryParameterizedModel struct {
+	Type   types.String `tfsdk:"type"`
+	Params types.Map    `tfsdk:"params"`
+}
+
+func (d DictionaryParameterizedModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":   types.StringType,
+			"params": types.MapType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (d DictionaryParameterizedModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(d.ObjectType().AttrTypes, map[string]attr.Value{
+		"type":   d.Type,
+		"params": d.Params,
+	})
+}
+
+type DictionaryLifetimeModel struct {
+	Min types.Int64 `tfsdk:"min"`
+	Max types.Int64 `tfsdk:"max"`
+}
+
+func (d DictionaryLifetimeModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"min": types.Int64Type,
+			"max": types.Int64Type,
+		},
+	}
+}
+
+func (d DictionaryLifetimeModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(d.ObjectType().AttrTypes, map[string]attr.Value{
+		"min": d.Min,
+		"max": d.Max,
+	})
+}
+
+// DictionaryResourceModel is the Terraform state model for the
+// clickhouse_dictionary resource.
+type DictionaryResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	Attributes types.List   `tfsdk:"attributes"`
+	PrimaryKey types.List   `tfsdk:"primary_key"`
+	Source     types.Object `tfsdk:"source"`
+	Layout     types.Object `tfsdk:"layout"`
+	Lifetime   types.Object `tfsdk:"lifetime"`
+	Comment    types.String `tfsdk:"comment"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NamedCollectionResourceModel is the Terraform state model for the
+// clickhouse_named_collection resource. SecretParamsWO is write-only and is
+// always null in state; SecretKeys records which keys it set.
+type NamedCollectionResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ServiceID             types.String `tfsdk:"service_id"`
+	Name                  types.String `tfsdk:"name"`
+	Params                types.Map    `tfsdk:"params"`
+	SecretParamsWO        types.Map    `tfsdk:"secret_params_wo"`
+	SecretParamsWOVersion types.Int64  `tfsdk:"secret_params_wo_version"`
+	SecretKeys            types.Set    `tfsdk:"secret_keys"`
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type QuotaIntervalModel struct {
+	DurationSeconds  types.Int64   `tfsdk:"duration_seconds"`
+	Randomized       types.Bool    `tfsdk:"randomized"`
+	MaxQueries       types.Int64   `tfsdk:"max_queries"`
+	MaxQuerySelects  types.Int64   `tfsdk:"max_query_selects"`
+	MaxQueryInserts  types.Int64   `tfsdk:"max_query_inserts"`
+	MaxErrors        types.Int64   `tfsdk:"max_errors"`
+	MaxResultRows    types.Int64   `tfsdk:"max_result_rows"`
+	MaxResultBytes   types.Int64   `tfsdk:"max_result_bytes"`
+	MaxReadRows      types.Int64   `tfsdk:"max_read_rows"`
+	MaxReadBytes     types.Int64   `tfsdk:"max_read_bytes"`
+	MaxWrittenBytes  types.Int64   `tfsdk:"max_written_bytes"`
+	MaxExecutionTime types.Float64 `tfsdk:"max_execution_time"`
+}
+
+func (q QuotaIntervalModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"duration_seconds":   types.Int64Type,
+			"randomized":         types.BoolType,
+			"max_queries":        types.Int64Type,
+			"max_query_selects":  types.Int64Type,
+			"max_query_inserts":  types.Int64Type,
+			"max_errors":         types.Int64Type,
+			"max_result_rows":    types.Int64Type,
+			"max_result_bytes":   types.Int64Type,
+			"max_read_rows":      types.Int64Type,
+			"max_read_bytes":     types.Int64Type,
+			"max_written_bytes":  types.Int64Type,
+			"max_execution_time": types.Float64Type,
+		},
+	}
+}
+
+func (q QuotaIntervalModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(q.ObjectType().AttrTypes, map[string]attr.Value{
+		"duration_seconds":   q.DurationSeconds,
+		"randomized":         q.Randomized,
+		"max_queries":        q.MaxQueries,
+		"max_query_selects":  q.MaxQuerySelects,
+		"max_query_inserts":  q.MaxQueryInserts,
+		"max_errors":         q.MaxErrors,
+		"max_result_rows":    q.MaxResultRows,
+		"max_result_bytes":   q.MaxResultBytes,
+		"max_read_rows":      q.MaxReadRows,
+		"max_read_bytes":     q.MaxReadBytes,
+		"max_written_bytes":  q.MaxWrittenBytes,
+		"max_execution_time": q.MaxExecutionTime,
+	})
+}
+
+// QuotaResourceModel is the Terraform state model for the clickhouse_quota
+// resource.
+type QuotaResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ServiceID    types.String `tfsdk:"service_id"`
+	Name         types.String `tfsdk:"name"`
+	KeyedBy      types.String `tfsdk:"keyed_by"`
+	Intervals    types.List   `tfsdk:"intervals"`
+	ApplyToUsers types.Set    `tfsdk:"apply_to_users"`
+	ApplyToRoles types.Set    `tfsdk:"apply_to_roles"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RowPolicyResourceModel is the Terraform state model for the
+// clickhouse_row_policy resource.
+type RowPolicyResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ServiceID    types.String `tfsdk:"service_id"`
+	Name         types.String `tfsdk:"name"`
+	Database     types.String `tfsdk:"database"`
+	Table        types.String `tfsdk:"table"`
+	Using        types.String `tfsdk:"using"`
+	Restrictive  types.Bool   `tfsdk:"restrictive"`
+	ApplyToUsers types.Set    `tfsdk:"apply_to_users"`
+	ApplyToRoles types.Set    `tfsdk:"apply_to_roles"`
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type SettingsProfileSettingModel struct {
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Min         types.String `tfsdk:"min"`
+	Max         types.String `tfsdk:"max"`
+	Writability types.String `tfsdk:"writability"`
+}
+
+func (s SettingsProfileSettingModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":        types.StringType,
+			"value":       types.StringType,
+			"min":         types.StringType,
+			"max":         types.StringType,
+			"writability": types.StringType,
+		},
+	}
+}
+
+func (s SettingsProfileSettingModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(s.ObjectType().AttrTypes, map[string]attr.Value{
+		"name":        s.Name,
+		"value":       s.Value,
+		"min":         s.Min,
+		"max":         s.Max,
+		"writability": s.Writability,
+	})
+}
+
+// SettingsProfileResourceModel is the Terraform state model for the
+// clickhouse_settings_profile resource.
+type SettingsProfileResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ServiceID       types.String `tfsdk:"service_id"`
+	Name            types.String `tfsdk:"name"`
+	Settings        types.List   `tfsdk:"settings"`
+	InheritProfiles types.List   `tfsdk:"inherit_profiles"`
+	ApplyToUsers    types.Set    `tfsdk:"apply_to_users"`
+	ApplyToRoles    types.Set    `tfsdk:"apply_to_roles"`
+}
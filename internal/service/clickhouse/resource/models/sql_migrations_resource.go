@@ -0,0 +1,65 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type SQLMigrationModel struct {
+	Version types.String `tfsdk:"version"`
+	UpSQL   types.String `tfsdk:"up_sql"`
+	DownSQL types.String `tfsdk:"down_sql"`
+}
+
+func (m SQLMigrationModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"version":  types.StringType,
+			"up_sql":   types.StringType,
+			"down_sql": types.StringType,
+		},
+	}
+}
+
+func (m SQLMigrationModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"version":  m.Version,
+		"up_sql":   m.UpSQL,
+		"down_sql": m.DownSQL,
+	})
+}
+
+type AppliedSQLMigrationModel struct {
+	Version  types.String `tfsdk:"version"`
+	Checksum types.String `tfsdk:"checksum"`
+}
+
+func (m AppliedSQLMigrationModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"version":  types.StringType,
+			"checksum": types.StringType,
+		},
+	}
+}
+
+func (m AppliedSQLMigrationModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"version":  m.Version,
+		"checksum": m.Checksum,
+	})
+}
+
+// SQLMigrationsResourceModel is the Terraform state model for the
+// clickhouse_sql_migrations resource.
+type SQLMigrationsResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+	Directory  types.String `tfsdk:"directory"`
+	Migrations types.List   `tfsdk:"migrations"`
+	Database   types.String `tfsdk:"database"`
+	Table      types.String `tfsdk:"table"`
+	OnCluster  types.String `tfsdk:"on_cluster"`
+	Applied    types.List   `tfsdk:"applied"`
+}
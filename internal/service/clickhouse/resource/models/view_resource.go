@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ViewResourceModel is the Terraform state model for the clickhouse_view
+// resource.
+type ViewResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Database  types.String `tfsdk:"database"`
+	Name      types.String `tfsdk:"name"`
+	Query     types.String `tfsdk:"query"`
+}
+
+// MaterializedViewResourceModel is the Terraform state model for the
+// clickhouse_materialized_view resource.
+type MaterializedViewResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	ToDatabase types.String `tfsdk:"to_database"`
+	ToTable    types.String `tfsdk:"to_table"`
+	Query      types.String `tfsdk:"query"`
+}
@@ -0,0 +1,401 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &NamedCollectionResource{}
+	_ resource.ResourceWithConfigure      = &NamedCollectionResource{}
+	_ resource.ResourceWithImportState    = &NamedCollectionResource{}
+	_ resource.ResourceWithModifyPlan     = &NamedCollectionResource{}
+	_ resource.ResourceWithValidateConfig = &NamedCollectionResource{}
+)
+
+//go:embed descriptions/named_collection.md
+var namedCollectionResourceDescription string
+
+func NewNamedCollectionResource() resource.Resource {
+	return &NamedCollectionResource{}
+}
+
+type NamedCollectionResource struct {
+	client api.Client
+}
+
+func (r *NamedCollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_named_collection"
+}
+
+func (r *NamedCollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: namedCollectionResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier in the form `service_id/name`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ClickHouse Cloud service ID the named collection is created in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the named collection.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"params": schema.MapAttribute{
+				Description: "Non-secret keys of the collection, e.g. `url` or `format`. Values are read back and drift is detected.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"secret_params_wo": schema.MapAttribute{
+				Description: "Secret keys of the collection, e.g. `access_key_id` or `password` (write-only, not persisted to state). Values are only sent when `secret_params_wo_version` changes or the set of secret keys changes.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					mapvalidator.AlsoRequires(path.Expressions{path.MatchRoot("secret_params_wo_version")}...),
+				},
+			},
+			"secret_params_wo_version": schema.Int64Attribute{
+				Description: "Version number for secret_params_wo. Increment this to trigger an update of the secret values.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.Expressions{path.MatchRoot("secret_params_wo")}...),
+				},
+			},
+			"secret_keys": schema.SetAttribute{
+				Description: "Keys set from `secret_params_wo`. Their values are never read back.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *NamedCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *NamedCollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_named_collection", &resp.Diagnostics)
+
+	var config models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Params.IsUnknown() || config.SecretParamsWO.IsUnknown() {
+		return
+	}
+
+	params := config.Params.Elements()
+	secrets := config.SecretParamsWO.Elements()
+	if len(params) == 0 && len(secrets) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("params"), "Empty named collection",
+			"A named collection needs at least one key in `params` or `secret_params_wo`.")
+	}
+	for key := range secrets {
+		if _, ok := params[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("secret_params_wo"), "Duplicate named collection key",
+				fmt.Sprintf("Key %q is set in both `params` and `secret_params_wo`.", key))
+		}
+	}
+}
+
+// ModifyPlan derives secret_keys from the write-only map, which is only
+// available in the configuration, so adding or removing a secret key shows up
+// in the plan even without a version bump.
+func (r *NamedCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretKeys := types.SetNull(types.StringType)
+	switch {
+	case config.SecretParamsWO.IsUnknown():
+		secretKeys = types.SetUnknown(types.StringType)
+	case !config.SecretParamsWO.IsNull():
+		keys := make([]string, 0, len(config.SecretParamsWO.Elements()))
+		for k := range config.SecretParamsWO.Elements() {
+			keys = append(keys, k)
+		}
+		set, diags := types.SetValueFrom(ctx, types.StringType, keys)
+		resp.Diagnostics.Append(diags...)
+		secretKeys = set
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_keys"), secretKeys)...)
+}
+
+func (r *NamedCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := api.NamedCollection{Name: plan.Name.ValueString(), Params: map[string]string{}}
+	resp.Diagnostics.Append(mergeNamedCollectionParams(ctx, collection.Params, plan.Params)...)
+	resp.Diagnostics.Append(mergeNamedCollectionParams(ctx, collection.Params, config.SecretParamsWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNamedCollection(ctx, plan.ServiceID.ValueString(), collection)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating named collection", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating named collection", fmt.Sprintf("named collection %q was not found after creation", collection.Name))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + collection.Name)
+	resp.Diagnostics.Append(applyNamedCollectionToState(ctx, created, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NamedCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_named_collection", &resp.Diagnostics)
+	var state models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetNamedCollection(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading named collection", err.Error())
+		return
+	}
+	if collection == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	resp.Diagnostics.Append(applyNamedCollectionToState(ctx, collection, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NamedCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, deleted, diags := namedCollectionChanges(ctx, plan, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNamedCollection(ctx, plan.ServiceID.ValueString(), plan.Name.ValueString(), set, deleted)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating named collection", err.Error())
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error updating named collection", fmt.Sprintf("named collection %q no longer exists", plan.Name.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(applyNamedCollectionToState(ctx, updated, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NamedCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.NamedCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNamedCollection(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting named collection", err.Error())
+	}
+}
+
+func (r *NamedCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid named collection import ID",
+			fmt.Sprintf("Expected service_id/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func mergeNamedCollectionParams(ctx context.Context, dst map[string]string, m types.Map) diag.Diagnostics {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	var values map[string]string
+	diags := m.ElementsAs(ctx, &values, false)
+	for k, v := range values {
+		dst[k] = v
+	}
+	return diags
+}
+
+// namedCollectionChanges computes the ALTER NAMED COLLECTION for an update.
+// Changed params are set; secrets are only sent when the version or the set of
+// secret keys changed, since their previous values are unknown; keys no longer
+// configured are deleted.
+func namedCollectionChanges(ctx context.Context, plan, state, config models.NamedCollectionResourceModel) (map[string]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	planned, prior := map[string]string{}, map[string]string{}
+	diags.Append(mergeNamedCollectionParams(ctx, planned, plan.Params)...)
+	diags.Append(mergeNamedCollectionParams(ctx, prior, state.Params)...)
+
+	set := map[string]string{}
+	for k, v := range planned {
+		if p, ok := prior[k]; !ok || p != v {
+			set[k] = v
+		}
+	}
+
+	if !plan.SecretParamsWOVersion.Equal(state.SecretParamsWOVersion) || !plan.SecretKeys.Equal(state.SecretKeys) {
+		diags.Append(mergeNamedCollectionParams(ctx, set, config.SecretParamsWO)...)
+	}
+
+	wanted := map[string]struct{}{}
+	for k := range planned {
+		wanted[k] = struct{}{}
+	}
+	var plannedSecrets, priorSecrets []string
+	if !plan.SecretKeys.IsNull() && !plan.SecretKeys.IsUnknown() {
+		diags.Append(plan.SecretKeys.ElementsAs(ctx, &plannedSecrets, false)...)
+	}
+	if !state.SecretKeys.IsNull() && !state.SecretKeys.IsUnknown() {
+		diags.Append(state.SecretKeys.ElementsAs(ctx, &priorSecrets, false)...)
+	}
+	for _, k := range plannedSecrets {
+		wanted[k] = struct{}{}
+	}
+
+	deleted := make([]string, 0)
+	for _, k := range append(mapKeysOf(prior), priorSecrets...) {
+		if _, ok := wanted[k]; !ok {
+			deleted = append(deleted, k)
+		}
+	}
+	sort.Strings(deleted)
+
+	return set, deleted, diags
+}
+
+func mapKeysOf(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// applyNamedCollectionToState maps a named collection read from ClickHouse into
+// state. Secret keys are skipped, and values ClickHouse hides from the current
+// user keep their state value. A secret key missing on the server is dropped
+// from secret_keys, so the next plan sends the secrets again.
+func applyNamedCollectionToState(ctx context.Context, collection *api.NamedCollection, state *models.NamedCollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	secret := map[string]struct{}{}
+	if !state.SecretKeys.IsNull() && !state.SecretKeys.IsUnknown() {
+		var keys []string
+		diags.Append(state.SecretKeys.ElementsAs(ctx, &keys, false)...)
+		for _, k := range keys {
+			secret[k] = struct{}{}
+		}
+	}
+	prior := map[string]string{}
+	diags.Append(mergeNamedCollectionParams(ctx, prior, state.Params)...)
+
+	params := map[string]string{}
+	secretKeys := make([]string, 0)
+	for k, v := range collection.Params {
+		if _, ok := secret[k]; ok {
+			secretKeys = append(secretKeys, k)
+			continue
+		}
+		if p, ok := prior[k]; ok && v == api.NamedCollectionHiddenValue {
+			v = p
+		}
+		params[k] = v
+	}
+	sort.Strings(secretKeys)
+
+	if len(params) == 0 && state.Params.IsNull() {
+		state.Params = types.MapNull(types.StringType)
+	} else {
+		m, d := types.MapValueFrom(ctx, types.StringType, params)
+		diags.Append(d...)
+		state.Params = m
+	}
+
+	if len(secretKeys) == 0 && len(secret) == 0 {
+		state.SecretKeys = types.SetNull(types.StringType)
+	} else {
+		s, d := types.SetValueFrom(ctx, types.StringType, secretKeys)
+		diags.Append(d...)
+		state.SecretKeys = s
+	}
+
+	state.SecretParamsWO = types.MapNull(types.StringType)
+	return diags
+}
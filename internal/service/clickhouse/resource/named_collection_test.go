@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+)
+
+func stringMap(m map[string]string) types.Map {
+	values := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+func stringSet(s ...string) types.Set {
+	values := make([]attr.Value, 0, len(s))
+	for _, v := range s {
+		values = append(values, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func TestNamedCollectionChanges(t *testing.T) {
+	ctx := context.Background()
+	state := models.NamedCollectionResourceModel{
+		Params:                stringMap(map[string]string{"url": "https://a/", "format": "CSV"}),
+		SecretParamsWOVersion: types.Int64Value(1),
+		SecretKeys:            stringSet("access_key_id", "secret_access_key"),
+	}
+	config := models.NamedCollectionResourceModel{
+		SecretParamsWO: stringMap(map[string]string{"access_key_id": "AKIA", "secret_access_key": "shh"}),
+	}
+
+	t.Run("param change leaves secrets alone", func(t *testing.T) {
+		plan := state
+		plan.Params = stringMap(map[string]string{"url": "https://b/"})
+		set, deleted, diags := namedCollectionChanges(ctx, plan, state, config)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if diff := cmp.Diff(map[string]string{"url": "https://b/"}, set); diff != "" {
+			t.Errorf("set mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"format"}, deleted); diff != "" {
+			t.Errorf("deleted mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("version bump resends secrets and drops removed secret keys", func(t *testing.T) {
+		plan := state
+		plan.SecretParamsWOVersion = types.Int64Value(2)
+		plan.SecretKeys = stringSet("access_key_id")
+		rotated := models.NamedCollectionResourceModel{SecretParamsWO: stringMap(map[string]string{"access_key_id": "AKIB"})}
+		set, deleted, diags := namedCollectionChanges(ctx, plan, state, rotated)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if diff := cmp.Diff(map[string]string{"access_key_id": "AKIB"}, set); diff != "" {
+			t.Errorf("set mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"secret_access_key"}, deleted); diff != "" {
+			t.Errorf("deleted mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestApplyNamedCollectionToState(t *testing.T) {
+	ctx := context.Background()
+	state := models.NamedCollectionResourceModel{
+		Params:     stringMap(map[string]string{"url": "https://a/"}),
+		SecretKeys: stringSet("secret_access_key"),
+	}
+	collection := &api.NamedCollection{Params: map[string]string{
+		"url":               api.NamedCollectionHiddenValue,
+		"format":            "CSV",
+		"secret_access_key": api.NamedCollectionHiddenValue,
+	}}
+
+	if diags := applyNamedCollectionToState(ctx, collection, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := stringMap(map[string]string{"url": "https://a/", "format": "CSV"})
+	if !state.Params.Equal(want) {
+		t.Errorf("params = %s, want %s", state.Params, want)
+	}
+	if !state.SecretKeys.Equal(stringSet("secret_access_key")) {
+		t.Errorf("secret_keys = %s, want [secret_access_key]", state.SecretKeys)
+	}
+	if !state.SecretParamsWO.IsNull() {
+		t.Errorf("secret_params_wo = %s, want null", state.SecretParamsWO)
+	}
+}
@@ -0,0 +1,382 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &QuotaResource{}
+	_ resource.ResourceWithConfigure      = &QuotaResource{}
+	_ resource.ResourceWithImportState    = &QuotaResource{}
+	_ resource.ResourceWithValidateConfig = &QuotaResource{}
+)
+
+//go:embed descriptions/quota.md
+var quotaResourceDescription string
+
+func NewQuotaResource() resource.Resource {
+	return &QuotaResource{}
+}
+
+type QuotaResource struct {
+	client api.Client
+}
+
+func (r *QuotaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota"
+}
+
+func quotaLimitAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+}
+
+func (r *QuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			Description: "ClickHouse Cloud service ID the quota is created in.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the quota.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"keyed_by": schema.StringAttribute{
+			Description: "How consumption is tracked: `user_name`, `ip_address`, `client_key`, `client_key_or_user_name` or `client_key_or_ip_address`. When unset, everyone the quota applies to shares a single counter.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(api.QuotaKeys...),
+			},
+		},
+		"intervals": schema.ListNestedAttribute{
+			Description: "Tracking intervals and their limits. Each interval must have a distinct `duration_seconds`. An interval without any limit only tracks consumption.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"duration_seconds": schema.Int64Attribute{
+						Description: "Length of the interval in seconds.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 4294967295),
+						},
+					},
+					"randomized": schema.BoolAttribute{
+						Description: "Start the interval at a random offset, so counters of different keys do not reset at the same moment.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"max_queries":       quotaLimitAttribute("Maximum number of queries."),
+					"max_query_selects": quotaLimitAttribute("Maximum number of SELECT queries."),
+					"max_query_inserts": quotaLimitAttribute("Maximum number of INSERT queries."),
+					"max_errors":        quotaLimitAttribute("Maximum number of queries that threw an exception."),
+					"max_result_rows":   quotaLimitAttribute("Maximum number of rows returned as results."),
+					"max_result_bytes":  quotaLimitAttribute("Maximum number of bytes returned as results."),
+					"max_read_rows":     quotaLimitAttribute("Maximum number of source rows read from tables."),
+					"max_read_bytes":    quotaLimitAttribute("Maximum number of bytes read from tables."),
+					"max_written_bytes": quotaLimitAttribute("Maximum number of bytes written."),
+					"max_execution_time": schema.Float64Attribute{
+						Description: "Maximum total query execution time, in seconds.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range sqlGranteeAttributes("quota") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: quotaResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *QuotaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *QuotaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_quota", &resp.Diagnostics)
+
+	var config models.QuotaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Intervals.IsNull() || config.Intervals.IsUnknown() {
+		return
+	}
+
+	var intervals []models.QuotaIntervalModel
+	resp.Diagnostics.Append(config.Intervals.ElementsAs(ctx, &intervals, false)...)
+	seen := make(map[int64]struct{}, len(intervals))
+	for i, interval := range intervals {
+		if interval.DurationSeconds.IsNull() || interval.DurationSeconds.IsUnknown() {
+			continue
+		}
+		d := interval.DurationSeconds.ValueInt64()
+		if _, ok := seen[d]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("intervals").AtListIndex(i).AtName("duration_seconds"),
+				"Duplicate quota interval",
+				fmt.Sprintf("An interval of %d seconds is already defined. ClickHouse keeps one set of limits per interval duration.", d),
+			)
+		}
+		seen[d] = struct{}{}
+	}
+}
+
+func (r *QuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.QuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, diags := quotaFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateQuota(ctx, plan.ServiceID.ValueString(), quota)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating quota", fmt.Sprintf("quota %q was not found after creation", quota.Name))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + quota.Name)
+	resp.Diagnostics.Append(applyQuotaToState(ctx, created, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *QuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_quota", &resp.Diagnostics)
+	var state models.QuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, err := r.client.GetQuota(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading quota", err.Error())
+		return
+	}
+	if quota == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	resp.Diagnostics.Append(applyQuotaToState(ctx, quota, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *QuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.QuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, diags := quotaFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateQuota(ctx, plan.ServiceID.ValueString(), quota)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating quota", err.Error())
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error updating quota", fmt.Sprintf("quota %q no longer exists", quota.Name))
+		return
+	}
+
+	resp.Diagnostics.Append(applyQuotaToState(ctx, updated, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *QuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.QuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteQuota(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting quota", err.Error())
+	}
+}
+
+func (r *QuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid quota import ID",
+			fmt.Sprintf("Expected service_id/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func quotaFromModel(ctx context.Context, m models.QuotaResourceModel) (api.Quota, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	quota := api.Quota{
+		Name:      m.Name.ValueString(),
+		KeyedBy:   m.KeyedBy.ValueString(),
+		Intervals: make([]api.QuotaInterval, 0),
+	}
+
+	if !m.Intervals.IsNull() && !m.Intervals.IsUnknown() {
+		var intervals []models.QuotaIntervalModel
+		diags.Append(m.Intervals.ElementsAs(ctx, &intervals, false)...)
+		for _, i := range intervals {
+			quota.Intervals = append(quota.Intervals, api.QuotaInterval{
+				DurationSeconds:  uint32(i.DurationSeconds.ValueInt64()), //nolint:gosec
+				Randomized:       i.Randomized.ValueBool(),
+				MaxQueries:       i.MaxQueries.ValueInt64Pointer(),
+				MaxQuerySelects:  i.MaxQuerySelects.ValueInt64Pointer(),
+				MaxQueryInserts:  i.MaxQueryInserts.ValueInt64Pointer(),
+				MaxErrors:        i.MaxErrors.ValueInt64Pointer(),
+				MaxResultRows:    i.MaxResultRows.ValueInt64Pointer(),
+				MaxResultBytes:   i.MaxResultBytes.ValueInt64Pointer(),
+				MaxReadRows:      i.MaxReadRows.ValueInt64Pointer(),
+				MaxReadBytes:     i.MaxReadBytes.ValueInt64Pointer(),
+				MaxWrittenBytes:  i.MaxWrittenBytes.ValueInt64Pointer(),
+				MaxExecutionTime: i.MaxExecutionTime.ValueFloat64Pointer(),
+			})
+		}
+	}
+
+	grantees, d := sqlGranteesFromModel(ctx, m.ApplyToUsers, m.ApplyToRoles)
+	diags.Append(d...)
+	quota.Grantees = grantees
+
+	return quota, diags
+}
+
+// applyQuotaToState maps a quota read from ClickHouse into state.
+// system.quota_limits returns intervals sorted by duration; they are put back
+// in the order the configuration lists them so reordering is not a diff.
+func applyQuotaToState(ctx context.Context, quota *api.Quota, state *models.QuotaResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if quota.KeyedBy == "" {
+		state.KeyedBy = types.StringNull()
+	} else {
+		state.KeyedBy = types.StringValue(quota.KeyedBy)
+	}
+
+	var prior []models.QuotaIntervalModel
+	if !state.Intervals.IsNull() && !state.Intervals.IsUnknown() {
+		diags.Append(state.Intervals.ElementsAs(ctx, &prior, false)...)
+	}
+
+	if len(quota.Intervals) == 0 && (state.Intervals.IsNull() || state.Intervals.IsUnknown()) {
+		state.Intervals = types.ListNull(models.QuotaIntervalModel{}.ObjectType())
+		diags.Append(applySQLGranteesToState(ctx, quota.Grantees, &state.ApplyToUsers, &state.ApplyToRoles)...)
+		return diags
+	}
+
+	byDuration := make(map[int64]api.QuotaInterval, len(quota.Intervals))
+	for _, i := range quota.Intervals {
+		byDuration[int64(i.DurationSeconds)] = i
+	}
+	ordered := make([]api.QuotaInterval, 0, len(quota.Intervals))
+	for _, p := range prior {
+		if i, ok := byDuration[p.DurationSeconds.ValueInt64()]; ok {
+			ordered = append(ordered, i)
+			delete(byDuration, p.DurationSeconds.ValueInt64())
+		}
+	}
+	for _, i := range quota.Intervals {
+		if _, ok := byDuration[int64(i.DurationSeconds)]; ok {
+			ordered = append(ordered, i)
+		}
+	}
+
+	values := make([]attr.Value, 0, len(ordered))
+	for _, i := range ordered {
+		values = append(values, models.QuotaIntervalModel{
+			DurationSeconds:  types.Int64Value(int64(i.DurationSeconds)),
+			Randomized:       types.BoolValue(i.Randomized),
+			MaxQueries:       types.Int64PointerValue(i.MaxQueries),
+			MaxQuerySelects:  types.Int64PointerValue(i.MaxQuerySelects),
+			MaxQueryInserts:  types.Int64PointerValue(i.MaxQueryInserts),
+			MaxErrors:        types.Int64PointerValue(i.MaxErrors),
+			MaxResultRows:    types.Int64PointerValue(i.MaxResultRows),
+			MaxResultBytes:   types.Int64PointerValue(i.MaxResultBytes),
+			MaxReadRows:      types.Int64PointerValue(i.MaxReadRows),
+			MaxReadBytes:     types.Int64PointerValue(i.MaxReadBytes),
+			MaxWrittenBytes:  types.Int64PointerValue(i.MaxWrittenBytes),
+			MaxExecutionTime: types.Float64PointerValue(i.MaxExecutionTime),
+		}.ObjectValue())
+	}
+	list, d := types.ListValue(models.QuotaIntervalModel{}.ObjectType(), values)
+	diags.Append(d...)
+	state.Intervals = list
+
+	diags.Append(applySQLGranteesToState(ctx, quota.Grantees, &state.ApplyToUsers, &state.ApplyToRoles)...)
+	return diags
+}
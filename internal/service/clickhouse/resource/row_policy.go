@@ -0,0 +1,262 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &RowPolicyResource{}
+	_ resource.ResourceWithConfigure      = &RowPolicyResource{}
+	_ resource.ResourceWithImportState    = &RowPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &RowPolicyResource{}
+)
+
+//go:embed descriptions/row_policy.md
+var rowPolicyResourceDescription string
+
+func NewRowPolicyResource() resource.Resource {
+	return &RowPolicyResource{}
+}
+
+type RowPolicyResource struct {
+	client api.Client
+}
+
+func (r *RowPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_row_policy"
+}
+
+func (r *RowPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiredName := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/database/table/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			Description: "ClickHouse Cloud service ID the row policy is created in.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name":     requiredName("Name of the row policy. Must be unique per table."),
+		"database": requiredName("Database of the table the policy filters."),
+		"table":    requiredName("Table the policy filters."),
+		"using": schema.StringAttribute{
+			Description: "SQL condition a row must satisfy to be visible, e.g. `tenant_id = 'acme'`. ClickHouse re-formats the expression when storing it; differences in whitespace and keyword case are not reported as changes.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				sqlEquivalentPlanModifier{},
+			},
+		},
+		"restrictive": schema.BoolAttribute{
+			Description: "When true the policy is restrictive: its condition is combined with AND with the other policies on the table. Permissive policies (the default) are combined with OR.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+	for name, attribute := range sqlGranteeAttributes("row policy") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: rowPolicyResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *RowPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *RowPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_row_policy", &resp.Diagnostics)
+}
+
+func (r *RowPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.RowPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := rowPolicyFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateRowPolicy(ctx, plan.ServiceID.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating row policy", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating row policy", fmt.Sprintf("row policy %q on %s.%s was not found after creation", policy.Name, policy.Database, policy.Table))
+		return
+	}
+
+	plan.ID = types.StringValue(rowPolicyID(plan))
+	resp.Diagnostics.Append(applyRowPolicyToState(ctx, created, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RowPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_row_policy", &resp.Diagnostics)
+	var state models.RowPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetRowPolicy(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Table.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading row policy", err.Error())
+		return
+	}
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(rowPolicyID(state))
+	state.Using = readRowPolicyUsing(state.Using, policy.Using)
+	resp.Diagnostics.Append(applyRowPolicyToState(ctx, policy, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RowPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.RowPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := rowPolicyFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateRowPolicy(ctx, plan.ServiceID.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating row policy", err.Error())
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error updating row policy", fmt.Sprintf("row policy %q on %s.%s no longer exists", policy.Name, policy.Database, policy.Table))
+		return
+	}
+
+	resp.Diagnostics.Append(applyRowPolicyToState(ctx, updated, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RowPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.RowPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRowPolicy(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Table.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting row policy", err.Error())
+	}
+}
+
+func (r *RowPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 4)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid row policy import ID",
+			fmt.Sprintf("Expected service_id/database/table/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[3])...)
+}
+
+func rowPolicyID(m models.RowPolicyResourceModel) string {
+	return strings.Join([]string{m.ServiceID.ValueString(), m.Database.ValueString(), m.Table.ValueString(), m.Name.ValueString()}, "/")
+}
+
+func rowPolicyFromModel(ctx context.Context, m models.RowPolicyResourceModel) (api.RowPolicy, diag.Diagnostics) {
+	grantees, diags := sqlGranteesFromModel(ctx, m.ApplyToUsers, m.ApplyToRoles)
+	return api.RowPolicy{
+		Name:        m.Name.ValueString(),
+		Database:    m.Database.ValueString(),
+		Table:       m.Table.ValueString(),
+		Using:       m.Using.ValueString(),
+		Restrictive: m.Restrictive.ValueBool(),
+		Grantees:    grantees,
+	}, diags
+}
+
+// applyRowPolicyToState maps a row policy read from ClickHouse into state.
+// The condition is left alone: Create and Update keep the planned one, and
+// Read replaces it only when it differs from what the server reports.
+func applyRowPolicyToState(ctx context.Context, policy *api.RowPolicy, state *models.RowPolicyResourceModel) diag.Diagnostics {
+	state.Restrictive = types.BoolValue(policy.Restrictive)
+
+	return applySQLGranteesToState(ctx, policy.Grantees, &state.ApplyToUsers, &state.ApplyToRoles)
+}
+
+// readRowPolicyUsing returns the condition to keep in state after a read.
+// ClickHouse stores the condition re-formatted (e.g. "a = 1 AND b = 2" as
+// "(a = 1) AND (b = 2)"), so the prior text is kept while it is equivalent.
+func readRowPolicyUsing(prior types.String, stored string) types.String {
+	if prior.IsNull() || prior.IsUnknown() || !sql.Equivalent(prior.ValueString(), stored) {
+		return types.StringValue(stored)
+	}
+	return prior
+}
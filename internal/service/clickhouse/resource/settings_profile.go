@@ -0,0 +1,325 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &SettingsProfileResource{}
+	_ resource.ResourceWithConfigure      = &SettingsProfileResource{}
+	_ resource.ResourceWithImportState    = &SettingsProfileResource{}
+	_ resource.ResourceWithValidateConfig = &SettingsProfileResource{}
+)
+
+//go:embed descriptions/settings_profile.md
+var settingsProfileResourceDescription string
+
+func NewSettingsProfileResource() resource.Resource {
+	return &SettingsProfileResource{}
+}
+
+type SettingsProfileResource struct {
+	client api.Client
+}
+
+func (r *SettingsProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings_profile"
+}
+
+func (r *SettingsProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			Description: "ClickHouse Cloud service ID the settings profile is created in.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the settings profile.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"settings": schema.ListNestedAttribute{
+			Description: "Settings and constraints applied by the profile, in order.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the setting, e.g. `max_memory_usage`.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value of the setting. Always given as a string; ClickHouse converts it to the setting type.",
+						Optional:    true,
+					},
+					"min": schema.StringAttribute{
+						Description: "Lowest value users can set the setting to.",
+						Optional:    true,
+					},
+					"max": schema.StringAttribute{
+						Description: "Highest value users can set the setting to.",
+						Optional:    true,
+					},
+					"writability": schema.StringAttribute{
+						Description: "Whether users can change the setting: `WRITABLE`, `CONST` or `CHANGEABLE_IN_READONLY`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(api.SettingWritabilities...),
+						},
+					},
+				},
+			},
+		},
+		"inherit_profiles": schema.ListAttribute{
+			Description: "Settings profiles this profile inherits from. Settings listed in `settings` override inherited ones.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+	for name, attribute := range sqlGranteeAttributes("settings profile") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: settingsProfileResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *SettingsProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *SettingsProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_settings_profile", &resp.Diagnostics)
+}
+
+func (r *SettingsProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SettingsProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := settingsProfileFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateSettingsProfile(ctx, plan.ServiceID.ValueString(), profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating settings profile", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("Error creating settings profile", fmt.Sprintf("settings profile %q was not found after creation", profile.Name))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + profile.Name)
+	resp.Diagnostics.Append(applySettingsProfileToState(ctx, created, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SettingsProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_settings_profile", &resp.Diagnostics)
+	var state models.SettingsProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetSettingsProfile(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading settings profile", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	resp.Diagnostics.Append(applySettingsProfileToState(ctx, profile, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SettingsProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SettingsProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := settingsProfileFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateSettingsProfile(ctx, plan.ServiceID.ValueString(), profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating settings profile", err.Error())
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error updating settings profile", fmt.Sprintf("settings profile %q no longer exists", profile.Name))
+		return
+	}
+
+	resp.Diagnostics.Append(applySettingsProfileToState(ctx, updated, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SettingsProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SettingsProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSettingsProfile(ctx, state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting settings profile", err.Error())
+	}
+}
+
+func (r *SettingsProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid settings profile import ID",
+			fmt.Sprintf("Expected service_id/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func settingsProfileFromModel(ctx context.Context, m models.SettingsProfileResourceModel) (api.SettingsProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	profile := api.SettingsProfile{
+		Name:            m.Name.ValueString(),
+		Settings:        make([]api.SettingsProfileSetting, 0),
+		InheritProfiles: make([]string, 0),
+	}
+
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		var settings []models.SettingsProfileSettingModel
+		diags.Append(m.Settings.ElementsAs(ctx, &settings, false)...)
+		for _, s := range settings {
+			profile.Settings = append(profile.Settings, api.SettingsProfileSetting{
+				Name:        s.Name.ValueString(),
+				Value:       s.Value.ValueStringPointer(),
+				Min:         s.Min.ValueStringPointer(),
+				Max:         s.Max.ValueStringPointer(),
+				Writability: s.Writability.ValueStringPointer(),
+			})
+		}
+	}
+	if !m.InheritProfiles.IsNull() && !m.InheritProfiles.IsUnknown() {
+		diags.Append(m.InheritProfiles.ElementsAs(ctx, &profile.InheritProfiles, false)...)
+	}
+
+	grantees, d := sqlGranteesFromModel(ctx, m.ApplyToUsers, m.ApplyToRoles)
+	diags.Append(d...)
+	profile.Grantees = grantees
+
+	return profile, diags
+}
+
+// applySettingsProfileToState maps a settings profile read from ClickHouse
+// into state. Where the server reports a setting value in a different but
+// equivalent spelling than the one in state, the state spelling is kept.
+func applySettingsProfileToState(ctx context.Context, profile *api.SettingsProfile, state *models.SettingsProfileResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prior []models.SettingsProfileSettingModel
+	if !state.Settings.IsNull() && !state.Settings.IsUnknown() {
+		diags.Append(state.Settings.ElementsAs(ctx, &prior, false)...)
+	}
+
+	if len(profile.Settings) == 0 && (state.Settings.IsNull() || state.Settings.IsUnknown()) {
+		state.Settings = types.ListNull(models.SettingsProfileSettingModel{}.ObjectType())
+	} else {
+		values := make([]attr.Value, 0, len(profile.Settings))
+		for i, s := range profile.Settings {
+			var p *models.SettingsProfileSettingModel
+			if i < len(prior) && prior[i].Name.ValueString() == s.Name {
+				p = &prior[i]
+			}
+			values = append(values, models.SettingsProfileSettingModel{
+				Name:        types.StringValue(s.Name),
+				Value:       settingValueToState(s.Value, p, func(m *models.SettingsProfileSettingModel) types.String { return m.Value }),
+				Min:         settingValueToState(s.Min, p, func(m *models.SettingsProfileSettingModel) types.String { return m.Min }),
+				Max:         settingValueToState(s.Max, p, func(m *models.SettingsProfileSettingModel) types.String { return m.Max }),
+				Writability: types.StringPointerValue(s.Writability),
+			}.ObjectValue())
+		}
+		list, d := types.ListValue(models.SettingsProfileSettingModel{}.ObjectType(), values)
+		diags.Append(d...)
+		state.Settings = list
+	}
+
+	if len(profile.InheritProfiles) == 0 && (state.InheritProfiles.IsNull() || state.InheritProfiles.IsUnknown()) {
+		state.InheritProfiles = types.ListNull(types.StringType)
+	} else {
+		list, d := types.ListValueFrom(ctx, types.StringType, profile.InheritProfiles)
+		diags.Append(d...)
+		state.InheritProfiles = list
+	}
+
+	diags.Append(applySQLGranteesToState(ctx, profile.Grantees, &state.ApplyToUsers, &state.ApplyToRoles)...)
+	return diags
+}
+
+func settingValueToState(actual *string, prior *models.SettingsProfileSettingModel, field func(*models.SettingsProfileSettingModel) types.String) types.String {
+	if actual == nil {
+		return types.StringNull()
+	}
+	if prior != nil {
+		if p := field(prior); !p.IsNull() && !p.IsUnknown() && settingValuesEquivalent(p.ValueString(), *actual) {
+			return p
+		}
+	}
+	return types.StringValue(*actual)
+}
@@ -0,0 +1,184 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// This file holds the pieces shared by the resources that manage objects
+// inside a service (access entities, views, dictionaries) over the Query API.
+
+// databaseObjectAttributes returns the id, service_id, database and name
+// attributes of a resource identified by service_id/database/name. kind names
+// the object in the descriptions, e.g. "view".
+func databaseObjectAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/database/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			Description: fmt.Sprintf("ClickHouse Cloud service ID the %s is created in.", kind),
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"database": schema.StringAttribute{
+			Description: fmt.Sprintf("Database the %s is created in.", kind),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("Name of the %s.", kind),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// importDatabaseObject handles the service_id/database/name import ID of the
+// resources built on databaseObjectAttributes.
+func importDatabaseObject(ctx context.Context, kind string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s import ID", kind),
+			fmt.Sprintf("Expected service_id/database/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+func databaseObjectID(serviceID, database, name types.String) types.String {
+	return types.StringValue(serviceID.ValueString() + "/" + database.ValueString() + "/" + name.ValueString())
+}
+
+// sqlGranteeAttributes returns the apply_to_users / apply_to_roles attributes.
+// entity names the kind of object in the descriptions, e.g. "settings profile".
+func sqlGranteeAttributes(entity string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"apply_to_users": schema.SetAttribute{
+			Description: fmt.Sprintf("SQL users the %s applies to.", entity),
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"apply_to_roles": schema.SetAttribute{
+			Description: fmt.Sprintf("SQL roles the %s applies to. Users granted one of these roles are covered as well.", entity),
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// configureQueryAPIResource extracts the API client from the provider data for
+// resources that run SQL against a service through the Query API.
+func configureQueryAPIResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) api.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return nil
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return nil
+	}
+	return providerData.API
+}
+
+func sqlGranteesFromModel(ctx context.Context, users, roles types.Set) (api.SQLGrantees, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	grantees := api.SQLGrantees{Users: []string{}, Roles: []string{}}
+	if !users.IsNull() && !users.IsUnknown() {
+		diags.Append(users.ElementsAs(ctx, &grantees.Users, false)...)
+	}
+	if !roles.IsNull() && !roles.IsUnknown() {
+		diags.Append(roles.ElementsAs(ctx, &grantees.Roles, false)...)
+	}
+	return grantees, diags
+}
+
+// applySQLGranteesToState writes the grantees read back from ClickHouse. An
+// empty list stays null when the attribute was not configured, so omitting
+// apply_to_users does not plan a change from null to [].
+func applySQLGranteesToState(ctx context.Context, grantees api.SQLGrantees, users, roles *types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	*users, diags = stringSetOrNull(ctx, *users, grantees.Users)
+	r, d := stringSetOrNull(ctx, *roles, grantees.Roles)
+	diags.Append(d...)
+	*roles = r
+	return diags
+}
+
+func stringSetOrNull(ctx context.Context, prior types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// settingValuesEquivalent reports whether two setting values mean the same to
+// ClickHouse. system.settings_profile_elements reports values in canonical
+// form, e.g. a boolean set as "true" reads back as "1" and "1e9" as
+// "1000000000".
+func settingValuesEquivalent(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	if ab, ok := settingBool(a); ok {
+		if bb, ok := settingBool(b); ok {
+			return ab == bb
+		}
+	}
+	af, aErr := strconv.ParseFloat(a, 64)
+	bf, bErr := strconv.ParseFloat(b, 64)
+	return aErr == nil && bErr == nil && af == bf
+}
+
+func settingBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "1", "true":
+		return true, true
+	case "0", "false":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,164 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+)
+
+func TestSettingValuesEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "true", b: "1", want: true},
+		{a: "False", b: "0", want: true},
+		{a: "1e9", b: "1000000000", want: true},
+		{a: "Memory", b: "memory", want: true},
+		{a: "1", b: "2", want: false},
+		{a: "10G", b: "10000000000", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := settingValuesEquivalent(tt.a, tt.b); got != tt.want {
+			t.Errorf("settingValuesEquivalent(%q, %q) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestApplySettingsProfileToState(t *testing.T) {
+	ctx := context.Background()
+	configured := models.SettingsProfileSettingModel{
+		Name:        types.StringValue("use_query_cache"),
+		Value:       types.StringValue("true"),
+		Min:         types.StringNull(),
+		Max:         types.StringNull(),
+		Writability: types.StringNull(),
+	}
+	settings, _ := types.ListValue(configured.ObjectType(), []attr.Value{configured.ObjectValue()})
+	state := models.SettingsProfileResourceModel{
+		Settings:        settings,
+		InheritProfiles: types.ListNull(types.StringType),
+		ApplyToUsers:    types.SetNull(types.StringType),
+		ApplyToRoles:    strSetValue("tenant_a"),
+	}
+
+	one := "1"
+	diags := applySettingsProfileToState(ctx, &api.SettingsProfile{
+		Name:            "tenant",
+		Settings:        []api.SettingsProfileSetting{{Name: "use_query_cache", Value: &one}},
+		InheritProfiles: []string{},
+		Grantees:        api.SQLGrantees{Users: []string{}, Roles: []string{"tenant_a"}},
+	}, &state)
+	if diags.HasError() {
+		t.Fatalf("applySettingsProfileToState: %v", diags)
+	}
+
+	if !state.Settings.Equal(settings) {
+		t.Errorf("equivalent setting value was not preserved:\ngot  = %v\nwant = %v", state.Settings, settings)
+	}
+	if !state.InheritProfiles.IsNull() || !state.ApplyToUsers.IsNull() {
+		t.Errorf("unconfigured empty attributes should stay null, got inherit_profiles = %v, apply_to_users = %v", state.InheritProfiles, state.ApplyToUsers)
+	}
+	if !state.ApplyToRoles.Equal(strSetValue("tenant_a")) {
+		t.Errorf("apply_to_roles = %v; want [tenant_a]", state.ApplyToRoles)
+	}
+}
+
+func TestApplyQuotaToState_KeepsConfiguredIntervalOrder(t *testing.T) {
+	ctx := context.Background()
+	interval := func(d int64) attr.Value {
+		return models.QuotaIntervalModel{
+			DurationSeconds:  types.Int64Value(d),
+			Randomized:       types.BoolValue(false),
+			MaxQueries:       types.Int64Null(),
+			MaxQuerySelects:  types.Int64Null(),
+			MaxQueryInserts:  types.Int64Null(),
+			MaxErrors:        types.Int64Null(),
+			MaxResultRows:    types.Int64Null(),
+			MaxResultBytes:   types.Int64Null(),
+			MaxReadRows:      types.Int64Null(),
+			MaxReadBytes:     types.Int64Null(),
+			MaxWrittenBytes:  types.Int64Null(),
+			MaxExecutionTime: types.Float64Null(),
+		}.ObjectValue()
+	}
+	configured, _ := types.ListValue(models.QuotaIntervalModel{}.ObjectType(), []attr.Value{interval(86400), interval(60)})
+	state := models.QuotaResourceModel{
+		KeyedBy:      types.StringNull(),
+		Intervals:    configured,
+		ApplyToUsers: types.SetNull(types.StringType),
+		ApplyToRoles: types.SetNull(types.StringType),
+	}
+
+	diags := applyQuotaToState(ctx, &api.Quota{
+		Name:      "tenant",
+		Intervals: []api.QuotaInterval{{DurationSeconds: 60}, {DurationSeconds: 86400}},
+		Grantees:  api.SQLGrantees{Users: []string{}, Roles: []string{}},
+	}, &state)
+	if diags.HasError() {
+		t.Fatalf("applyQuotaToState: %v", diags)
+	}
+
+	if !state.Intervals.Equal(configured) {
+		t.Errorf("intervals were reordered:\ngot  = %v\nwant = %v", state.Intervals, configured)
+	}
+	if !state.KeyedBy.IsNull() {
+		t.Errorf("keyed_by = %v; want null for a quota that is not keyed", state.KeyedBy)
+	}
+}
+
+func TestApplyRowPolicyToState(t *testing.T) {
+	ctx := context.Background()
+
+	// Create and Update keep the planned condition, however ClickHouse
+	// re-formats it.
+	state := models.RowPolicyResourceModel{
+		Using:        types.StringValue("a = 1 AND b = 2"),
+		ApplyToUsers: types.SetNull(types.StringType),
+		ApplyToRoles: types.SetNull(types.StringType),
+	}
+	diags := applyRowPolicyToState(ctx, &api.RowPolicy{
+		Using:       "(a = 1) AND (b = 2)",
+		Restrictive: true,
+		Grantees:    api.SQLGrantees{Users: []string{"alice"}, Roles: []string{}},
+	}, &state)
+	if diags.HasError() {
+		t.Fatalf("applyRowPolicyToState: %v", diags)
+	}
+	if state.Using.ValueString() != "a = 1 AND b = 2" {
+		t.Errorf("using = %q; want the planned condition", state.Using.ValueString())
+	}
+	if !state.Restrictive.ValueBool() {
+		t.Errorf("restrictive = false; want true")
+	}
+	if !state.ApplyToUsers.Equal(strSetValue("alice")) || !state.ApplyToRoles.IsNull() {
+		t.Errorf("grantees = %v / %v; want [alice] / null", state.ApplyToUsers, state.ApplyToRoles)
+	}
+}
+
+func TestReadRowPolicyUsing(t *testing.T) {
+	tests := []struct {
+		name      string
+		using     string
+		stored    string
+		wantUsing string
+	}{
+		{name: "keeps configured text when equivalent", using: "tenant_id='a' and x>1", stored: "tenant_id = 'a' AND x > 1", wantUsing: "tenant_id='a' and x>1"},
+		{name: "keeps configured text when ClickHouse adds parentheses", using: "a = 1 AND b = 2", stored: "(a = 1) AND (b = 2)", wantUsing: "a = 1 AND b = 2"},
+		{name: "takes server text on drift", using: "tenant_id = 'a'", stored: "tenant_id = 'b'", wantUsing: "tenant_id = 'b'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readRowPolicyUsing(types.StringValue(tt.using), tt.stored); got.ValueString() != tt.wantUsing {
+				t.Errorf("using = %q; want %q", got.ValueString(), tt.wantUsing)
+			}
+		})
+	}
+}
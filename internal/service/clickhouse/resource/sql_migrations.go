@@ -0,0 +1,592 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &SQLMigrationsResource{}
+	_ resource.ResourceWithConfigure      = &SQLMigrationsResource{}
+	_ resource.ResourceWithModifyPlan     = &SQLMigrationsResource{}
+	_ resource.ResourceWithValidateConfig = &SQLMigrationsResource{}
+)
+
+//go:embed descriptions/sql_migrations.md
+var sqlMigrationsResourceDescription string
+
+func NewSQLMigrationsResource() resource.Resource {
+	return &SQLMigrationsResource{}
+}
+
+type SQLMigrationsResource struct {
+	client api.Client
+}
+
+func (r *SQLMigrationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_migrations"
+}
+
+func (r *SQLMigrationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: sqlMigrationsResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier in the form `service_id/database/table`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ClickHouse Cloud service ID the migrations are applied to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Description: "Directory holding `<version>_<title>.up.sql` files and optional matching `.down.sql` files. Versions are ordered numerically when they are all numbers and lexically otherwise. Exactly one of `directory` or `migrations` must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("directory"), path.MatchRoot("migrations")),
+				},
+			},
+			"migrations": schema.ListNestedAttribute{
+				Description: "Migrations in the order they are applied. When `directory` is set, this is filled from the files found there.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "Unique version of the migration, e.g. `0001` or a timestamp.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"up_sql": schema.StringAttribute{
+							Description: "SQL applying the migration. Several statements are separated with `;`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"down_sql": schema.StringAttribute{
+							Description: "SQL reverting the migration, run when an applied migration is removed from the list.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database of the bookkeeping table. Defaults to `default`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Description: "Name of the bookkeeping table recording applied versions. Defaults to `schema_migrations`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("schema_migrations"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"on_cluster": schema.StringAttribute{
+				Description: "Cluster to create and modify the bookkeeping table on with `ON CLUSTER`. Migration SQL is sent as written; add `ON CLUSTER` to it where needed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"applied": schema.ListNestedAttribute{
+				Description: "Migrations recorded in the bookkeeping table, with the checksum of the `up_sql` they were applied with.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "Version of the applied migration.",
+							Computed:    true,
+						},
+						"checksum": schema.StringAttribute{
+							Description: "SHA-256 of the `up_sql` the migration was applied with.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SQLMigrationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *SQLMigrationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_sql_migrations", &resp.Diagnostics)
+
+	var config models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Migrations.IsNull() || config.Migrations.IsUnknown() {
+		return
+	}
+
+	var migrations []models.SQLMigrationModel
+	resp.Diagnostics.Append(config.Migrations.ElementsAs(ctx, &migrations, false)...)
+	seen := map[string]struct{}{}
+	for _, m := range migrations {
+		if m.Version.IsUnknown() {
+			continue
+		}
+		if _, ok := seen[m.Version.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("migrations"), "Duplicate migration version",
+				fmt.Sprintf("Version %q is listed more than once.", m.Version.ValueString()))
+		}
+		seen[m.Version.ValueString()] = struct{}{}
+	}
+}
+
+// ModifyPlan loads migrations from directory, plans an apply whenever the
+// configured versions differ from the applied ones and warns about applied
+// migrations whose up_sql has been edited since.
+func (r *SQLMigrationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Directory.IsNull() && !plan.Directory.IsUnknown() {
+		migrations, err := loadMigrationsDirectory(plan.Directory.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("directory"), "Error reading migrations directory", err.Error())
+			return
+		}
+		list, diags := migrationsToList(migrations)
+		resp.Diagnostics.Append(diags...)
+		plan.Migrations = list
+	}
+
+	if req.State.Raw.IsNull() || plan.Migrations.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var migrations []models.SQLMigrationModel
+	resp.Diagnostics.Append(plan.Migrations.ElementsAs(ctx, &migrations, false)...)
+	applied, diags := appliedMigrationsFromList(ctx, state.Applied)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, m := range migrations {
+		if checksum, ok := applied[m.Version.ValueString()]; ok && !m.UpSQL.IsUnknown() && checksum != api.MigrationChecksum(m.UpSQL.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("migrations"), "Applied migration changed",
+				fmt.Sprintf("Migration %q was applied with a different up_sql (checksum %s). Applied migrations are not re-run; add a new migration instead of editing it.", m.Version.ValueString(), checksum))
+		}
+	}
+
+	if migrationsPending(migrations, applied) {
+		plan.Applied = types.ListUnknown(models.AppliedSQLMigrationModel{}.ObjectType())
+	} else {
+		plan.Applied = state.Applied
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *SQLMigrationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table := migrationsTableFromModel(plan)
+	if err := r.client.EnsureMigrationsTable(ctx, plan.ServiceID.ValueString(), table); err != nil {
+		resp.Diagnostics.AddError("Error creating migrations table", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + table.Database + "/" + table.Name)
+	resp.Diagnostics.Append(r.migrate(ctx, &plan, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SQLMigrationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_sql_migrations", &resp.Diagnostics)
+	var state models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, err := r.client.GetAppliedMigrations(ctx, state.ServiceID.ValueString(), migrationsTableFromModel(state))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading applied migrations", err.Error())
+		return
+	}
+
+	list, diags := appliedMigrationsToList(applied)
+	resp.Diagnostics.Append(diags...)
+	state.Applied = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SQLMigrationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.SQLMigrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []models.SQLMigrationModel
+	if !state.Migrations.IsNull() && !state.Migrations.IsUnknown() {
+		resp.Diagnostics.Append(state.Migrations.ElementsAs(ctx, &prior, false)...)
+	}
+	if err := r.client.EnsureMigrationsTable(ctx, plan.ServiceID.ValueString(), migrationsTableFromModel(plan)); err != nil {
+		resp.Diagnostics.AddError("Error creating migrations table", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.migrate(ctx, &plan, prior)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the resource. Reverting every migration on destroy
+// would drop data, so the schema and the bookkeeping table are left in place.
+func (r *SQLMigrationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// migrate brings the service to the planned migrations: applied versions no
+// longer configured are reverted with the down_sql from prior, newest first,
+// then pending versions are applied in order. plan.Applied is refreshed from
+// the bookkeeping table even when a step fails, so state records what ran,
+// and removed migrations that are still recorded stay in plan.Migrations so
+// their down_sql is there for the next apply.
+func (r *SQLMigrationsResource) migrate(ctx context.Context, plan *models.SQLMigrationsResourceModel, prior []models.SQLMigrationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serviceID := plan.ServiceID.ValueString()
+	table := migrationsTableFromModel(*plan)
+
+	var migrations []models.SQLMigrationModel
+	diags.Append(plan.Migrations.ElementsAs(ctx, &migrations, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	refresh := func() []api.AppliedMigration {
+		applied, err := r.client.GetAppliedMigrations(ctx, serviceID, table)
+		if err != nil {
+			diags.AddError("Error reading applied migrations", err.Error())
+			plan.Applied = types.ListNull(models.AppliedSQLMigrationModel{}.ObjectType())
+			return nil
+		}
+		list, d := appliedMigrationsToList(applied)
+		diags.Append(d...)
+		plan.Applied = list
+		return applied
+	}
+	fail := func(summary string, err error) diag.Diagnostics {
+		diags.AddError(summary, err.Error())
+		kept, d := migrationsToList(withUnreverted(migrations, prior, refresh()))
+		diags.Append(d...)
+		plan.Migrations = kept
+		return diags
+	}
+
+	current, err := r.client.GetAppliedMigrations(ctx, serviceID, table)
+	if err != nil {
+		diags.AddError("Error reading applied migrations", err.Error())
+		plan.Applied = types.ListNull(models.AppliedSQLMigrationModel{}.ObjectType())
+		return diags
+	}
+	applied := make(map[string]string, len(current))
+	for _, a := range current {
+		applied[a.Version] = a.Checksum
+	}
+
+	reverts, err := migrationsToRevert(migrations, prior, applied)
+	if err == nil {
+		err = checkMigrationOrder(migrations, applied)
+	}
+	if err != nil {
+		return fail("Error planning migrations", err)
+	}
+
+	for _, m := range reverts {
+		if err := r.client.RevertMigration(ctx, serviceID, table, m.Version.ValueString(), sql.SplitStatements(m.DownSQL.ValueString())); err != nil {
+			return fail("Error reverting migration", err)
+		}
+	}
+
+	for _, m := range migrations {
+		version := m.Version.ValueString()
+		if _, ok := applied[version]; ok {
+			continue
+		}
+		up := m.UpSQL.ValueString()
+		if err := r.client.ApplyMigration(ctx, serviceID, table, version, api.MigrationChecksum(up), sql.SplitStatements(up)); err != nil {
+			return fail("Error applying migration", err)
+		}
+	}
+
+	refresh()
+	return diags
+}
+
+func migrationsTableFromModel(m models.SQLMigrationsResourceModel) api.MigrationsTable {
+	return api.MigrationsTable{
+		Database: m.Database.ValueString(),
+		Name:     m.Table.ValueString(),
+		Cluster:  m.OnCluster.ValueString(),
+	}
+}
+
+// migrationsPending reports whether applying would run or revert anything.
+func migrationsPending(migrations []models.SQLMigrationModel, applied map[string]string) bool {
+	configured := make(map[string]struct{}, len(migrations))
+	for _, m := range migrations {
+		if m.Version.IsUnknown() {
+			return true
+		}
+		configured[m.Version.ValueString()] = struct{}{}
+		if _, ok := applied[m.Version.ValueString()]; !ok {
+			return true
+		}
+	}
+	for version := range applied {
+		if _, ok := configured[version]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// migrationsToRevert returns the applied migrations no longer configured,
+// newest first, taking their down_sql from the previous configuration.
+func migrationsToRevert(migrations, prior []models.SQLMigrationModel, applied map[string]string) ([]models.SQLMigrationModel, error) {
+	configured := make(map[string]struct{}, len(migrations))
+	for _, m := range migrations {
+		configured[m.Version.ValueString()] = struct{}{}
+	}
+
+	reverts := make([]models.SQLMigrationModel, 0)
+	known := make(map[string]struct{}, len(prior))
+	for i := len(prior) - 1; i >= 0; i-- {
+		m := prior[i]
+		version := m.Version.ValueString()
+		known[version] = struct{}{}
+		if _, ok := configured[version]; ok {
+			continue
+		}
+		if _, ok := applied[version]; !ok {
+			continue
+		}
+		if m.DownSQL.ValueString() == "" {
+			return nil, fmt.Errorf("migration %q was removed but has no down_sql to revert it", version)
+		}
+		reverts = append(reverts, m)
+	}
+
+	for version := range applied {
+		_, isConfigured := configured[version]
+		_, isKnown := known[version]
+		if !isConfigured && !isKnown {
+			return nil, fmt.Errorf("migration %q is recorded in the bookkeeping table but is not configured", version)
+		}
+	}
+	return reverts, nil
+}
+
+// withUnreverted returns migrations followed by the prior migrations that
+// were removed but are still in applied, in their prior order. A nil applied
+// (the bookkeeping table could not be read) keeps every removed migration.
+func withUnreverted(migrations, prior []models.SQLMigrationModel, applied []api.AppliedMigration) []models.SQLMigrationModel {
+	configured := make(map[string]struct{}, len(migrations))
+	for _, m := range migrations {
+		configured[m.Version.ValueString()] = struct{}{}
+	}
+	recorded := make(map[string]struct{}, len(applied))
+	for _, a := range applied {
+		recorded[a.Version] = struct{}{}
+	}
+
+	kept := append([]models.SQLMigrationModel{}, migrations...)
+	for _, m := range prior {
+		version := m.Version.ValueString()
+		if _, ok := configured[version]; ok {
+			continue
+		}
+		if _, ok := recorded[version]; ok || applied == nil {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// checkMigrationOrder rejects a pending migration listed before an applied
+// one: it would run after migrations written against a schema without it.
+func checkMigrationOrder(migrations []models.SQLMigrationModel, applied map[string]string) error {
+	pending := ""
+	for _, m := range migrations {
+		version := m.Version.ValueString()
+		if _, ok := applied[version]; !ok {
+			if pending == "" {
+				pending = version
+			}
+			continue
+		}
+		if pending != "" {
+			return fmt.Errorf("migration %q is pending but the later migration %q is already applied", pending, version)
+		}
+	}
+	return nil
+}
+
+func appliedMigrationsFromList(ctx context.Context, list types.List) (map[string]string, diag.Diagnostics) {
+	applied := map[string]string{}
+	if list.IsNull() || list.IsUnknown() {
+		return applied, nil
+	}
+	var entries []models.AppliedSQLMigrationModel
+	diags := list.ElementsAs(ctx, &entries, false)
+	for _, m := range entries {
+		applied[m.Version.ValueString()] = m.Checksum.ValueString()
+	}
+	return applied, diags
+}
+
+func appliedMigrationsToList(applied []api.AppliedMigration) (types.List, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(applied))
+	for _, a := range applied {
+		values = append(values, models.AppliedSQLMigrationModel{
+			Version:  types.StringValue(a.Version),
+			Checksum: types.StringValue(a.Checksum),
+		}.ObjectValue())
+	}
+	return types.ListValue(models.AppliedSQLMigrationModel{}.ObjectType(), values)
+}
+
+func migrationsToList(migrations []models.SQLMigrationModel) (types.List, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(migrations))
+	for _, m := range migrations {
+		values = append(values, m.ObjectValue())
+	}
+	return types.ListValue(models.SQLMigrationModel{}.ObjectType(), values)
+}
+
+// loadMigrationsDirectory reads <version>_<title>.up.sql files and their
+// optional .down.sql counterparts from dir.
+func loadMigrationsDirectory(dir string) ([]models.SQLMigrationModel, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0)
+	files := map[string]string{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+		version, _, _ := strings.Cut(strings.TrimSuffix(name, ".up.sql"), "_")
+		if version == "" {
+			return nil, fmt.Errorf("%s: file name does not start with a version", name)
+		}
+		if other, ok := files[version]; ok {
+			return nil, fmt.Errorf("%s and %s have the same version %q", other, name, version)
+		}
+		files[version] = name
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no *.up.sql files found in %s", dir)
+	}
+	sortMigrationVersions(versions)
+
+	migrations := make([]models.SQLMigrationModel, 0, len(versions))
+	for _, version := range versions {
+		upFile := files[version]
+		up, err := os.ReadFile(filepath.Join(dir, upFile)) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		m := models.SQLMigrationModel{
+			Version: types.StringValue(version),
+			UpSQL:   types.StringValue(string(up)),
+			DownSQL: types.StringNull(),
+		}
+		down, err := os.ReadFile(filepath.Join(dir, strings.TrimSuffix(upFile, ".up.sql")+".down.sql")) //nolint:gosec
+		if err == nil {
+			m.DownSQL = types.StringValue(string(down))
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+// sortMigrationVersions orders versions numerically when all of them are
+// numbers, so 2 comes before 10, and lexically otherwise.
+func sortMigrationVersions(versions []string) {
+	numeric := true
+	for _, v := range versions {
+		if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+			numeric = false
+			break
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if numeric {
+			a, _ := strconv.ParseUint(versions[i], 10, 64)
+			b, _ := strconv.ParseUint(versions[j], 10, 64)
+			return a < b
+		}
+		return versions[i] < versions[j]
+	})
+}
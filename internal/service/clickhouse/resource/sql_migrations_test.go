@@ -0,0 +1,140 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+)
+
+func migration(version, down string) models.SQLMigrationModel {
+	m := models.SQLMigrationModel{
+		Version: types.StringValue(version),
+		UpSQL:   types.StringValue("SELECT " + version),
+		DownSQL: types.StringNull(),
+	}
+	if down != "" {
+		m.DownSQL = types.StringValue(down)
+	}
+	return m
+}
+
+func TestLoadMigrationsDirectory(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"10_add_users.up.sql":      "ALTER TABLE t ADD COLUMN users UInt64",
+		"2_create.up.sql":          "CREATE TABLE t (x UInt8) ENGINE = MergeTree ORDER BY x",
+		"2_create.down.sql":        "DROP TABLE t",
+		"README.md":                "ignored",
+		"10_add_users.down.sql.bk": "ignored",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := loadMigrationsDirectory(dir)
+	if err != nil {
+		t.Fatalf("loadMigrationsDirectory: %v", err)
+	}
+	if len(got) != 2 || got[0].Version.ValueString() != "2" || got[1].Version.ValueString() != "10" {
+		t.Fatalf("versions = %v; want [2 10]", got)
+	}
+	if got[0].DownSQL.ValueString() != "DROP TABLE t" || !got[1].DownSQL.IsNull() {
+		t.Errorf("down_sql = %s, %s; want DROP TABLE t and null", got[0].DownSQL, got[1].DownSQL)
+	}
+}
+
+func TestMigrationsToRevert(t *testing.T) {
+	prior := []models.SQLMigrationModel{migration("1", ""), migration("2", "DROP TABLE b"), migration("3", "DROP TABLE c")}
+	applied := map[string]string{"1": "x", "2": "y", "3": "z"}
+
+	got, err := migrationsToRevert(prior[:1], prior, applied)
+	if err != nil {
+		t.Fatalf("migrationsToRevert: %v", err)
+	}
+	if len(got) != 2 || got[0].Version.ValueString() != "3" || got[1].Version.ValueString() != "2" {
+		t.Errorf("reverts = %v; want 3 then 2", got)
+	}
+
+	if _, err := migrationsToRevert(prior[1:], prior, applied); err == nil {
+		t.Error("removing a migration without down_sql succeeded; want error")
+	}
+	if _, err := migrationsToRevert(prior[:1], prior[:1], map[string]string{"1": "x", "4": "w"}); err == nil {
+		t.Error("unknown applied version succeeded; want error")
+	}
+}
+
+func TestCheckMigrationOrder(t *testing.T) {
+	migrations := []models.SQLMigrationModel{migration("1", ""), migration("2", ""), migration("3", "")}
+
+	if err := checkMigrationOrder(migrations, map[string]string{"1": "x"}); err != nil {
+		t.Errorf("appending migrations: %v", err)
+	}
+	if err := checkMigrationOrder(migrations, map[string]string{"1": "x", "3": "z"}); err == nil {
+		t.Error("pending migration before an applied one succeeded; want error")
+	}
+}
+
+func TestMigrationsPending(t *testing.T) {
+	migrations := []models.SQLMigrationModel{migration("1", ""), migration("2", "")}
+
+	if migrationsPending(migrations, map[string]string{"1": "x", "2": "y"}) {
+		t.Error("all applied reported as pending")
+	}
+	if !migrationsPending(migrations, map[string]string{"1": "x"}) {
+		t.Error("new migration not reported as pending")
+	}
+	if !migrationsPending(migrations[:1], map[string]string{"1": "x", "2": "y"}) {
+		t.Error("removed migration not reported as pending")
+	}
+}
+
+func TestMigrate_FailedRevertKeepsDownSQL(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+	client := api.NewClientMock(mc)
+
+	recorded := []api.AppliedMigration{{Version: "1"}, {Version: "2"}, {Version: "3"}}
+	client.GetAppliedMigrationsMock.Set(func(context.Context, string, api.MigrationsTable) ([]api.AppliedMigration, error) {
+		return recorded, nil
+	})
+	client.RevertMigrationMock.Set(func(_ context.Context, _ string, _ api.MigrationsTable, version string, _ []string) error {
+		if version == "2" {
+			return errors.New("table b is in use")
+		}
+		recorded = recorded[:len(recorded)-1]
+		return nil
+	})
+
+	prior := []models.SQLMigrationModel{migration("1", ""), migration("2", "DROP TABLE b"), migration("3", "DROP TABLE c")}
+	list, d := migrationsToList(prior[:1])
+	if d.HasError() {
+		t.Fatal(d)
+	}
+	plan := models.SQLMigrationsResourceModel{ServiceID: types.StringValue("svc"), Migrations: list}
+
+	r := &SQLMigrationsResource{client: client}
+	if diags := r.migrate(ctx, &plan, prior); !diags.HasError() {
+		t.Fatal("migrate succeeded; want the revert of 2 to fail")
+	}
+
+	var got []models.SQLMigrationModel
+	if d := plan.Migrations.ElementsAs(ctx, &got, false); d.HasError() {
+		t.Fatal(d)
+	}
+	if len(got) != 2 || got[1].Version.ValueString() != "2" || got[1].DownSQL.ValueString() != "DROP TABLE b" {
+		t.Errorf("migrations = %v; want 1 and 2 with its down_sql", got)
+	}
+	if len(plan.Applied.Elements()) != 2 {
+		t.Errorf("applied = %v; want 1 and 2", plan.Applied)
+	}
+}
@@ -0,0 +1,33 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+)
+
+// sqlEquivalentPlanModifier suppresses diffs on attributes holding SQL text
+// (view queries, row policy conditions) when the configured text only differs
+// from the state in whitespace, keyword case or redundant parentheses.
+// ClickHouse stores such text re-formatted, and users re-indenting a query
+// should not trigger a CREATE OR REPLACE.
+type sqlEquivalentPlanModifier struct{}
+
+func (m sqlEquivalentPlanModifier) Description(_ context.Context) string {
+	return "Keeps the stored SQL when the configured SQL only differs in formatting."
+}
+
+func (m sqlEquivalentPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m sqlEquivalentPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if sql.Equivalent(req.StateValue.ValueString(), req.ConfigValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,184 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/sql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &ViewResource{}
+	_ resource.ResourceWithConfigure      = &ViewResource{}
+	_ resource.ResourceWithImportState    = &ViewResource{}
+	_ resource.ResourceWithValidateConfig = &ViewResource{}
+)
+
+//go:embed descriptions/view.md
+var viewResourceDescription string
+
+func NewViewResource() resource.Resource {
+	return &ViewResource{}
+}
+
+type ViewResource struct {
+	client api.Client
+}
+
+func (r *ViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view"
+}
+
+// viewQueryAttribute is the SELECT body shared by views and materialized views.
+func viewQueryAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			sqlEquivalentPlanModifier{},
+		},
+	}
+}
+
+func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := databaseObjectAttributes("view")
+	attributes["query"] = viewQueryAttribute("SELECT query the view wraps. Changes are applied with `CREATE OR REPLACE VIEW`; differences in whitespace and keyword case are not reported as changes.")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: viewResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *ViewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureQueryAPIResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_view", &resp.Diagnostics)
+}
+
+func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.client.CreateView(ctx, plan.ServiceID.ValueString(), viewFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating view", err.Error())
+		return
+	}
+	if view == nil {
+		resp.Diagnostics.AddError("Error creating view", fmt.Sprintf("view %s.%s was not found after creation", plan.Database.ValueString(), plan.Name.ValueString()))
+		return
+	}
+
+	plan.ID = databaseObjectID(plan.ServiceID, plan.Database, plan.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	utils.BetaWarning("clickhouse_view", &resp.Diagnostics)
+	var state models.ViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.client.GetView(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading view", err.Error())
+		return
+	}
+	if view == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = databaseObjectID(state.ServiceID, state.Database, state.Name)
+	state.Query = readViewQuery(state.Query, view.Query)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.client.ReplaceView(ctx, plan.ServiceID.ValueString(), viewFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating view", err.Error())
+		return
+	}
+	if view == nil {
+		resp.Diagnostics.AddError("Error updating view", fmt.Sprintf("view %s.%s was not found after replacing it", plan.Database.ValueString(), plan.Name.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.ViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteView(ctx, state.ServiceID.ValueString(), state.Database.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting view", err.Error())
+	}
+}
+
+func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDatabaseObject(ctx, "view", req, resp)
+}
+
+func viewFromModel(m models.ViewResourceModel) api.View {
+	return api.View{
+		Database: m.Database.ValueString(),
+		Name:     m.Name.ValueString(),
+		Query:    m.Query.ValueString(),
+	}
+}
+
+// queryDatabase is the current database of Query API sessions, which
+// ClickHouse adds to bare table names when it stores a view's query.
+const queryDatabase = "default"
+
+// readViewQuery returns the query to keep in state after a read. ClickHouse
+// stores the query re-formatted and with table names qualified, so the prior
+// text is kept while it is equivalent. Create and Update keep the planned
+// query as is.
+func readViewQuery(prior types.String, stored string) types.String {
+	if prior.IsNull() || prior.IsUnknown() ||
+		!sql.Equivalent(sql.StripDatabase(prior.ValueString(), queryDatabase), sql.StripDatabase(stored, queryDatabase)) {
+		return types.StringValue(stored)
+	}
+	return prior
+}
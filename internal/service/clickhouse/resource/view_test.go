@@ -0,0 +1,52 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickhouse/resource/models"
+)
+
+func TestReadViewQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  types.String
+		stored string
+		want   string
+	}{
+		{name: "equivalent keeps configured text", query: types.StringValue("select a\n  from t"), stored: "SELECT a FROM t", want: "select a\n  from t"},
+		{name: "changed uses stored text", query: types.StringValue("SELECT a FROM t"), stored: "SELECT b FROM t", want: "SELECT b FROM t"},
+		{name: "qualified table keeps configured text", query: types.StringValue("SELECT a FROM t WHERE b = 1"), stored: "SELECT a FROM default.t WHERE b = 1", want: "SELECT a FROM t WHERE b = 1"},
+		{name: "other database is a change", query: types.StringValue("SELECT a FROM t"), stored: "SELECT a FROM analytics.t", want: "SELECT a FROM analytics.t"},
+		{name: "null uses stored text", query: types.StringNull(), stored: "SELECT a FROM t", want: "SELECT a FROM t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if query := readViewQuery(tt.query, tt.stored); query.ValueString() != tt.want {
+				t.Errorf("query = %q, want %q", query.ValueString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyMaterializedViewToState_ToDatabase(t *testing.T) {
+	view := &api.MaterializedView{Database: "default", Name: "mv", ToDatabase: "default", ToTable: "target", Query: "SELECT 1"}
+
+	state := models.MaterializedViewResourceModel{Database: types.StringValue("default"), ToDatabase: types.StringNull()}
+	applyMaterializedViewToState(view, &state)
+	if !state.ToDatabase.IsNull() {
+		t.Errorf("to_database = %s, want null when the view writes to its own database", state.ToDatabase)
+	}
+	if state.ToTable.ValueString() != "target" {
+		t.Errorf("to_table = %q, want target", state.ToTable.ValueString())
+	}
+
+	view.ToDatabase = "analytics"
+	applyMaterializedViewToState(view, &state)
+	if state.ToDatabase.ValueString() != "analytics" {
+		t.Errorf("to_database = %s, want analytics", state.ToDatabase)
+	}
+}
@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure      = (*alertResource)(nil)
 	_ resource.ResourceWithImportState    = (*alertResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*alertResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*alertResource)(nil)
 )
 
 // Threshold type values referenced in more than one place.
@@ -50,11 +51,14 @@ var alertIntervalMinutes = map[string]int64{
 	"1h": 60, "6h": 360, "12h": 720, "1d": 1440,
 }
 
-// channelTypeWebhook is the only channel type supported today.
+// channelTypeWebhook is the only channel type the alerts API has. Slack,
+// incident.io and generic HTTP endpoints are webhook services, and PagerDuty,
+// Opsgenie and Teams are generic webhooks with a body_template, so every
+// destination is still a webhook channel. There is no email channel.
 const channelTypeWebhook = "webhook"
 
-// alertChannelTypes is the set of accepted channel types. Only webhook exists
-// today; more are expected, at which point each adds its own required sub-field.
+// alertChannelTypes is the set of accepted channel types. Should the API grow
+// another, it adds its own required sub-field alongside webhook_id.
 var alertChannelTypes = []string{channelTypeWebhook}
 
 func isRangeThresholdType(t string) bool { return slices.Contains(alertRangeThresholdTypes, t) }
@@ -64,7 +68,7 @@ func NewAlertResource() resource.Resource {
 	return &alertResource{}
 }
 
-// alertResource manages a ClickStack alert (saved-search source only).
+// alertResource manages a ClickStack alert on a saved search or a dashboard tile.
 type alertResource struct {
 	client *client.Client
 }
@@ -82,6 +86,9 @@ type alertResourceModel struct {
 	ID                    types.String       `tfsdk:"id"`
 	Team                  types.String       `tfsdk:"team"`
 	SavedSearchID         types.String       `tfsdk:"saved_search_id"`
+	DashboardID           types.String       `tfsdk:"dashboard_id"`
+	TileName              types.String       `tfsdk:"tile_name"`
+	TileID                types.String       `tfsdk:"tile_id"`
 	GroupBy               types.String       `tfsdk:"group_by"`
 	Channel               *alertChannelModel `tfsdk:"channel"`
 	Threshold             types.Float64      `tfsdk:"threshold"`
@@ -102,7 +109,7 @@ func (r *alertResource) Metadata(_ context.Context, req resource.MetadataRequest
 
 func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a ClickStack alert that evaluates a saved search on a schedule and " +
+		Description: "Manages a ClickStack alert that evaluates a saved search or a dashboard tile on a schedule and " +
 			"notifies through a channel when a threshold is crossed.\n\n" +
 			"Alerts are threshold-based (there is no anomaly mode). Configuration is validated at " +
 			"plan time; those rules mirror the ClickStack server contract on a best-effort basis, so " +
@@ -121,8 +128,24 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"saved_search_id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the saved search this alert evaluates.",
+				Optional:    true,
+				Description: "ID of the saved search this alert evaluates. Exactly one of `saved_search_id` and `dashboard_id` must be set.",
+			},
+			"dashboard_id": schema.StringAttribute{
+				Optional: true,
+				Description: "ID of the dashboard whose tile this alert evaluates; requires `tile_name`. " +
+					"Changing it, or switching between a saved search and a tile, forces the alert to be replaced.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tile_name": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the tile on `dashboard_id` to alert on. It must be unique on the " +
+					"dashboard. The alert follows the tile with this name: if a dashboard edit gives the " +
+					"tile a new ID, the next apply moves the alert onto it.",
+			},
+			"tile_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Server ID of the tile `tile_name` resolves to. Null for a saved-search alert.",
 			},
 			"group_by": schema.StringAttribute{
 				Optional: true,
@@ -138,7 +161,7 @@ func (r *alertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
 						Required:    true,
-						Description: "Channel type. Currently only `webhook` is supported.",
+						Description: "Channel type. Only `webhook` exists in the ClickStack API: to notify Slack use a `clickhouse_clickstack_webhook` with `service = \"slack\"`, and for PagerDuty, Opsgenie or Microsoft Teams a `generic` webhook with a `body_template`. Email is not supported.",
 					},
 					"webhook_id": schema.StringAttribute{
 						Optional:    true,
@@ -290,6 +313,27 @@ func (m *alertResourceModel) validate() diag.Diagnostics {
 	validateLen(&diags, path.Root("message"), m.Message, 4096)
 	validateLen(&diags, path.Root("note"), m.Note, 4096)
 
+	// Source: a saved search, or a dashboard tile named by dashboard_id and
+	// tile_name. Unknown values may still resolve to null, so they pass.
+	savedSearch := !m.SavedSearchID.IsNull()
+	tile := !m.DashboardID.IsNull()
+	switch {
+	case savedSearch && tile:
+		diags.AddAttributeError(path.Root("dashboard_id"), "Conflicting alert source",
+			"set either saved_search_id or dashboard_id and tile_name, not both")
+	case !savedSearch && !tile:
+		diags.AddAttributeError(path.Root("saved_search_id"), "Missing alert source",
+			"set saved_search_id, or dashboard_id and tile_name for a tile alert")
+	}
+	if tile && m.TileName.IsNull() {
+		diags.AddAttributeError(path.Root("tile_name"), "tile_name required",
+			"tile_name is required when dashboard_id is set")
+	}
+	if !tile && !m.TileName.IsNull() && !m.DashboardID.IsUnknown() {
+		diags.AddAttributeError(path.Root("tile_name"), "tile_name without dashboard_id",
+			"tile_name only applies to a tile alert; set dashboard_id as well")
+	}
+
 	// Channel: type must be known, and webhook channels require a webhook_id.
 	if m.Channel != nil {
 		ct := m.Channel.Type
@@ -316,6 +360,11 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveTile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	al, err := r.client.WithTeam(plan.Team.ValueString()).CreateAlert(ctx, plan.toClient())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating Alert", err.Error())
@@ -345,6 +394,10 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	state.applyAlert(al)
+	// On import tile_name is null: backfill it from the tile the alert is on.
+	if al.Source == client.AlertSourceTile && state.TileName.IsNull() {
+		resp.Diagnostics.Append(r.backfillTileName(ctx, &state)...)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -355,6 +408,11 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveTile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	al, err := r.client.WithTeam(plan.Team.ValueString()).UpdateAlert(ctx, plan.ID.ValueString(), plan.toClient())
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
@@ -369,6 +427,87 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ModifyPlan plans tile_id as the ID tile_name resolves to on the dashboard
+// today, so an alert whose tile got a new ID in a dashboard edit is moved
+// onto it. When the tile cannot be resolved yet (a dashboard or tile created
+// in the same apply), tile_id is unknown and resolved on apply.
+func (r *alertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan alertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.DashboardID.IsNull():
+		plan.TileID = types.StringNull()
+	case !known(plan.DashboardID) || !known(plan.TileName) || r.client == nil:
+		plan.TileID = types.StringUnknown()
+	default:
+		id, err := r.lookupTileID(ctx, plan)
+		if err != nil {
+			tflog.Debug(ctx, "could not resolve the alert's tile at plan time; resolving on apply: "+err.Error())
+			plan.TileID = types.StringUnknown()
+		} else {
+			plan.TileID = types.StringValue(id)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tile_id"), plan.TileID)...)
+}
+
+// resolveTile sets the tile ID of a tile alert whose plan left it unknown.
+func (r *alertResource) resolveTile(ctx context.Context, plan *alertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.DashboardID.IsNull() {
+		plan.TileID = types.StringNull()
+		return diags
+	}
+	if known(plan.TileID) {
+		return diags
+	}
+	id, err := r.lookupTileID(ctx, *plan)
+	if err != nil {
+		diags.AddAttributeError(path.Root("tile_name"), "Could not resolve dashboard tile", err.Error())
+		return diags
+	}
+	plan.TileID = types.StringValue(id)
+	return diags
+}
+
+// lookupTileID fetches the alert's dashboard and resolves tile_name on it.
+func (r *alertResource) lookupTileID(ctx context.Context, m alertResourceModel) (string, error) {
+	body, err := r.client.WithTeam(m.Team.ValueString()).GetDashboard(ctx, m.DashboardID.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("read dashboard %s: %w", m.DashboardID.ValueString(), err)
+	}
+	return resolveTileID(body, m.TileName.ValueString())
+}
+
+// backfillTileName sets tile_name from the dashboard tile the alert is on. A
+// tile that can no longer be found leaves it null, with a warning.
+func (r *alertResource) backfillTileName(ctx context.Context, m *alertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	body, err := r.client.WithTeam(m.Team.ValueString()).GetDashboard(ctx, m.DashboardID.ValueString())
+	if err == nil {
+		var name string
+		var found bool
+		name, found, err = tileNameByID(body, m.TileID.ValueString())
+		if found {
+			m.TileName = types.StringValue(name)
+			return diags
+		}
+		if err == nil {
+			err = fmt.Errorf("the dashboard has no tile with ID %s", m.TileID.ValueString())
+		}
+	}
+	diags.AddAttributeWarning(path.Root("tile_name"), "Could not determine the alert's tile",
+		"tile_name was left unset: "+err.Error())
+	return diags
+}
+
 func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state alertResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -401,12 +540,17 @@ func (m *alertResourceModel) toClient() client.Alert {
 		Threshold:       m.Threshold.ValueFloat64(),
 		ThresholdType:   m.ThresholdType.ValueString(),
 		SavedSearchID:   m.SavedSearchID.ValueString(),
+		DashboardID:     m.DashboardID.ValueString(),
+		TileID:          m.TileID.ValueString(),
 		GroupBy:         optStringPtr(m.GroupBy),
 		Name:            optStringPtr(m.Name),
 		Message:         optStringPtr(m.Message),
 		Note:            optStringPtr(m.Note),
 		ScheduleStartAt: optStringPtr(m.ScheduleStartAt),
 	}
+	if known(m.DashboardID) {
+		al.Source = client.AlertSourceTile
+	}
 	if m.Channel != nil {
 		al.Channel = client.AlertChannel{
 			Type:      m.Channel.Type.ValueString(),
@@ -437,7 +581,9 @@ func (m *alertResourceModel) toClient() client.Alert {
 
 func (m *alertResourceModel) applyAlert(al *client.Alert) {
 	m.ID = types.StringValue(al.ID)
-	m.SavedSearchID = types.StringValue(al.SavedSearchID)
+	m.SavedSearchID = emptyToNull(al.SavedSearchID)
+	m.DashboardID = emptyToNull(al.DashboardID)
+	m.TileID = emptyToNull(al.TileID)
 	m.GroupBy = types.StringPointerValue(al.GroupBy)
 	m.Channel = &alertChannelModel{
 		Type:      types.StringValue(al.Channel.Type),
@@ -55,6 +55,9 @@ func TestAlertResource_Schema(t *testing.T) {
 func mkAlert(mods func(*alertResourceModel)) alertResourceModel {
 	m := alertResourceModel{
 		SavedSearchID:         types.StringValue("ss1"),
+		DashboardID:           types.StringNull(),
+		TileName:              types.StringNull(),
+		TileID:                types.StringNull(),
 		GroupBy:               types.StringNull(),
 		Channel:               &alertChannelModel{Type: types.StringValue("webhook"), WebhookID: types.StringValue("wh1")},
 		Threshold:             types.Float64Value(100),
@@ -163,6 +166,50 @@ func TestAlertResource_Validate(t *testing.T) {
 			func(m *alertResourceModel) { m.Channel.Type = types.StringValue("email") },
 			true,
 		},
+		{
+			"valid tile alert",
+			func(m *alertResourceModel) {
+				m.SavedSearchID = types.StringNull()
+				m.DashboardID = types.StringValue("d1")
+				m.TileName = types.StringValue("Errors")
+			},
+			false,
+		},
+		{
+			"saved search and dashboard both set",
+			func(m *alertResourceModel) {
+				m.DashboardID = types.StringValue("d1")
+				m.TileName = types.StringValue("Errors")
+			},
+			true,
+		},
+		{
+			"no source",
+			func(m *alertResourceModel) { m.SavedSearchID = types.StringNull() },
+			true,
+		},
+		{
+			"dashboard_id without tile_name",
+			func(m *alertResourceModel) {
+				m.SavedSearchID = types.StringNull()
+				m.DashboardID = types.StringValue("d1")
+			},
+			true,
+		},
+		{
+			"tile_name without dashboard_id",
+			func(m *alertResourceModel) { m.TileName = types.StringValue("Errors") },
+			true,
+		},
+		{
+			"unknown dashboard_id passes",
+			func(m *alertResourceModel) {
+				m.SavedSearchID = types.StringNull()
+				m.DashboardID = types.StringUnknown()
+				m.TileName = types.StringValue("Errors")
+			},
+			false,
+		},
 		{
 			"name too long",
 			func(m *alertResourceModel) { m.Name = types.StringValue(string(make([]byte, 513))) },
@@ -223,6 +270,33 @@ func TestAlertResource_ToClient(t *testing.T) {
 	})
 }
 
+func TestAlertResource_ToClient_TileSource(t *testing.T) {
+	t.Parallel()
+	m := mkAlert(func(m *alertResourceModel) {
+		m.SavedSearchID = types.StringNull()
+		m.DashboardID = types.StringValue("d1")
+		m.TileName = types.StringValue("Errors")
+		m.TileID = types.StringValue("t1")
+	})
+	al := m.toClient()
+	if al.Source != client.AlertSourceTile || al.DashboardID != "d1" || al.TileID != "t1" {
+		t.Errorf("unexpected tile source: source=%q dashboard=%q tile=%q", al.Source, al.DashboardID, al.TileID)
+	}
+	if al.SavedSearchID != "" {
+		t.Errorf("expected no savedSearchId on a tile alert, got %q", al.SavedSearchID)
+	}
+
+	var back alertResourceModel
+	back.TileName = types.StringValue("Errors")
+	back.applyAlert(&client.Alert{ID: "al1", Source: client.AlertSourceTile, DashboardID: "d1", TileID: "t1"})
+	if !back.SavedSearchID.IsNull() || back.DashboardID.ValueString() != "d1" || back.TileID.ValueString() != "t1" {
+		t.Errorf("unexpected state: saved_search_id=%s dashboard_id=%s tile_id=%s", back.SavedSearchID, back.DashboardID, back.TileID)
+	}
+	if back.TileName.ValueString() != "Errors" {
+		t.Errorf("tile_name must be kept from config, got %s", back.TileName)
+	}
+}
+
 func TestAlertResource_ApplyAlert(t *testing.T) {
 	t.Parallel()
 
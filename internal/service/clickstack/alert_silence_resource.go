@@ -0,0 +1,510 @@
+package clickstack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = (*alertSilenceResource)(nil)
+	_ resource.ResourceWithConfigure      = (*alertSilenceResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*alertSilenceResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*alertSilenceResource)(nil)
+)
+
+// NewAlertSilenceResource is a helper to register the resource with the provider.
+func NewAlertSilenceResource() resource.Resource {
+	return &alertSilenceResource{}
+}
+
+// alertSilenceResource silences a group of alerts for a maintenance window.
+// There is no silence object server-side: the resource sets and lifts the
+// silence of each selected alert, and the ID only identifies the window in
+// state.
+type alertSilenceResource struct {
+	client *client.Client
+}
+
+// alertSilenceResourceModel maps the resource schema data.
+type alertSilenceResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Team             types.String `tfsdk:"team"`
+	AlertIDs         types.Set    `tfsdk:"alert_ids"`
+	SavedSearchTags  types.Set    `tfsdk:"saved_search_tags"`
+	StartTime        types.String `tfsdk:"start_time"`
+	EndTime          types.String `tfsdk:"end_time"`
+	Reason           types.String `tfsdk:"reason"`
+	SilencedAlertIDs types.Set    `tfsdk:"silenced_alert_ids"`
+	Active           types.Bool   `tfsdk:"active"`
+}
+
+func (r *alertSilenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_alert_silence"
+}
+
+func (r *alertSilenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Silences a group of ClickStack alerts for a maintenance window. Between `start_time` " +
+			"and `end_time` the selected alerts are silenced until `end_time`; the server lifts the " +
+			"silence on its own at that time, after which the resource stays in state with nothing to " +
+			"change. A window that starts in the future is applied by the first `terraform apply` run " +
+			"after `start_time`, so schedule one for it. Destroying the resource lifts the silences it " +
+			"set, leaving silences set in the UI with a different end time alone. The " +
+			"`clickhouse_clickstack_alert` resource never reads or changes a silence.",
+		Attributes: map[string]schema.Attribute{
+			idAttr: schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the silence window. It exists only in Terraform state.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			teamAttr: schema.StringAttribute{
+				Optional: true,
+				Description: "Team ID of the alerts, sent as the `x-hdx-team` header. Defaults to the API " +
+					"key's team. Changing this forces a new window.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"alert_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the alerts to silence. Exactly one of `alert_ids` and `saved_search_tags` must be set. " +
+					"Alerts deleted since are skipped with a warning until they are removed from this list.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("alert_ids"), path.MatchRoot("saved_search_tags")),
+				},
+			},
+			"saved_search_tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Silences every alert on a saved search carrying any of these tags. The " +
+					"selection is resolved on each plan, so alerts added later are silenced by the next " +
+					"apply. Dashboard tile alerts have no tags and are never selected.",
+				Validators: []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"start_time": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Start of the window (RFC3339). Defaults to the time of the first apply.",
+				PlanModifiers: []planmodifier.String{rfc3339EqualPlanModifier{}},
+			},
+			"end_time": schema.StringAttribute{
+				Required:      true,
+				Description:   "End of the window (RFC3339); the alerts are silenced until this time.",
+				PlanModifiers: []planmodifier.String{rfc3339EqualPlanModifier{}},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Description: "Why the alerts are silenced. The API has no field for it, so it is kept in " +
+					"Terraform state only.",
+			},
+			"silenced_alert_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the alerts the window applies to: `alert_ids`, or the alerts `saved_search_tags` selects.",
+			},
+			"active": schema.BoolAttribute{
+				Computed: true,
+				Description: "Whether the selected alerts are currently silenced by this window. Decided at apply " +
+					"time, so a saved plan applied after `start_time` still silences the alerts.",
+			},
+		},
+	}
+}
+
+func (r *alertSilenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "resource")
+		return
+	}
+	r.client = providerData.ClickStack
+}
+
+func (r *alertSilenceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_clickstack_alert_silence", &resp.Diagnostics)
+	var config alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// validate checks that the window's times parse and end after they start.
+func (m *alertSilenceResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	parse := func(attr string, v types.String) (time.Time, bool) {
+		if !known(v) {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(time.RFC3339, v.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root(attr), "Invalid time", fmt.Sprintf("%s must be an RFC3339 time: %s", attr, err))
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	end, endOK := parse("end_time", m.EndTime)
+	start, startOK := parse("start_time", m.StartTime)
+	if endOK && startOK && !end.After(start) {
+		diags.AddAttributeError(path.Root("end_time"), "Invalid silence window", "end_time must be after start_time.")
+	}
+	return diags
+}
+
+// activeAt reports whether the window covers t. A null start_time means the
+// window started when it was first applied. Unparseable times (rejected by
+// ValidateConfig) count as inactive.
+func (m *alertSilenceResourceModel) activeAt(t time.Time) bool {
+	end, err := time.Parse(time.RFC3339, m.EndTime.ValueString())
+	if err != nil || !t.Before(end) {
+		return false
+	}
+	if m.StartTime.IsNull() {
+		return true
+	}
+	start, err := time.Parse(time.RFC3339, m.StartTime.ValueString())
+	return err == nil && !t.Before(start)
+}
+
+// selectAlerts resolves the window's alert IDs: those of alert_ids that still
+// exist, or the alerts on saved searches carrying any of saved_search_tags,
+// sorted. Deleted alerts are left out so they are never silenced; see
+// warnDeletedAlerts.
+func (r *alertSilenceResource) selectAlerts(ctx context.Context, m alertSilenceResourceModel) ([]string, error) {
+	c := r.client.WithTeam(m.Team.ValueString())
+	if !m.AlertIDs.IsNull() {
+		var configured []string
+		if diags := m.AlertIDs.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return nil, fmt.Errorf("read alert_ids: %v", diags)
+		}
+		alerts, err := c.ListAlerts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := []string{}
+		for _, al := range alerts {
+			if slices.Contains(configured, al.ID) {
+				ids = append(ids, al.ID)
+			}
+		}
+		slices.Sort(ids)
+		return ids, nil
+	}
+
+	var tags []string
+	if diags := m.SavedSearchTags.ElementsAs(ctx, &tags, false); diags.HasError() {
+		return nil, fmt.Errorf("read saved_search_tags: %v", diags)
+	}
+	searches, err := c.ListSavedSearches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagged := map[string]bool{}
+	for _, ss := range searches {
+		if slices.ContainsFunc(ss.Tags, func(t string) bool { return slices.Contains(tags, t) }) {
+			tagged[ss.ID] = true
+		}
+	}
+	alerts, err := c.ListAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, al := range alerts {
+		if al.Source != client.AlertSourceTile && tagged[al.SavedSearchID] {
+			ids = append(ids, al.ID)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// warnDeletedAlerts warns about the entries of alert_ids missing from found,
+// i.e. alerts deleted since they were configured. They are skipped rather than
+// failing every apply with a 404, until they are removed from alert_ids.
+func warnDeletedAlerts(ctx context.Context, diags *diag.Diagnostics, alertIDs types.Set, found []string) {
+	if !known(alertIDs) {
+		return
+	}
+	var configured []string
+	diags.Append(alertIDs.ElementsAs(ctx, &configured, false)...)
+	missing := slices.DeleteFunc(configured, func(id string) bool { return slices.Contains(found, id) })
+	if len(missing) == 0 {
+		return
+	}
+	slices.Sort(missing)
+	diags.AddAttributeWarning(path.Root("alert_ids"), "Alerts no longer exist",
+		fmt.Sprintf("These alerts were deleted and are not silenced: %s. Remove them from alert_ids.", strings.Join(missing, ", ")))
+}
+
+// ModifyPlan plans the alert selection. Whether the window is active is
+// decided at apply time, so a saved plan applied after start_time still
+// silences the alerts: active is planned unknown whenever the window changes
+// or its activity differs from state, e.g. once start_time passes or after its
+// silences were lifted in the UI, and the apply then silences the alerts
+// again. Otherwise active keeps its state value and nothing is planned.
+func (r *alertSilenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SilencedAlertIDs = types.SetUnknown(types.StringType)
+	if known(plan.AlertIDs) || known(plan.SavedSearchTags) && r.client != nil {
+		ids, err := r.selectAlerts(ctx, plan)
+		if err != nil {
+			tflog.Debug(ctx, "could not resolve the silenced alerts at plan time; resolving on apply: "+err.Error())
+		} else {
+			warnDeletedAlerts(ctx, &resp.Diagnostics, plan.AlertIDs, ids)
+			set, diags := types.SetValueFrom(ctx, types.StringType, ids)
+			resp.Diagnostics.Append(diags...)
+			plan.SilencedAlertIDs = set
+		}
+	}
+
+	plan.Active = types.BoolUnknown()
+	if !req.State.Raw.IsNull() {
+		var state alertSilenceResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.sameWindow(state) && known(state.Active) && state.Active.ValueBool() == plan.activeAt(time.Now()) {
+			plan.Active = state.Active
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("silenced_alert_ids"), plan.SilencedAlertIDs)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), plan.Active)...)
+}
+
+// sameWindow reports whether m plans no change to state's window or alert
+// selection. Unknown values count as a change.
+func (m *alertSilenceResourceModel) sameWindow(state alertSilenceResourceModel) bool {
+	return m.Team.Equal(state.Team) &&
+		m.AlertIDs.Equal(state.AlertIDs) &&
+		m.SavedSearchTags.Equal(state.SavedSearchTags) &&
+		m.StartTime.Equal(state.StartTime) &&
+		m.EndTime.Equal(state.EndTime) &&
+		m.Reason.Equal(state.Reason) &&
+		m.SilencedAlertIDs.Equal(state.SilencedAlertIDs)
+}
+
+func (r *alertSilenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := make([]byte, 8)
+	_, _ = rand.Read(id) // never fails
+	plan.ID = types.StringValue(hex.EncodeToString(id))
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created alert silence resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes active: the window is active while every selected alert is
+// still silenced until end_time. Alerts deleted since are dropped from
+// silenced_alert_ids, with a warning while alert_ids still lists them.
+func (r *alertSilenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	resp.Diagnostics.Append(state.SilencedAlertIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client.WithTeam(state.Team.ValueString())
+	active := state.activeAt(time.Now())
+	existing := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s, err := c.GetAlertSilence(ctx, id)
+		if errors.Is(err, client.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Alert Silence", err.Error())
+			return
+		}
+		existing = append(existing, id)
+		if s == nil || !rfc3339Equal(s.Until, state.EndTime.ValueString()) {
+			active = false
+		}
+	}
+
+	warnDeletedAlerts(ctx, &resp.Diagnostics, state.AlertIDs, existing)
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, existing)
+	resp.Diagnostics.Append(diags...)
+	state.SilencedAlertIDs = set
+	state.Active = types.BoolValue(active)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *alertSilenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete lifts the silences the window set. A silence whose end time no
+// longer matches was set or changed elsewhere and is left alone.
+func (r *alertSilenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertSilenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	resp.Diagnostics.Append(state.SilencedAlertIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.lift(ctx, state, ids); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Alert Silence", err.Error())
+	}
+}
+
+// apply brings the alerts in line with plan: when the window is active it
+// silences the selected alerts until end_time; otherwise it lifts the
+// silences a previous apply set. Alerts that left the selection since state
+// are unsilenced too.
+func (r *alertSilenceResource) apply(ctx context.Context, plan, state *alertSilenceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Use the selection the plan resolved, if any: an apply must not act on a
+	// different set of alerts than the plan showed.
+	var ids []string
+	if known(plan.SilencedAlertIDs) {
+		diags.Append(plan.SilencedAlertIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		var err error
+		if ids, err = r.selectAlerts(ctx, *plan); err != nil {
+			diags.AddError("Error Selecting Alerts", err.Error())
+			return diags
+		}
+	}
+	active := plan.Active.ValueBool()
+	if !known(plan.Active) {
+		active = plan.activeAt(time.Now())
+	}
+
+	if state != nil {
+		var previous []string
+		diags.Append(state.SilencedAlertIDs.ElementsAs(ctx, &previous, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		lifted := previous
+		if active {
+			lifted = slices.DeleteFunc(previous, func(id string) bool { return slices.Contains(ids, id) })
+		}
+		if err := r.lift(ctx, *state, lifted); err != nil {
+			diags.AddError("Error Lifting Alert Silence", err.Error())
+			return diags
+		}
+	}
+
+	c := r.client.WithTeam(plan.Team.ValueString())
+	if active {
+		for _, id := range ids {
+			if err := c.SilenceAlert(ctx, id, plan.EndTime.ValueString()); err != nil {
+				diags.AddError("Error Silencing Alert", err.Error())
+				return diags
+			}
+		}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	plan.SilencedAlertIDs = set
+	plan.Active = types.BoolValue(active)
+	return diags
+}
+
+// lift unsilences those of ids still silenced until m's end_time, i.e. by
+// this window. Deleted alerts are skipped.
+func (r *alertSilenceResource) lift(ctx context.Context, m alertSilenceResourceModel, ids []string) error {
+	c := r.client.WithTeam(m.Team.ValueString())
+	for _, id := range ids {
+		s, err := c.GetAlertSilence(ctx, id)
+		if errors.Is(err, client.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if s == nil || !rfc3339Equal(s.Until, m.EndTime.ValueString()) {
+			continue
+		}
+		if err := c.UnsilenceAlert(ctx, id); err != nil && !errors.Is(err, client.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
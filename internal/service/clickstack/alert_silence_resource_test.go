@@ -0,0 +1,210 @@
+package clickstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+// fakeSilences serves the alert, silence and saved-search endpoints a silence
+// window uses, keeping the silence end time of each alert.
+type fakeSilences struct {
+	mu       sync.Mutex
+	alerts   []client.Alert
+	searches []client.SavedSearch
+	until    map[string]string
+}
+
+func (f *fakeSilences) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	write := func(v any) { _ = json.NewEncoder(w).Encode(map[string]any{"data": v}) }
+	rest := strings.TrimPrefix(r.URL.Path, "/api/v2/alerts")
+	switch {
+	case r.URL.Path == "/api/v2/saved-searches":
+		write(f.searches)
+	case rest == "":
+		write(f.alerts)
+	case strings.HasSuffix(rest, "/silenced"):
+		id := strings.TrimSuffix(strings.TrimPrefix(rest, "/"), "/silenced")
+		if r.Method == http.MethodDelete {
+			delete(f.until, id)
+			return
+		}
+		var body struct {
+			MutedUntil string `json:"mutedUntil"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.until[id] = body.MutedUntil
+	default:
+		id := strings.TrimPrefix(rest, "/")
+		al := map[string]any{"id": id}
+		if u, ok := f.until[id]; ok {
+			al["silenced"] = map[string]any{"until": u}
+		}
+		write(al)
+	}
+}
+
+func silenceWindow(t *testing.T, ids ...string) alertSilenceResourceModel {
+	t.Helper()
+	set, diags := types.SetValueFrom(context.Background(), types.StringType, ids)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return alertSilenceResourceModel{
+		AlertIDs:         set,
+		SavedSearchTags:  types.SetNull(types.StringType),
+		StartTime:        types.StringNull(),
+		EndTime:          types.StringValue(time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+		SilencedAlertIDs: types.SetUnknown(types.StringType),
+		Active:           types.BoolUnknown(),
+	}
+}
+
+func TestAlertSilence_ApplyAndLift(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSilences{
+		alerts: []client.Alert{{ID: "a1"}, {ID: "a2"}, {ID: "a3"}},
+		until:  map[string]string{"a3": "2030-01-01T00:00:00Z"},
+	}
+	r := &alertSilenceResource{client: dashboardTestClient(t, fake)}
+	ctx := context.Background()
+
+	state := silenceWindow(t, "a1", "a2", "a3")
+	if diags := r.apply(ctx, &state, nil); diags.HasError() {
+		t.Fatalf("apply: %s", diags)
+	}
+	if !state.Active.ValueBool() || len(fake.until) != 3 || fake.until["a1"] != state.EndTime.ValueString() {
+		t.Fatalf("alerts not silenced: active=%s until=%v", state.Active, fake.until)
+	}
+
+	// a2 leaves the selection and is unsilenced; a1 stays silenced.
+	plan := silenceWindow(t, "a1", "a3")
+	plan.EndTime = state.EndTime
+	if diags := r.apply(ctx, &plan, &state); diags.HasError() {
+		t.Fatalf("update: %s", diags)
+	}
+	if _, ok := fake.until["a2"]; ok || fake.until["a1"] == "" {
+		t.Errorf("unexpected silences after update: %v", fake.until)
+	}
+
+	// Lifting leaves a silence with another end time alone.
+	fake.until["a3"] = "2030-01-01T00:00:00Z"
+	if err := r.lift(ctx, plan, []string{"a1", "a3"}); err != nil {
+		t.Fatalf("lift: %v", err)
+	}
+	if _, ok := fake.until["a1"]; ok || fake.until["a3"] == "" {
+		t.Errorf("unexpected silences after lift: %v", fake.until)
+	}
+}
+
+func TestAlertSilence_FutureWindowIsNotApplied(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSilences{alerts: []client.Alert{{ID: "a1"}}, until: map[string]string{}}
+	r := &alertSilenceResource{client: dashboardTestClient(t, fake)}
+
+	m := silenceWindow(t, "a1")
+	m.StartTime = types.StringValue(time.Now().Add(30 * time.Minute).UTC().Format(time.RFC3339))
+	if diags := r.apply(context.Background(), &m, nil); diags.HasError() {
+		t.Fatalf("apply: %s", diags)
+	}
+	if m.Active.ValueBool() || len(fake.until) != 0 {
+		t.Errorf("a future window must not silence yet: active=%s until=%v", m.Active, fake.until)
+	}
+}
+
+func TestAlertSilence_SelectByTags(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSilences{
+		searches: []client.SavedSearch{{ID: "ss1", Tags: []string{"db"}}, {ID: "ss2", Tags: []string{"web"}}},
+		alerts: []client.Alert{
+			{ID: "a1", Source: client.AlertSourceSavedSearch, SavedSearchID: "ss1"},
+			{ID: "a2", Source: client.AlertSourceSavedSearch, SavedSearchID: "ss2"},
+			{ID: "a3", Source: client.AlertSourceTile, DashboardID: "d1"},
+		},
+	}
+	r := &alertSilenceResource{client: dashboardTestClient(t, fake)}
+
+	m := silenceWindow(t)
+	m.AlertIDs = types.SetNull(types.StringType)
+	m.SavedSearchTags, _ = types.SetValueFrom(context.Background(), types.StringType, []string{"db", "payments"})
+	ids, err := r.selectAlerts(context.Background(), m)
+	if err != nil {
+		t.Fatalf("selectAlerts: %v", err)
+	}
+	if !slices.Equal(ids, []string{"a1"}) {
+		t.Errorf("selected %v, want [a1]", ids)
+	}
+}
+
+func TestAlertSilence_DeletedAlertsAreSkipped(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSilences{alerts: []client.Alert{{ID: "a1"}}, until: map[string]string{}}
+	r := &alertSilenceResource{client: dashboardTestClient(t, fake)}
+	ctx := context.Background()
+
+	m := silenceWindow(t, "a1", "gone")
+	ids, err := r.selectAlerts(ctx, m)
+	if err != nil {
+		t.Fatalf("selectAlerts: %v", err)
+	}
+	if !slices.Equal(ids, []string{"a1"}) {
+		t.Errorf("selected %v, want [a1]", ids)
+	}
+	var diags diag.Diagnostics
+	warnDeletedAlerts(ctx, &diags, m.AlertIDs, ids)
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "gone") {
+		t.Errorf("want a warning naming the deleted alert, got %v", diags)
+	}
+
+	if d := r.apply(ctx, &m, nil); d.HasError() {
+		t.Fatalf("apply: %s", d)
+	}
+	if _, ok := fake.until["gone"]; ok || fake.until["a1"] == "" {
+		t.Errorf("unexpected silences: %v", fake.until)
+	}
+}
+
+func TestAlertSilence_SameWindow(t *testing.T) {
+	t.Parallel()
+	state := silenceWindow(t, "a1")
+	state.SilencedAlertIDs = state.AlertIDs
+	plan := state
+	if !plan.sameWindow(state) {
+		t.Error("identical window: want same")
+	}
+	plan.EndTime = types.StringValue(time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339))
+	if plan.sameWindow(state) {
+		t.Error("moved end_time: want a change")
+	}
+	plan = state
+	plan.SilencedAlertIDs = types.SetUnknown(types.StringType)
+	if plan.sameWindow(state) {
+		t.Error("unresolved selection: want a change")
+	}
+}
+
+func TestAlertSilence_Validate(t *testing.T) {
+	t.Parallel()
+	m := silenceWindow(t, "a1")
+	m.StartTime = types.StringValue("2026-11-01T06:00:00Z")
+	m.EndTime = types.StringValue("2026-11-01T02:00:00Z")
+	if diags := m.validate(); !diags.HasError() {
+		t.Error("want an error for an end before the start")
+	}
+	m.EndTime = types.StringValue("tomorrow")
+	if diags := m.validate(); !diags.HasError() {
+		t.Error("want an error for a non-RFC3339 end_time")
+	}
+}
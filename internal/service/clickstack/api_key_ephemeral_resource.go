@@ -0,0 +1,135 @@
+package clickstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure      = (*apiKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*apiKeyEphemeralResource)(nil)
+)
+
+// NewAPIKeyEphemeralResource is a helper to register the ephemeral resource
+// with the provider.
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+// apiKeyEphemeralResource reads the secret of a service API key managed by
+// clickhouse_clickstack_api_key, so that it never lands in state or plan.
+type apiKeyEphemeralResource struct {
+	client *client.Client
+}
+
+// apiKeyEphemeralResourceModel maps the ephemeral resource schema data.
+type apiKeyEphemeralResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Team types.String `tfsdk:"team"`
+	Name types.String `tfsdk:"name"`
+	Key  types.String `tfsdk:"key"`
+}
+
+func (e *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_api_key"
+}
+
+func (e *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the secret of a service API key created by the `clickhouse_clickstack_api_key` " +
+			"resource without writing it to state or plan (requires Terraform >= 1.10). Pass `key` on to " +
+			"write-only arguments, other ephemeral resources or provider configuration, for example to " +
+			"hand a collector or CI job its own ClickStack credentials.\n\n" +
+			"## Example\n\n" +
+			"```terraform\n" +
+			"resource \"clickhouse_clickstack_api_key\" \"ci\" {\n" +
+			"  name = \"ci\"\n" +
+			"}\n\n" +
+			"ephemeral \"clickhouse_clickstack_api_key\" \"ci\" {\n" +
+			"  id = clickhouse_clickstack_api_key.ci.id\n" +
+			"}\n\n" +
+			"resource \"aws_secretsmanager_secret_version\" \"ci\" {\n" +
+			"  secret_id                = aws_secretsmanager_secret.ci.id\n" +
+			"  secret_string_wo         = ephemeral.clickhouse_clickstack_api_key.ci.key\n" +
+			"  secret_string_wo_version = 1\n" +
+			"}\n" +
+			"```",
+		Attributes: map[string]schema.Attribute{
+			idAttr: schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the `clickhouse_clickstack_api_key` to read.",
+			},
+			teamAttr: schema.StringAttribute{
+				Optional:    true,
+				Description: "Team ID the key belongs to, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+			},
+			nameAttr: schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the key's virtual member.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key, used as a Bearer token against the ClickStack API.",
+			},
+		},
+	}
+}
+
+func (e *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "ephemeral resource")
+		return
+	}
+	e.client = providerData.ClickStack
+}
+
+func (e *apiKeyEphemeralResource) ValidateConfig(_ context.Context, _ ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_clickstack_api_key", &resp.Diagnostics)
+}
+
+func (e *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := e.client.WithTeam(data.Team.ValueString()).GetAPIKey(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading API Key", err.Error())
+		return
+	}
+	if member.AccessKey == nil || *member.AccessKey == "" {
+		resp.Diagnostics.AddError("API Key Not Returned",
+			fmt.Sprintf("The ClickStack API did not return the key of %s. Reading it requires an admin API key on the provider.", data.ID.ValueString()))
+		return
+	}
+
+	data.Name = types.StringPointerValue(member.Name)
+	data.Key = types.StringValue(*member.AccessKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,205 @@
+package clickstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = (*apiKeyResource)(nil)
+	_ resource.ResourceWithConfigure   = (*apiKeyResource)(nil)
+	_ resource.ResourceWithImportState = (*apiKeyResource)(nil)
+)
+
+// NewAPIKeyResource is a helper to register the resource with the provider.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource manages a service API key: a virtual (API-only) team member
+// whose access key authenticates against the API. The key itself is never
+// written to state; the clickhouse_clickstack_api_key ephemeral resource reads
+// it when needed.
+type apiKeyResource struct {
+	client *client.Client
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Team   types.String `tfsdk:"team"`
+	Name   types.String `tfsdk:"name"`
+	RoleID types.String `tfsdk:"role_id"`
+}
+
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_api_key"
+}
+
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a service API key for self-hosted ClickStack, held by a virtual (API-only) team " +
+			"member with its own role. The key is not stored in state: read it with the " +
+			"`clickhouse_clickstack_api_key` ephemeral resource (Terraform >= 1.10). Destroying the resource " +
+			"revokes the key; to rotate it, replace the resource (`terraform apply -replace`). Personal API " +
+			"keys belong to user accounts and are not managed here. ClickStack on ClickHouse Cloud is reached " +
+			"with ClickHouse Cloud API keys instead, so this resource is not available there.",
+		Attributes: map[string]schema.Attribute{
+			idAttr: schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the virtual member holding the key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			teamAttr: schema.StringAttribute{
+				Optional: true,
+				Description: "Team ID the key belongs to, sent as the `x-hdx-team` header. Defaults to the API key's team. " +
+					"Changing this forces a new key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			nameAttr: schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the key's virtual member, shown in the team's member list. Changing this forces a new key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			roleIDAttr: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "ID of the role granted to the key. Omit on OSS deployments, which have no RBAC; the role " +
+					"the server assigns is then tracked in state. Changing it updates the key in place.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "resource")
+		return
+	}
+	r.client = providerData.ClickStack
+}
+
+func (r *apiKeyResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_clickstack_api_key", &resp.Diagnostics)
+}
+
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.WithTeam(plan.Team.ValueString()).CreateAPIKey(ctx, client.CreateAPIKeyInput{
+		Name:   plan.Name.ValueString(),
+		RoleID: plan.RoleID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating API Key", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(member.ID)
+	plan.RoleID = resolveRoleID(plan.RoleID, member.RoleID)
+	if plan.RoleID.IsUnknown() {
+		plan.RoleID = types.StringNull()
+	}
+	tflog.Trace(ctx, "created API key resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.WithTeam(state.Team.ValueString()).GetAPIKey(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading API Key", err.Error())
+		return
+	}
+
+	if member.Name != nil {
+		state.Name = types.StringValue(*member.Name)
+	}
+	state.RoleID = resolveRoleID(state.RoleID, member.RoleID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only ever changes role_id; everything else forces a new key.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithTeam(plan.Team.ValueString()).UpdateMemberRole(ctx, state.ID.ValueString(), plan.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating API Key Role", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.WithTeam(state.Team.ValueString()).DeleteAPIKey(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error Deleting API Key", err.Error())
+	}
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "<id>" or "<team>/<id>".
+	if team, id, ok := strings.Cut(req.ID, "/"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttr), id)...)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root(idAttr), req, resp)
+}
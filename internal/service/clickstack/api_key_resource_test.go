@@ -0,0 +1,90 @@
+package clickstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiKeyMembers serves a member list with one real user and one key.
+var apiKeyMembers = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte(`{"data":[{"id":"u1","email":"a@b.com","roleId":"r1"},` +
+		`{"id":"k1","name":"ci","isVirtual":true,"roleId":"r2","accessKey":"secret"}]}`))
+})
+
+func TestAPIKeyResource_SecretNotInSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := &fwresource.SchemaResponse{}
+	NewAPIKeyResource().Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	for name, attr := range resp.Schema.Attributes {
+		if attr.IsSensitive() {
+			t.Errorf("attribute %q is sensitive; the key must only be exposed by the ephemeral resource", name)
+		}
+	}
+}
+
+func TestAPIKeyResource_Read(t *testing.T) {
+	t.Parallel()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&apiKeyResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, schemaResp)
+	r := &apiKeyResource{client: dashboardTestClient(t, apiKeyMembers)}
+
+	for _, tc := range []struct {
+		id          string
+		wantRemoved bool
+	}{{"k1", false}, {"u1", true}, {"gone", true}} {
+		prior := tfsdk.State{Schema: schemaResp.Schema}
+		prior.Set(context.Background(), &apiKeyResourceModel{
+			ID: types.StringValue(tc.id), Team: types.StringNull(), Name: types.StringValue("ci"), RoleID: types.StringNull(),
+		})
+		resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Read(context.Background(), fwresource.ReadRequest{State: prior}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %s", tc.id, resp.Diagnostics)
+		}
+		if got := resp.State.Raw.IsNull(); got != tc.wantRemoved {
+			t.Errorf("%s: removed=%v, want %v", tc.id, got, tc.wantRemoved)
+		}
+		if tc.wantRemoved {
+			continue
+		}
+		var got apiKeyResourceModel
+		resp.State.Get(context.Background(), &got)
+		if got.RoleID.ValueString() != "r2" {
+			t.Errorf("role_id=%q, want r2", got.RoleID.ValueString())
+		}
+	}
+}
+
+func TestAPIKeyEphemeralResource_Open(t *testing.T) {
+	t.Parallel()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	NewAPIKeyEphemeralResource().Schema(context.Background(), ephemeral.SchemaRequest{}, schemaResp)
+	e := &apiKeyEphemeralResource{client: dashboardTestClient(t, apiKeyMembers)}
+
+	config := tfsdk.Config{Schema: schemaResp.Schema}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), &apiKeyEphemeralResourceModel{
+		ID: types.StringValue("k1"), Team: types.StringNull(), Name: types.StringNull(), Key: types.StringNull(),
+	})
+	config.Raw = state.Raw
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+	e.Open(context.Background(), ephemeral.OpenRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	var got apiKeyEphemeralResourceModel
+	resp.Result.Get(context.Background(), &got)
+	if got.Key.ValueString() != "secret" || got.Name.ValueString() != "ci" {
+		t.Errorf("unexpected result: key=%q name=%q", got.Key.ValueString(), got.Name.ValueString())
+	}
+}
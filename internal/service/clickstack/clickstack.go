@@ -2,6 +2,7 @@ package clickstack
 
 import (
 	upstreamdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	upstreamephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	upstreamresource "github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
@@ -25,12 +26,16 @@ func (servicePackage) Resources() []func() upstreamresource.Resource {
 		NewConnectionResource,
 		NewDashboardResource,
 		NewSourceResource,
+		NewSourceSetResource,
 		NewRoleResource,
 		NewTeamResource,
 		NewTeamMemberResource,
+		NewTeamInvitationResource,
+		NewAPIKeyResource,
 		NewWebhookResource,
 		NewSavedSearchResource,
 		NewAlertResource,
+		NewAlertSilenceResource,
 	}
 }
 
@@ -38,5 +43,19 @@ func (servicePackage) DataSources() []func() upstreamdatasource.DataSource {
 	return []func() upstreamdatasource.DataSource{
 		NewDashboardDataSource,
 		NewRoleDataSource,
+		NewSourceDataSource,
+		NewSourcesDataSource,
+		NewConnectionDataSource,
+		NewSavedSearchDataSource,
+		NewWebhookDataSource,
+		NewWebhooksDataSource,
+		NewTeamDataSource,
+		NewTeamMembersDataSource,
+	}
+}
+
+func (servicePackage) EphemeralResources() []func() upstreamephemeral.EphemeralResource {
+	return []func() upstreamephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
 	}
 }
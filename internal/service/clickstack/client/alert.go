@@ -10,9 +10,11 @@ import (
 
 const alertsPath = "/api/v2/alerts"
 
-// AlertSourceSavedSearch is the only alert source this provider supports today:
-// alerts evaluate a saved search. (Tile alerts, source "tile", are out of scope.)
-const AlertSourceSavedSearch = "saved_search"
+// Alert sources: an alert evaluates either a saved search or a dashboard tile.
+const (
+	AlertSourceSavedSearch = "saved_search"
+	AlertSourceTile        = "tile"
+)
 
 // AlertChannelWebhook is the webhook channel type.
 const AlertChannelWebhook = "webhook"
@@ -55,7 +57,9 @@ type Alert struct {
 	Threshold             float64      `json:"threshold"`
 	ThresholdType         string       `json:"thresholdType"`
 	ThresholdMax          *float64     `json:"thresholdMax,omitempty"`
-	SavedSearchID         string       `json:"savedSearchId"`
+	SavedSearchID         string       `json:"savedSearchId,omitempty"`
+	DashboardID           string       `json:"dashboardId,omitempty"`
+	TileID                string       `json:"tileId,omitempty"`
 	GroupBy               *string      `json:"groupBy,omitempty"`
 	Name                  *string      `json:"name,omitempty"`
 	Message               *string      `json:"message,omitempty"`
@@ -74,10 +78,12 @@ type alertEnvelope struct {
 	Data Alert `json:"data"`
 }
 
-// CreateAlert creates an alert and returns it as stored by the API. Source is
-// forced to saved_search regardless of the input.
+// CreateAlert creates an alert and returns it as stored by the API. An empty
+// Source defaults to saved_search.
 func (c *Client) CreateAlert(ctx context.Context, input Alert) (*Alert, error) {
-	input.Source = AlertSourceSavedSearch
+	if input.Source == "" {
+		input.Source = AlertSourceSavedSearch
+	}
 	body, err := json.Marshal(input)
 	if err != nil {
 		return nil, fmt.Errorf("encode alert: %w", err)
@@ -115,7 +121,9 @@ func (c *Client) GetAlert(ctx context.Context, id string) (*Alert, error) {
 // omitted fields clear vs. are kept), not a whole-document replace. It returns an
 // error wrapping ErrNotFound when the alert does not exist.
 func (c *Client) UpdateAlert(ctx context.Context, id string, input Alert) (*Alert, error) {
-	input.Source = AlertSourceSavedSearch
+	if input.Source == "" {
+		input.Source = AlertSourceSavedSearch
+	}
 	body, err := json.Marshal(input)
 	if err != nil {
 		return nil, fmt.Errorf("encode alert: %w", err)
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AlertSilence is the server-managed silence of an alert: who set it, when,
+// and the time (RFC3339) until which notifications are muted. It is kept out
+// of Alert so the alert resource never sends or reconciles it.
+type AlertSilence struct {
+	By    string `json:"by,omitempty"`
+	At    string `json:"at,omitempty"`
+	Until string `json:"until"`
+}
+
+// alertListEnvelope wraps alert-list API responses.
+type alertListEnvelope struct {
+	Data []Alert `json:"data"`
+}
+
+// ListAlerts returns every alert visible to the API key's team.
+func (c *Client) ListAlerts(ctx context.Context) ([]Alert, error) {
+	raw, err := c.do(ctx, http.MethodGet, alertsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp alertListEnvelope
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("decode alerts: %w", err)
+	}
+	return resp.Data, nil
+}
+
+// GetAlertSilence returns the silence of an alert, or nil when it is not
+// silenced. It returns an error wrapping ErrNotFound when the alert does not
+// exist.
+func (c *Client) GetAlertSilence(ctx context.Context, id string) (*AlertSilence, error) {
+	raw, err := c.do(ctx, http.MethodGet, alertsPath+"/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data struct {
+			Silenced *AlertSilence `json:"silenced"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("decode alert: %w", err)
+	}
+	return resp.Data.Silenced, nil
+}
+
+// SilenceAlert mutes an alert's notifications until the RFC3339 time until,
+// replacing any existing silence. The server lifts it on its own at that time.
+func (c *Client) SilenceAlert(ctx context.Context, id, until string) error {
+	body, err := json.Marshal(struct {
+		MutedUntil string `json:"mutedUntil"`
+	}{until})
+	if err != nil {
+		return fmt.Errorf("encode alert silence: %w", err)
+	}
+	_, err = c.do(ctx, http.MethodPost, alertsPath+"/"+url.PathEscape(id)+"/silenced", body)
+	return err
+}
+
+// UnsilenceAlert lifts an alert's silence. It returns an error wrapping
+// ErrNotFound when the alert does not exist.
+func (c *Client) UnsilenceAlert(ctx context.Context, id string) error {
+	_, err := c.do(ctx, http.MethodDelete, alertsPath+"/"+url.PathEscape(id)+"/silenced", nil)
+	return err
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSilenceAlert(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/alerts/al1/silenced" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body["mutedUntil"] != "2026-11-01T06:00:00Z" {
+			t.Errorf("unexpected mutedUntil: %v", body["mutedUntil"])
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := c.SilenceAlert(context.Background(), "al1", "2026-11-01T06:00:00Z"); err != nil {
+		t.Fatalf("SilenceAlert: %v", err)
+	}
+}
+
+func TestGetAlertSilence(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/alerts/al1":
+			_, _ = io.WriteString(w, `{"data":{"id":"al1","silenced":{"by":"u1","at":"2026-11-01T00:00:00.000Z","until":"2026-11-01T06:00:00.000Z"}}}`)
+		case "/api/v2/alerts/al2":
+			_, _ = io.WriteString(w, `{"data":{"id":"al2"}}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	s, err := c.GetAlertSilence(context.Background(), "al1")
+	if err != nil {
+		t.Fatalf("GetAlertSilence: %v", err)
+	}
+	if s == nil || s.Until != "2026-11-01T06:00:00.000Z" || s.By != "u1" {
+		t.Errorf("unexpected silence: %+v", s)
+	}
+
+	s, err = c.GetAlertSilence(context.Background(), "al2")
+	if err != nil || s != nil {
+		t.Errorf("want no silence, got %+v, %v", s, err)
+	}
+}
@@ -168,3 +168,38 @@ func TestDeleteAlert_NotFound(t *testing.T) {
 		t.Errorf("expected ErrNotFound, got %v", err)
 	}
 }
+
+func TestCreateAlert_TileSource(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body["source"] != "tile" || body["dashboardId"] != "d1" || body["tileId"] != "t1" {
+			t.Errorf("unexpected tile source fields: %v", body)
+		}
+		if _, ok := body["savedSearchId"]; ok {
+			t.Errorf("expected savedSearchId omitted for a tile alert, got %v", body["savedSearchId"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":{"id":"al1","source":"tile","dashboardId":"d1","tileId":"t1","interval":"5m","threshold":1,"thresholdType":"above","channel":{"type":"webhook","webhookId":"wh1"}}}`)
+	})
+
+	al, err := c.CreateAlert(context.Background(), Alert{
+		Source:        AlertSourceTile,
+		DashboardID:   "d1",
+		TileID:        "t1",
+		Interval:      "5m",
+		Threshold:     1,
+		ThresholdType: "above",
+		Channel:       AlertChannel{Type: AlertChannelWebhook, WebhookID: "wh1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateAlert: %v", err)
+	}
+	if al.Source != AlertSourceTile || al.TileID != "t1" {
+		t.Errorf("unexpected alert: %+v", al)
+	}
+}
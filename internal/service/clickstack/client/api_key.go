@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CreateAPIKeyInput is the request body for creating a service API key. The
+// key belongs to a virtual (API-only) team member named Name; RoleID is
+// omitted when empty, as OSS deployments have no RBAC.
+type CreateAPIKeyInput struct {
+	Name   string `json:"name"`
+	RoleID string `json:"roleId,omitempty"`
+}
+
+type teamMemberEnvelope struct {
+	Data TeamMember `json:"data"`
+}
+
+// CreateAPIKey creates a virtual team member and returns it with its access
+// key. Service keys are a self-hosted concept: on ClickHouse Cloud the API is
+// reached with Cloud API keys, so in cloud mode CreateAPIKey returns
+// ErrCloudUnsupported.
+func (c *Client) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (*TeamMember, error) {
+	if c.cloud {
+		return nil, fmt.Errorf("create API key: %w", ErrCloudUnsupported)
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("encode API key: %w", err)
+	}
+
+	raw, err := c.do(ctx, http.MethodPost, teamPath+"/members/virtual", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp teamMemberEnvelope
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, fmt.Errorf("decode API key: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+// GetAPIKey fetches the virtual team member holding a service API key, with
+// its access key when the API returns it. It returns an error wrapping
+// ErrNotFound when no virtual member has the given ID.
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*TeamMember, error) {
+	members, err := c.ListTeamMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if members[i].ID == id && members[i].IsVirtual {
+			return &members[i], nil
+		}
+	}
+	return nil, fmt.Errorf("API key %q: %w", id, ErrNotFound)
+}
+
+// DeleteAPIKey deletes the virtual team member holding a service API key,
+// revoking the key. It returns an error wrapping ErrNotFound when the member
+// does not exist.
+func (c *Client) DeleteAPIKey(ctx context.Context, id string) error {
+	_, err := c.do(ctx, http.MethodDelete, teamPath+"/members/virtual/"+url.PathEscape(id), nil)
+	return err
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/team/members/virtual" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if body["name"] != "ci" || body["roleId"] != "r1" {
+			t.Errorf("unexpected body: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":{"id":"u7","email":"","name":"ci","isVirtual":true,"roleId":"r1","roleName":"Member","accessKey":"secret"}}`)
+	})
+
+	m, err := c.CreateAPIKey(context.Background(), CreateAPIKeyInput{Name: "ci", RoleID: "r1"})
+	if err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+	if m.ID != "u7" || !m.IsVirtual || m.AccessKey == nil || *m.AccessKey != "secret" {
+		t.Errorf("unexpected API key member: %+v", m)
+	}
+}
+
+func TestCreateAPIKey_Cloud(t *testing.T) {
+	t.Parallel()
+
+	c := newCloudTestClient(t, func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	if _, err := c.CreateAPIKey(context.Background(), CreateAPIKeyInput{Name: "ci"}); !errors.Is(err, ErrCloudUnsupported) {
+		t.Errorf("expected ErrCloudUnsupported, got %v", err)
+	}
+}
+
+func TestGetAPIKey(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":[{"id":"u1","email":"a@b.com","roleId":"r1"},{"id":"u2","name":"ci","isVirtual":true,"roleId":"r2","accessKey":"key"}]}`)
+	})
+
+	m, err := c.GetAPIKey(context.Background(), "u2")
+	if err != nil {
+		t.Fatalf("GetAPIKey: %v", err)
+	}
+	if m.RoleID != "r2" || m.AccessKey == nil || *m.AccessKey != "key" {
+		t.Errorf("unexpected API key member: %+v", m)
+	}
+
+	// A real user is not an API key.
+	if _, err := c.GetAPIKey(context.Background(), "u1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a real user, got %v", err)
+	}
+}
+
+func TestDeleteAPIKey_NotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v2/team/members/virtual/u7" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := c.DeleteAPIKey(context.Background(), "u7"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
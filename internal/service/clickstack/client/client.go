@@ -152,6 +152,18 @@ type apiError struct {
 	Error   string `json:"error"`
 }
 
+// authorize sets the credentials, and the team header when scoped, on req.
+func (c *Client) authorize(req *http.Request) {
+	if c.cloud {
+		req.SetBasicAuth(c.tokenKey, c.tokenSecret)
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if c.teamID != "" {
+		req.Header.Set("x-hdx-team", c.teamID)
+	}
+}
+
 // do sends an API request with an optional pre-encoded JSON body and returns
 // the raw response body. Callers decode the result into their concrete
 // response type.
@@ -176,14 +188,7 @@ func (c *Client) do(ctx context.Context, method, path string, body []byte) ([]by
 	if err != nil {
 		return nil, fmt.Errorf("build request: %w", err)
 	}
-	if c.cloud {
-		req.SetBasicAuth(c.tokenKey, c.tokenSecret)
-	} else {
-		req.Header.Set("Authorization", "Bearer "+c.apiKey)
-		if c.teamID != "" {
-			req.Header.Set("x-hdx-team", c.teamID)
-		}
-	}
+	c.authorize(req)
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// clickhouseProxyPath is the ClickHouse HTTP proxy the ClickStack UI queries
+// through. The API picks the target server and credentials from the
+// connection named in the x-hyperdx-connection-id header, so callers never
+// need the connection's password, which the API does not return.
+const clickhouseProxyPath = "/api/clickhouse-proxy/"
+
+// columnsQuery lists a table's columns. Database and table are bound as
+// ClickHouse query parameters rather than spliced into the SQL.
+const columnsQuery = "SELECT name FROM system.columns WHERE database = {database:String} AND table = {table:String} ORDER BY position FORMAT JSONEachRow"
+
+// ListColumns returns the column names of database.table as seen through
+// connection connectionID, in table order. A table that does not exist has no
+// columns. Nested columns are listed by their dotted name (e.g.
+// "Events.Timestamp"), as in system.columns.
+//
+// The proxy is a self-hosted ClickStack endpoint; in cloud mode ListColumns
+// returns ErrCloudUnsupported.
+func (c *Client) ListColumns(ctx context.Context, connectionID, database, table string) ([]string, error) {
+	if c.cloud {
+		return nil, fmt.Errorf("list columns: ClickHouse proxy: %w", ErrCloudUnsupported)
+	}
+
+	q := url.Values{}
+	q.Set("query", columnsQuery)
+	q.Set("param_database", database)
+	q.Set("param_table", table)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+clickhouseProxyPath+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	c.authorize(req)
+	req.Header.Set("x-hyperdx-connection-id", connectionID)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list columns of %s.%s: %w", database, table, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // nothing actionable on close failure
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// The API answers with its JSON error body, ClickHouse with plain text.
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 4096)) //nolint:errcheck // best-effort error body
+		var ae apiError
+		msg := string(bytes.TrimSpace(raw))
+		if json.Unmarshal(raw, &ae) == nil {
+			msg = cmp.Or(ae.Message, ae.Error)
+		}
+		if msg != "" {
+			return nil, fmt.Errorf("list columns of %s.%s: status %d: %s", database, table, resp.StatusCode, msg)
+		}
+		return nil, fmt.Errorf("list columns of %s.%s: unexpected status %d", database, table, resp.StatusCode)
+	}
+
+	var cols []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var row struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(line, &row); err != nil {
+			return nil, fmt.Errorf("list columns of %s.%s: decode row: %w", database, table, err)
+		}
+		cols = append(cols, row.Name)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("list columns of %s.%s: read response: %w", database, table, err)
+	}
+	return cols, nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestListColumns(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/clickhouse-proxy/" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if got := r.Header.Get("x-hyperdx-connection-id"); got != "conn1" {
+			t.Errorf("x-hyperdx-connection-id = %q, want conn1", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer "+testAPIKey {
+			t.Errorf("Authorization = %q", got)
+		}
+		q := r.URL.Query()
+		if q.Get("param_database") != "otel" || q.Get("param_table") != "otel_logs" {
+			t.Errorf("unexpected query parameters: %v", q)
+		}
+		if !strings.Contains(q.Get("query"), "system.columns") {
+			t.Errorf("query = %q", q.Get("query"))
+		}
+		_, _ = w.Write([]byte("{\"name\":\"Timestamp\"}\n{\"name\":\"Events.Timestamp\"}\n"))
+	})
+
+	got, err := c.ListColumns(context.Background(), "conn1", "otel", "otel_logs")
+	if err != nil {
+		t.Fatalf("ListColumns: %v", err)
+	}
+	if want := []string{"Timestamp", "Events.Timestamp"}; !slices.Equal(got, want) {
+		t.Errorf("ListColumns = %v, want %v", got, want)
+	}
+}
+
+func TestListColumns_ClickHouseError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "Code: 516. DB::Exception: default: Authentication failed", http.StatusInternalServerError)
+	})
+
+	_, err := c.ListColumns(context.Background(), "conn1", "otel", "otel_logs")
+	if err == nil || !strings.Contains(err.Error(), "Authentication failed") {
+		t.Fatalf("err = %v, want the ClickHouse error text", err)
+	}
+}
+
+func TestListColumns_Cloud(t *testing.T) {
+	t.Parallel()
+
+	c := newCloudTestClient(t, func(http.ResponseWriter, *http.Request) {
+		t.Error("no request expected in cloud mode")
+	})
+
+	if _, err := c.ListColumns(context.Background(), "conn1", "otel", "otel_logs"); !errors.Is(err, ErrCloudUnsupported) {
+		t.Fatalf("err = %v, want ErrCloudUnsupported", err)
+	}
+}
@@ -82,6 +82,22 @@ func (c *Client) GetDashboard(ctx context.Context, id string) (json.RawMessage,
 	return env.Data, nil
 }
 
+// ListDashboards returns the body of every dashboard visible to the API key's
+// team.
+func (c *Client) ListDashboards(ctx context.Context) ([]json.RawMessage, error) {
+	raw, err := c.do(ctx, http.MethodGet, dashboardsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var env struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return nil, fmt.Errorf("decode dashboards: %w", err)
+	}
+	return env.Data, nil
+}
+
 // UpdateDashboard replaces the dashboard with the given ID and returns the updated body.
 func (c *Client) UpdateDashboard(ctx context.Context, id string, body json.RawMessage) (json.RawMessage, error) {
 	raw, err := c.do(ctx, http.MethodPut, dashboardsPath+"/"+url.PathEscape(id), body)
@@ -32,12 +32,14 @@ type TeamMember struct {
 	AccessKey     *string `json:"accessKey,omitempty"`
 }
 
-// TeamInvitation is a pending invitation to join the team.
+// TeamInvitation is a pending invitation to join the team. CreatedAt is the
+// RFC 3339 time the invitation was issued, empty when the API omits it.
 type TeamInvitation struct {
-	ID     string  `json:"id"`
-	Email  string  `json:"email"`
-	Name   *string `json:"name,omitempty"`
-	RoleID string  `json:"roleId"`
+	ID        string  `json:"id"`
+	Email     string  `json:"email"`
+	Name      *string `json:"name,omitempty"`
+	RoleID    string  `json:"roleId"`
+	CreatedAt string  `json:"createdAt,omitempty"`
 }
 
 // InviteTeamMemberInput is the request body for inviting a member. RoleID is
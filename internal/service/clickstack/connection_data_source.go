@@ -0,0 +1,105 @@
+package clickstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = (*connectionDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*connectionDataSource)(nil)
+)
+
+// NewConnectionDataSource is a helper to register the data source with the provider.
+func NewConnectionDataSource() datasource.DataSource {
+	return &connectionDataSource{}
+}
+
+// connectionDataSource looks up a ClickStack connection by ID or name. The
+// password is write-only in the API and is not exposed.
+type connectionDataSource struct {
+	client *client.Client
+}
+
+// connectionDataSourceModel maps the data source schema data.
+type connectionDataSourceModel struct {
+	Team                 types.String `tfsdk:"team"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Host                 types.String `tfsdk:"host"`
+	Username             types.String `tfsdk:"username"`
+	HyperdxSettingPrefix types.String `tfsdk:"hyperdx_setting_prefix"`
+	PrometheusEndpoint   types.String `tfsdk:"prometheus_endpoint"`
+}
+
+func (d *connectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_connection"
+}
+
+func (d *connectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	id, name := idOrNameAttributes("connection")
+	resp.Schema = schema.Schema{
+		Description: "Looks up a ClickStack connection by ID or name. The connection password is never returned.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: teamLookupAttribute("connection"),
+			idAttr:   id,
+			nameAttr: name,
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "ClickHouse HTTP(S) endpoint the connection points at.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "ClickHouse user the connection authenticates as.",
+			},
+			"hyperdx_setting_prefix": schema.StringAttribute{
+				Computed:    true,
+				Description: "Prefix for HyperDX-specific ClickHouse settings, if set.",
+			},
+			"prometheus_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Prometheus-compatible endpoint used for PromQL sources, if set.",
+			},
+		},
+	}
+}
+
+func (d *connectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *connectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_connection", &resp.Diagnostics)
+	var config connectionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conns, err := d.client.WithTeam(config.Team.ValueString()).ListConnections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Connections", err.Error())
+		return
+	}
+	conn, err := findByIDOrName(conns, "connection", config.ID.ValueString(), config.Name.ValueString(),
+		func(c client.Connection) (string, string) { return c.ID, c.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Not Found", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(conn.ID)
+	config.Name = types.StringValue(conn.Name)
+	config.Host = types.StringValue(conn.Host)
+	config.Username = types.StringValue(conn.Username)
+	config.HyperdxSettingPrefix = emptyPtrToNull(conn.HyperdxSettingPrefix)
+	config.PrometheusEndpoint = emptyPtrToNull(conn.PrometheusEndpoint)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -44,6 +47,8 @@ type connectionResourceModel struct {
 	Host                 types.String `tfsdk:"host"`
 	Username             types.String `tfsdk:"username"`
 	Password             types.String `tfsdk:"password"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	PasswordWOVersion    types.Int64  `tfsdk:"password_wo_version"`
 	HyperdxSettingPrefix types.String `tfsdk:"hyperdx_setting_prefix"`
 	PrometheusEndpoint   types.String `tfsdk:"prometheus_endpoint"`
 }
@@ -96,7 +101,29 @@ func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Sensitive: true,
 				Description: "ClickHouse password. The API never returns the password, so drift in " +
 					"this attribute cannot be detected. After import, the next apply re-sends the " +
-					"configured password.",
+					"configured password. Stored in (sensitive) state; prefer `password_wo`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "ClickHouse password, write-only: sent to the API but never persisted to " +
+					"Terraform state (requires Terraform >= 1.11). Requires `password_wo_version`; the " +
+					"password is only sent on create and when the version changes.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Description: "Version number for `password_wo`. Increment it to send the current " +
+					"`password_wo` value, e.g. together with the password of a `clickhouse_service`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"hyperdx_setting_prefix": schema.StringAttribute{
 				Optional: true,
@@ -138,8 +165,9 @@ func (r *connectionResource) ValidateConfig(_ context.Context, _ resource.Valida
 }
 
 func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan connectionResourceModel
+	var plan, config connectionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -148,7 +176,7 @@ func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequ
 		Name:                 plan.Name.ValueString(),
 		Host:                 plan.Host.ValueString(),
 		Username:             plan.Username.ValueString(),
-		Password:             plan.Password.ValueString(),
+		Password:             connectionPasswordOnCreate(plan, config),
 		HyperdxSettingPrefix: plan.HyperdxSettingPrefix.ValueStringPointer(),
 		PrometheusEndpoint:   plan.PrometheusEndpoint.ValueStringPointer(),
 	})
@@ -187,8 +215,10 @@ func (r *connectionResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan connectionResourceModel
+	var plan, state, config connectionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -200,11 +230,7 @@ func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		// nil clears the value on the server; non-nil sets it.
 		HyperdxSettingPrefix: plan.HyperdxSettingPrefix.ValueStringPointer(),
 		PrometheusEndpoint:   plan.PrometheusEndpoint.ValueStringPointer(),
-	}
-	// A nil password means "keep the existing password" server-side, which
-	// matches the write-only semantics of the attribute.
-	if !plan.Password.IsNull() {
-		input.Password = plan.Password.ValueStringPointer()
+		Password:             connectionPasswordOnUpdate(plan, state, config),
 	}
 
 	conn, err := r.client.WithTeam(plan.Team.ValueString()).UpdateConnection(ctx, plan.ID.ValueString(), input)
@@ -246,8 +272,34 @@ func (r *connectionResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// connectionPasswordOnCreate returns the configured password, from
+// password_wo (config only) or password.
+func connectionPasswordOnCreate(plan, config connectionResourceModel) string {
+	if known(config.PasswordWO) {
+		return config.PasswordWO.ValueString()
+	}
+	return plan.Password.ValueString()
+}
+
+// connectionPasswordOnUpdate returns the password to send: password_wo when
+// password_wo_version changed, or password whenever it is set. nil means
+// "keep the existing password" server-side, which matches the write-only
+// semantics of both attributes.
+func connectionPasswordOnUpdate(plan, state, config connectionResourceModel) *string {
+	if known(config.PasswordWO) {
+		if plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+			return nil
+		}
+		return config.PasswordWO.ValueStringPointer()
+	}
+	if !plan.Password.IsNull() {
+		return plan.Password.ValueStringPointer()
+	}
+	return nil
+}
+
 // applyConnection copies the API representation into the model. The password
-// is intentionally untouched: the API never returns it, so the configured
+// attributes are intentionally untouched: the API never returns it, so the configured
 // value in plan/state is authoritative.
 func (m *connectionResourceModel) applyConnection(conn *client.Connection) {
 	m.ID = types.StringValue(conn.ID)
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestConnectionResource_Schema(t *testing.T) {
@@ -18,7 +20,7 @@ func TestConnectionResource_Schema(t *testing.T) {
 		t.Fatalf("unexpected schema diagnostics: %s", resp.Diagnostics)
 	}
 
-	for _, attr := range []string{"id", "team", "name", "host", "username", "password", "hyperdx_setting_prefix", "prometheus_endpoint"} {
+	for _, attr := range []string{"id", "team", "name", "host", "username", "password", "password_wo", "password_wo_version", "hyperdx_setting_prefix", "prometheus_endpoint"} {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("expected resource schema to contain attribute %q", attr)
 		}
@@ -31,4 +33,46 @@ func TestConnectionResource_Schema(t *testing.T) {
 	if !password.IsSensitive() {
 		t.Error("expected password attribute to be sensitive")
 	}
+
+	if wo, ok := resp.Schema.Attributes["password_wo"].(schema.StringAttribute); !ok || !wo.WriteOnly || !wo.Sensitive {
+		t.Error("expected password_wo to be a sensitive write-only string")
+	}
+}
+
+func TestConnectionPasswordOnUpdate(t *testing.T) {
+	t.Parallel()
+
+	model := func(password, wo string, version int64) connectionResourceModel {
+		m := connectionResourceModel{Password: types.StringNull(), PasswordWO: types.StringNull(), PasswordWOVersion: types.Int64Null()}
+		if password != "" {
+			m.Password = types.StringValue(password)
+		}
+		if wo != "" {
+			m.PasswordWO = types.StringValue(wo)
+		}
+		if version != 0 {
+			m.PasswordWOVersion = types.Int64Value(version)
+		}
+		return m
+	}
+	cases := []struct {
+		name                string
+		plan, state, config connectionResourceModel
+		want                *string
+	}{
+		{"password is always sent", model("p", "", 0), model("p", "", 0), model("p", "", 0), ptr("p")},
+		{"no password keeps the server's", model("", "", 0), model("p", "", 0), model("", "", 0), nil},
+		// Write-only values are null in plan and state; only the config has them.
+		{"same version keeps the server's", model("", "", 1), model("", "", 1), model("", "wo", 1), nil},
+		{"new version sends password_wo", model("", "", 2), model("", "", 1), model("", "wo", 2), ptr("wo")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := connectionPasswordOnUpdate(tc.plan, tc.state, tc.config)
+			if (got == nil) != (tc.want == nil) || got != nil && *got != *tc.want {
+				t.Errorf("connectionPasswordOnUpdate = %v, want %v", got, tc.want)
+			}
+		})
+	}
 }
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
 )
@@ -66,24 +65,7 @@ func (d *dashboardDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 }
 
 func (d *dashboardDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	providerData, ok := req.ProviderData.(*service.ProviderData)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
-		)
-		return
-	}
-
-	if providerData.ClickStack == nil {
-		addNotConfiguredError(&resp.Diagnostics, "data source")
-		return
-	}
-	d.client = providerData.ClickStack
+	d.client = configureDataSource(req, resp)
 }
 
 func (d *dashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
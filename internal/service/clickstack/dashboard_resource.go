@@ -39,12 +39,20 @@ type dashboardResource struct {
 	client *client.Client
 }
 
-// dashboardResourceModel maps the resource schema data.
+// dashboardResourceModel maps the resource schema data. A dashboard is
+// defined either by DashboardJSON or by the typed fields below it (see
+// dashboard_typed.go), never both.
 type dashboardResourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	Team           types.String `tfsdk:"team"`
 	DashboardJSON  types.String `tfsdk:"dashboard_json"`
 	NormalizedJSON types.String `tfsdk:"normalized_json"`
+
+	Name      types.String             `tfsdk:"name"`
+	Tags      types.Set                `tfsdk:"tags"`
+	Tiles     []dashboardTileModel     `tfsdk:"tile"`
+	Filters   []dashboardFilterModel   `tfsdk:"filter"`
+	Variables []dashboardVariableModel `tfsdk:"variable"`
 }
 
 func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,8 +60,36 @@ func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		idAttr: schema.StringAttribute{
+			Computed:      true,
+			Description:   "Identifier of the dashboard.",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		teamAttr: schema.StringAttribute{
+			Optional:      true,
+			Description:   "Team ID to manage this dashboard under (`x-hdx-team`). Changing this forces replacement.",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		dashboardJSONAttr: schema.StringAttribute{
+			Optional: true,
+			Description: "The dashboard body as a JSON string, in the v2 API format. Use `jsonencode(...)` or `file(...)`. " +
+				"Conflicts with `name`, `tags` and the `tile`, `filter` and `variable` blocks.",
+			PlanModifiers: []planmodifier.String{
+				dashboardJSONPlanModifier{},
+			},
+		},
+		normalizedJSONAttr: schema.StringAttribute{
+			Computed:    true,
+			Description: "Server-canonical dashboard body returned by the API (defaults applied, server-assigned tile IDs).",
+		},
+	}
+	for k, v := range dashboardTypedAttributes() {
+		attributes[k] = v
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Manages a ClickStack dashboard from a JSON document (the v2 API " +
+		Description: "Manages a ClickStack dashboard, either from a JSON document (the v2 API " +
 			"dashboard body: name, tiles, tags, filters, savedQuery, containers). The JSON is " +
 			"validated at plan time against the ClickStack API when the validate endpoint is " +
 			"available. Export an existing dashboard with `GET /api/v2/dashboards/{id}` or " +
@@ -64,34 +100,21 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"`dashboard_json` value itself changes, at which point the entire dashboard is replaced and " +
 			"any manual edits are overwritten. Manage a dashboard either entirely in Terraform or " +
 			"entirely in the UI, not both.\n\n" +
-			"Tile alerts (alerts bound to a dashboard tile) are not managed by this resource. On " +
+			"Tile alerts (alerts bound to a dashboard tile) are managed with " +
+			"`clickhouse_clickstack_alert`, not by this resource. On " +
 			"update, Terraform carries each tile's server-assigned ID forward — matched by tile " +
 			"`name` — so a UI-created tile alert survives an apply. Tiles with duplicate or blank " +
 			"names, or renamed between applies, fall back to positional matching and may lose their " +
-			"alert; pin an explicit `id` on such tiles if you manage tile alerts in the UI.",
-		Attributes: map[string]schema.Attribute{
-			idAttr: schema.StringAttribute{
-				Computed:      true,
-				Description:   "Identifier of the dashboard.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
-			},
-			teamAttr: schema.StringAttribute{
-				Optional:      true,
-				Description:   "Team ID to manage this dashboard under (`x-hdx-team`). Changing this forces replacement.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-			},
-			dashboardJSONAttr: schema.StringAttribute{
-				Required:    true,
-				Description: "The dashboard body as a JSON string, in the v2 API format. Use `jsonencode(...)` or `file(...)`.",
-				PlanModifiers: []planmodifier.String{
-					dashboardJSONPlanModifier{},
-				},
-			},
-			normalizedJSONAttr: schema.StringAttribute{
-				Computed:    true,
-				Description: "Server-canonical dashboard body returned by the API (defaults applied, server-assigned tile IDs).",
-			},
-		},
+			"alert; pin an explicit `id` on such tiles if you manage tile alerts in the UI.\n\n" +
+			"Instead of `dashboard_json`, a dashboard can be written with `name`, `tags` and typed " +
+			"`tile`, `filter` and `variable` blocks, which compile to the same API body. Tile geometry " +
+			"and select entries are checked at plan time, and a plan shows changes per tile. The typed " +
+			"blocks cover chart tiles (line, stacked_bar, table, number, pie) over a source; search, " +
+			"markdown and SQL tiles, and containers, need `dashboard_json`. `terraform import` always " +
+			"fills `dashboard_json`; to manage an imported dashboard with typed blocks, write them and " +
+			"apply, which replaces the dashboard body in place.",
+		Attributes: attributes,
+		Blocks:     dashboardTypedBlocks(),
 	}
 }
 
@@ -123,8 +146,13 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	body, err := r.client.WithTeam(plan.Team.ValueString()).
-		CreateDashboard(ctx, json.RawMessage(plan.DashboardJSON.ValueString()))
+	authored, diags := plan.authoredBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.WithTeam(plan.Team.ValueString()).CreateDashboard(ctx, authored)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating Dashboard", err.Error())
 		return
@@ -171,11 +199,13 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// On import, dashboard_json is null/unknown because no config value exists
 	// yet. Populate it from the fetched body so the imported state is
-	// re-appliable without an immediate diff. The dashboard id and filter ids
+	// re-appliable without an immediate diff. A dashboard written with typed
+	// blocks keeps dashboard_json null too, but is told apart by its typed
+	// values (a name at least) and left alone. The dashboard id and filter ids
 	// are dropped: the API rejects them in an authored body. Select entries are
 	// cleaned up for the same reason — the API exports aggregation fields its own
 	// write schema rejects.
-	if state.DashboardJSON.IsNull() || state.DashboardJSON.IsUnknown() {
+	if (state.DashboardJSON.IsNull() || state.DashboardJSON.IsUnknown()) && !state.usesTypedSchema() {
 		authored := body
 		if stripped, err := stripServerIDs(authored); err == nil {
 			authored = stripped
@@ -210,7 +240,11 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	// on create), so mergeFilterIDs carries existing ids forward and mints
 	// placeholders for new ones. Each step is best effort: if it fails, that
 	// step's ids are left as authored and only that transformation is skipped.
-	body := json.RawMessage(plan.DashboardJSON.ValueString())
+	body, diags := plan.authoredBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if !state.NormalizedJSON.IsNull() && !state.NormalizedJSON.IsUnknown() {
 		prior := json.RawMessage(state.NormalizedJSON.ValueString())
 		if merged, err := mergeTileIDs(body, prior); err == nil {
@@ -313,23 +347,49 @@ func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.Val
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if cfg.DashboardJSON.IsNull() || cfg.DashboardJSON.IsUnknown() {
+	// An unknown dashboard_json may resolve to null, so the mode check waits.
+	if cfg.DashboardJSON.IsUnknown() {
 		return
 	}
-	body := cfg.DashboardJSON.ValueString()
-	if err := parseDashboardJSON(body); err != nil {
-		resp.Diagnostics.AddAttributeError(path.Root(dashboardJSONAttr), "Invalid dashboard_json", err.Error())
+	resp.Diagnostics.Append(cfg.validateDashboardMode()...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var body json.RawMessage
+	if cfg.usesTypedSchema() {
+		resp.Diagnostics.Append(cfg.validateTypedDashboard()...)
+		if resp.Diagnostics.HasError() || !cfg.typedIsKnown() {
+			return
+		}
+		var diags diag.Diagnostics
+		body, diags = cfg.compileDashboard(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		if err := parseDashboardJSON(cfg.DashboardJSON.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(dashboardJSONAttr), "Invalid dashboard_json", err.Error())
+			return
+		}
+		body = json.RawMessage(cfg.DashboardJSON.ValueString())
+	}
 	// r.client is nil during early validation (Configure runs later); only call
 	// the API when the client is available.
 	if r.client == nil {
 		return
 	}
-	res, err := r.client.WithTeam(cfg.Team.ValueString()).ValidateDashboard(ctx, json.RawMessage(body))
+	// Diagnostics point at dashboard_json, or in typed mode at the block the
+	// API's error path names.
+	diagPath := func(string) path.Path { return path.Root(dashboardJSONAttr) }
+	if cfg.usesTypedSchema() {
+		diagPath = typedDashboardPath
+	}
+	res, err := r.client.WithTeam(cfg.Team.ValueString()).ValidateDashboard(ctx, body)
 	if err != nil {
 		if errors.Is(err, client.ErrValidateUnsupported) {
-			resp.Diagnostics.AddAttributeWarning(path.Root(dashboardJSONAttr),
+			resp.Diagnostics.AddAttributeWarning(diagPath(""),
 				"Dashboard validation skipped",
 				"The ClickStack API does not expose /api/v2/dashboards/validate; the dashboard will be validated on apply.")
 			return
@@ -340,8 +400,8 @@ func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.Val
 		// error so a persistent misconfiguration is diagnosable rather than looking
 		// like graceful degradation.
 		tflog.Warn(ctx, "dashboard validation endpoint returned an error; deferring validation to apply: "+err.Error())
-		resp.Diagnostics.AddAttributeWarning(path.Root(dashboardJSONAttr),
-			"Dashboard validation unavailable", "Could not validate dashboard_json: "+err.Error())
+		resp.Diagnostics.AddAttributeWarning(diagPath(""),
+			"Dashboard validation unavailable", "Could not validate the dashboard: "+err.Error())
 		return
 	}
 	if !res.Valid {
@@ -350,10 +410,10 @@ func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.Val
 			if e.Path != "" {
 				detail = e.Path + ": " + e.Message
 			}
-			resp.Diagnostics.AddAttributeError(path.Root(dashboardJSONAttr), "Invalid dashboard configuration", detail)
+			resp.Diagnostics.AddAttributeError(diagPath(e.Path), "Invalid dashboard configuration", detail)
 		}
 		if len(res.Errors) == 0 {
-			resp.Diagnostics.AddAttributeError(path.Root(dashboardJSONAttr), "Invalid dashboard configuration",
+			resp.Diagnostics.AddAttributeError(diagPath(""), "Invalid dashboard configuration",
 				"the API reported the dashboard as invalid but returned no error details")
 		}
 	}
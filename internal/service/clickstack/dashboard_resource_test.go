@@ -95,11 +95,16 @@ func TestDashboardResource_Schema(t *testing.T) {
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("unexpected schema diagnostics: %s", resp.Diagnostics)
 	}
-	for _, attr := range []string{"id", "team", "dashboard_json", "normalized_json"} {
+	for _, attr := range []string{"id", "team", "dashboard_json", "normalized_json", "name", "tags"} {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("expected attribute %q", attr)
 		}
 	}
+	for _, block := range []string{"tile", "filter", "variable"} {
+		if _, ok := resp.Schema.Blocks[block]; !ok {
+			t.Errorf("expected block %q", block)
+		}
+	}
 }
 
 // dashboardValidateConfigRequest builds a ValidateConfigRequest whose config
@@ -114,17 +119,8 @@ func dashboardValidateConfigRequest(t *testing.T, dashboardJSON string) fwresour
 		t.Fatalf("unexpected schema diagnostics: %s", schemaResp.Diagnostics)
 	}
 
-	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
-		idAttr:             tftypes.String,
-		teamAttr:           tftypes.String,
-		dashboardJSONAttr:  tftypes.String,
-		normalizedJSONAttr: tftypes.String,
-	}}
-	raw := tftypes.NewValue(objType, map[string]tftypes.Value{
-		idAttr:             tftypes.NewValue(tftypes.String, nil),
-		teamAttr:           tftypes.NewValue(tftypes.String, nil),
-		dashboardJSONAttr:  tftypes.NewValue(tftypes.String, dashboardJSON),
-		normalizedJSONAttr: tftypes.NewValue(tftypes.String, nil),
+	raw := dashboardRawValue(schemaResp.Schema, map[string]tftypes.Value{
+		dashboardJSONAttr: tftypes.NewValue(tftypes.String, dashboardJSON),
 	})
 
 	return fwresource.ValidateConfigRequest{
@@ -275,7 +271,8 @@ func dashboardTestSchema(t *testing.T) rschema.Schema {
 }
 
 // dashboardObjectValue builds a tftypes object value for the resource's four
-// attributes; a nil pointer produces a null attribute.
+// JSON-mode attributes; a nil pointer produces a null attribute, and the typed
+// attributes and blocks are null.
 func dashboardObjectValue(id, team, dashJSON, normJSON *string) tftypes.Value {
 	str := func(p *string) tftypes.Value {
 		if p == nil {
@@ -283,13 +280,9 @@ func dashboardObjectValue(id, team, dashJSON, normJSON *string) tftypes.Value {
 		}
 		return tftypes.NewValue(tftypes.String, *p)
 	}
-	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
-		idAttr:             tftypes.String,
-		teamAttr:           tftypes.String,
-		dashboardJSONAttr:  tftypes.String,
-		normalizedJSONAttr: tftypes.String,
-	}}
-	return tftypes.NewValue(objType, map[string]tftypes.Value{
+	resp := &fwresource.SchemaResponse{}
+	(&dashboardResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	return dashboardRawValue(resp.Schema, map[string]tftypes.Value{
 		idAttr:             str(id),
 		teamAttr:           str(team),
 		dashboardJSONAttr:  str(dashJSON),
@@ -297,6 +290,21 @@ func dashboardObjectValue(id, team, dashJSON, normJSON *string) tftypes.Value {
 	})
 }
 
+// dashboardRawValue builds an object of the schema's type from vals, with
+// every attribute or block not in vals null.
+func dashboardRawValue(sch rschema.Schema, vals map[string]tftypes.Value) tftypes.Value {
+	objType := sch.Type().TerraformType(context.Background()).(tftypes.Object)
+	all := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if v, ok := vals[name]; ok {
+			all[name] = v
+			continue
+		}
+		all[name] = tftypes.NewValue(typ, nil)
+	}
+	return tftypes.NewValue(objType, all)
+}
+
 // dashboardTestClient points a client at an httptest server running h.
 func dashboardTestClient(t *testing.T, h http.Handler) *client.Client {
 	t.Helper()
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -184,23 +185,9 @@ func mergeArrayIDsByName(authored, priorNormalized json.RawMessage, key string)
 		return authored, nil
 	}
 
-	// Index prior ids by name, tracking how many elements share each name so
-	// non-unique names fall back to positional matching.
-	idByName := map[string]string{}
-	nameCount := map[string]int{}
-	for _, pe := range priorElems {
-		e, ok := pe.(map[string]any) //nolint:forbidigo // generic JSON handling needs dynamic typing
-		if !ok {
-			continue
-		}
-		name := elemString(e, "name")
-		id := elemString(e, "id")
-		if name == "" || id == "" {
-			continue
-		}
-		nameCount[name]++
-		idByName[name] = id
-	}
+	// Index prior ids by name; non-unique names fall back to positional
+	// matching.
+	idByName, nameCount := idsByName(priorElems)
 
 	// consumed tracks prior ids already assigned in this merge, including
 	// author-pinned ids, so no id is ever placed on two elements. A duplicate id
@@ -257,6 +244,75 @@ func mergeArrayIDsByName(authored, priorNormalized json.RawMessage, key string)
 	return out, nil
 }
 
+// idsByName indexes the ids of elems by name, counting how many elements
+// share each name so a caller can tell a unique match from an ambiguous one.
+// Elements without both a name and an id are skipped.
+func idsByName(elems []any) (idByName map[string]string, nameCount map[string]int) { //nolint:forbidigo // generic JSON handling needs dynamic typing
+	idByName = map[string]string{}
+	nameCount = map[string]int{}
+	for _, pe := range elems {
+		e, ok := pe.(map[string]any) //nolint:forbidigo // generic JSON handling needs dynamic typing
+		if !ok {
+			continue
+		}
+		name := elemString(e, "name")
+		id := elemString(e, "id")
+		if name == "" || id == "" {
+			continue
+		}
+		nameCount[name]++
+		idByName[name] = id
+	}
+	return idByName, nameCount
+}
+
+// errTileNotFound is returned by resolveTileID when no tile has the name.
+var errTileNotFound = errors.New("tile not found")
+
+// resolveTileID returns the server id of the tile named name in a dashboard
+// body. It matches names as mergeArrayIDsByName does, so it finds the tile
+// that an update of the dashboard keeps the id on; a name shared by several
+// tiles has no such tile and is an error.
+func resolveTileID(body json.RawMessage, name string) (string, error) {
+	tiles, err := dashboardTiles(body)
+	if err != nil {
+		return "", err
+	}
+	idByName, nameCount := idsByName(tiles)
+	switch nameCount[name] {
+	case 0:
+		return "", fmt.Errorf("%w: the dashboard has no tile named %q", errTileNotFound, name)
+	case 1:
+		return idByName[name], nil
+	default:
+		return "", fmt.Errorf("the dashboard has %d tiles named %q; tile names must be unique to attach an alert", nameCount[name], name)
+	}
+}
+
+// tileNameByID returns the name of the tile with the given id, if any.
+func tileNameByID(body json.RawMessage, id string) (string, bool, error) {
+	tiles, err := dashboardTiles(body)
+	if err != nil {
+		return "", false, err
+	}
+	for _, te := range tiles {
+		if e, ok := te.(map[string]any); ok && elemString(e, "id") == id { //nolint:forbidigo // generic JSON handling needs dynamic typing
+			return elemString(e, "name"), true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// dashboardTiles returns the tiles array of a dashboard body.
+func dashboardTiles(body json.RawMessage) ([]any, error) { //nolint:forbidigo // generic JSON handling needs dynamic typing
+	var doc map[string]any //nolint:forbidigo // generic JSON handling needs dynamic typing
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("parse dashboard: %w", err)
+	}
+	tiles, _ := jsonArray(doc["tiles"])
+	return tiles, nil
+}
+
 // jsonArray returns v as a []any when it is a non-empty JSON array.
 func jsonArray(v any) ([]any, bool) { //nolint:forbidigo // generic JSON handling needs dynamic typing
 	arr, ok := v.([]any) //nolint:forbidigo // generic JSON handling needs dynamic typing
@@ -3,6 +3,7 @@ package clickstack
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -123,6 +124,24 @@ func TestMergeFilterIDs_AllNewWhenNoPrior(t *testing.T) {
 	}
 }
 
+func TestResolveTileID(t *testing.T) {
+	t.Parallel()
+	body := json.RawMessage(`{"name":"D","tiles":[{"id":"id-a","name":"A"},{"id":"id-b","name":"B"},{"id":"id-c","name":"B"}]}`)
+
+	if got, err := resolveTileID(body, "A"); err != nil || got != "id-a" {
+		t.Errorf("resolveTileID(A) = %q, %v; want id-a", got, err)
+	}
+	if _, err := resolveTileID(body, "B"); err == nil {
+		t.Error("expected an error for a duplicated tile name")
+	}
+	if _, err := resolveTileID(body, "missing"); !errors.Is(err, errTileNotFound) {
+		t.Errorf("expected errTileNotFound, got %v", err)
+	}
+	if name, ok, err := tileNameByID(body, "id-b"); err != nil || !ok || name != "B" {
+		t.Errorf("tileNameByID(id-b) = %q, %v, %v; want B", name, ok, err)
+	}
+}
+
 func TestMergeTileIDs_NameMatch(t *testing.T) {
 	t.Parallel()
 	authored := json.RawMessage(`{"name":"D","tiles":[{"name":"A"},{"name":"B"}]}`)
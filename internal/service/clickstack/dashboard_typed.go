@@ -0,0 +1,551 @@
+package clickstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The typed dashboard schema is an alternative to dashboard_json: tile,
+// filter and variable blocks that compile to the same v2 API body. Each tile
+// is its own block, so a plan shows which tile changed and how, rather than
+// one diff over the whole JSON string.
+
+const (
+	dashboardTileAttr     = "tile"
+	dashboardFilterAttr   = "filter"
+	dashboardVariableAttr = "variable"
+	dashboardTagsAttr     = "tags"
+
+	// dashboardGridColumns is the width of the ClickStack dashboard grid; a
+	// tile's x + w may not exceed it.
+	dashboardGridColumns = 24
+
+	// dashboardFilterTypeQuery is the only filter type the v2 API accepts.
+	dashboardFilterTypeQuery = "QUERY_EXPRESSION"
+)
+
+// dashboardDisplayTypes are the chart types a typed tile can render. Search
+// and markdown tiles carry a different config shape; use dashboard_json for
+// those.
+var dashboardDisplayTypes = []string{"line", "stacked_bar", "table", "number", "pie"}
+
+var dashboardQueryLanguages = []string{"sql", "lucene"}
+
+// dashboardVariableName is a valid variable name; dashboardVariableRef
+// matches a {{name}} reference to one.
+var (
+	dashboardVariableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	dashboardVariableRef  = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+)
+
+// --- models ---
+
+type dashboardSelectModel struct {
+	AggFn           types.String  `tfsdk:"agg_fn"`
+	ValueExpression types.String  `tfsdk:"value_expression"`
+	Alias           types.String  `tfsdk:"alias"`
+	Level           types.Float64 `tfsdk:"level"`
+	MetricType      types.String  `tfsdk:"metric_type"`
+	MetricName      types.String  `tfsdk:"metric_name"`
+}
+
+type dashboardTileModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Name          types.String           `tfsdk:"name"`
+	X             types.Int64            `tfsdk:"x"`
+	Y             types.Int64            `tfsdk:"y"`
+	W             types.Int64            `tfsdk:"w"`
+	H             types.Int64            `tfsdk:"h"`
+	DisplayType   types.String           `tfsdk:"display_type"`
+	SourceID      types.String           `tfsdk:"source_id"`
+	Where         types.String           `tfsdk:"where"`
+	WhereLanguage types.String           `tfsdk:"where_language"`
+	GroupBy       types.String           `tfsdk:"group_by"`
+	Select        []dashboardSelectModel `tfsdk:"select"`
+}
+
+type dashboardFilterModel struct {
+	Name          types.String `tfsdk:"name"`
+	Expression    types.String `tfsdk:"expression"`
+	SourceID      types.String `tfsdk:"source_id"`
+	WhereLanguage types.String `tfsdk:"where_language"`
+}
+
+type dashboardVariableModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// --- wire form ---
+
+// The typed schema only produces a subset of the v2 body, so these structs
+// cover that subset; dashboard_json remains the way to author anything else.
+type dashboardBody struct {
+	Name    string                `json:"name"`
+	Tags    []string              `json:"tags,omitempty"`
+	Tiles   []dashboardTileBody   `json:"tiles"`
+	Filters []dashboardFilterBody `json:"filters,omitempty"`
+}
+
+type dashboardTileBody struct {
+	ID     string              `json:"id,omitempty"`
+	Name   string              `json:"name"`
+	X      int64               `json:"x"`
+	Y      int64               `json:"y"`
+	W      int64               `json:"w"`
+	H      int64               `json:"h"`
+	Config dashboardTileConfig `json:"config"`
+}
+
+type dashboardTileConfig struct {
+	DisplayType   string                `json:"displayType"`
+	SourceID      string                `json:"sourceId,omitempty"`
+	Select        []dashboardSelectBody `json:"select"`
+	Where         string                `json:"where,omitempty"`
+	WhereLanguage string                `json:"whereLanguage,omitempty"`
+	GroupBy       string                `json:"groupBy,omitempty"`
+}
+
+type dashboardSelectBody struct {
+	AggFn           string   `json:"aggFn"`
+	ValueExpression string   `json:"valueExpression,omitempty"`
+	Alias           string   `json:"alias,omitempty"`
+	Level           *float64 `json:"level,omitempty"`
+	MetricType      string   `json:"metricType,omitempty"`
+	MetricName      string   `json:"metricName,omitempty"`
+}
+
+type dashboardFilterBody struct {
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Expression    string `json:"expression"`
+	SourceID      string `json:"sourceId"`
+	WhereLanguage string `json:"whereLanguage,omitempty"`
+}
+
+// --- schema ---
+
+func dashboardTypedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		nameAttr: schema.StringAttribute{
+			Optional:    true,
+			Description: "Dashboard name. Required with `tile` blocks; conflicts with `dashboard_json`.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		dashboardTagsAttr: schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Dashboard tags. Conflicts with `dashboard_json`.",
+		},
+	}
+}
+
+func dashboardTypedBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		dashboardTileAttr: schema.ListNestedBlock{
+			Description: "A chart tile. Conflicts with `dashboard_json`.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					idAttr: schema.StringAttribute{
+						Optional: true,
+						Description: "Tile ID. Leave unset to let the server assign one; it is carried " +
+							"forward by tile name on update, so tile alerts stay bound.",
+					},
+					nameAttr: schema.StringAttribute{
+						Required:    true,
+						Description: "Tile title. Must be unique within the dashboard.",
+						Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"x": schema.Int64Attribute{
+						Required:    true,
+						Description: fmt.Sprintf("Column of the tile's left edge, from 0 to %d.", dashboardGridColumns-1),
+						Validators:  []validator.Int64{int64validator.Between(0, dashboardGridColumns-1)},
+					},
+					"y": schema.Int64Attribute{
+						Required:    true,
+						Description: "Row of the tile's top edge, from 0.",
+						Validators:  []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"w": schema.Int64Attribute{
+						Required:    true,
+						Description: fmt.Sprintf("Width in grid columns. x + w may not exceed %d.", dashboardGridColumns),
+						Validators:  []validator.Int64{int64validator.Between(1, dashboardGridColumns)},
+					},
+					"h": schema.Int64Attribute{
+						Required:    true,
+						Description: "Height in grid rows.",
+						Validators:  []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"display_type": schema.StringAttribute{
+						Required:    true,
+						Description: "Chart type: one of " + strings.Join(dashboardDisplayTypes, ", ") + ".",
+						Validators:  []validator.String{stringvalidator.OneOf(dashboardDisplayTypes...)},
+					},
+					"source_id": schema.StringAttribute{
+						Required:    true,
+						Description: "ID of the `clickhouse_clickstack_source` the tile queries.",
+					},
+					"where": schema.StringAttribute{
+						Optional:    true,
+						Description: "Filter applied to the tile's query. May reference variables as `{{name}}`.",
+					},
+					"where_language": schema.StringAttribute{
+						Optional:    true,
+						Description: "Language of `where`: `sql` or `lucene`.",
+						Validators:  []validator.String{stringvalidator.OneOf(dashboardQueryLanguages...)},
+					},
+					"group_by": schema.StringAttribute{
+						Optional:    true,
+						Description: "Group-by expression. May reference variables as `{{name}}`.",
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"select": schema.ListNestedBlock{
+						Description: "A series plotted by the tile. At least one is required.",
+						Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"agg_fn": schema.StringAttribute{
+									Required:    true,
+									Description: "Aggregation function, e.g. `count`, `sum`, `avg` or `quantile`.",
+								},
+								"value_expression": schema.StringAttribute{
+									Optional:    true,
+									Description: "Expression aggregated. Not allowed with `count`. May reference variables as `{{name}}`.",
+								},
+								"alias": schema.StringAttribute{
+									Optional:    true,
+									Description: "Series label.",
+								},
+								"level": schema.Float64Attribute{
+									Optional:    true,
+									Description: "Quantile level between 0 and 1. Only allowed with `quantile`.",
+								},
+								"metric_type": schema.StringAttribute{
+									Optional:    true,
+									Description: "Metric type for metric sources, e.g. `gauge` or `sum`.",
+								},
+								"metric_name": schema.StringAttribute{
+									Optional:    true,
+									Description: "Metric name for metric sources.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		dashboardFilterAttr: schema.ListNestedBlock{
+			Description: "A dashboard-level filter dropdown. Conflicts with `dashboard_json`.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					nameAttr: schema.StringAttribute{
+						Required:    true,
+						Description: "Filter label.",
+					},
+					"expression": schema.StringAttribute{
+						Required:    true,
+						Description: "Expression whose values populate the filter.",
+					},
+					"source_id": schema.StringAttribute{
+						Required:    true,
+						Description: "ID of the source the expression is evaluated against.",
+					},
+					"where_language": schema.StringAttribute{
+						Optional:    true,
+						Description: "Language the filter applies in: `sql` or `lucene`.",
+						Validators:  []validator.String{stringvalidator.OneOf(dashboardQueryLanguages...)},
+					},
+				},
+			},
+		},
+		dashboardVariableAttr: schema.ListNestedBlock{
+			Description: "A value substituted for `{{name}}` in tile `where`, `group_by` and " +
+				"`value_expression` when the dashboard body is compiled. Variables are a provider " +
+				"feature; the API only sees the substituted text. Conflicts with `dashboard_json`.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					nameAttr: schema.StringAttribute{
+						Required:    true,
+						Description: "Variable name: letters, digits and underscores, not starting with a digit.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(dashboardVariableName, "must be letters, digits and underscores, not starting with a digit"),
+						},
+					},
+					"value": schema.StringAttribute{
+						Required:    true,
+						Description: "Text substituted for the reference.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// --- mode ---
+
+// usesTypedSchema reports whether any typed attribute or block is set.
+func (m *dashboardResourceModel) usesTypedSchema() bool {
+	return !m.Name.IsNull() || !m.Tags.IsNull() || len(m.Tiles) > 0 || len(m.Filters) > 0 || len(m.Variables) > 0
+}
+
+// typedIsKnown reports whether every typed value is known, so the body can
+// be compiled. Unknowns only occur during validation and planning.
+func (m *dashboardResourceModel) typedIsKnown() bool {
+	vals := []attr.Value{m.Name, m.Tags}
+	for _, t := range m.Tiles {
+		vals = append(vals, t.ID, t.Name, t.X, t.Y, t.W, t.H, t.DisplayType, t.SourceID, t.Where, t.WhereLanguage, t.GroupBy)
+		for _, s := range t.Select {
+			vals = append(vals, s.AggFn, s.ValueExpression, s.Alias, s.Level, s.MetricType, s.MetricName)
+		}
+	}
+	for _, f := range m.Filters {
+		vals = append(vals, f.Name, f.Expression, f.SourceID, f.WhereLanguage)
+	}
+	for _, v := range m.Variables {
+		vals = append(vals, v.Name, v.Value)
+	}
+	for _, v := range vals {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// authoredBody returns the body sent to the API: dashboard_json as written,
+// or the compiled typed schema.
+func (m *dashboardResourceModel) authoredBody(ctx context.Context) (json.RawMessage, diag.Diagnostics) {
+	if !m.usesTypedSchema() {
+		return json.RawMessage(m.DashboardJSON.ValueString()), nil
+	}
+	return m.compileDashboard(ctx)
+}
+
+// --- validation ---
+
+// validateDashboardMode checks that exactly one of dashboard_json and the
+// typed schema is used.
+func (m *dashboardResourceModel) validateDashboardMode() diag.Diagnostics {
+	var diags diag.Diagnostics
+	typed := m.usesTypedSchema()
+	switch {
+	case !m.DashboardJSON.IsNull() && typed:
+		diags.AddAttributeError(path.Root(dashboardJSONAttr), "Conflicting dashboard definitions",
+			"dashboard_json cannot be combined with name, tags, or tile, filter and variable blocks. Use one or the other.")
+	case m.DashboardJSON.IsNull() && !typed:
+		diags.AddError("Missing dashboard definition",
+			"Set either dashboard_json or name with one or more tile blocks.")
+	case typed && m.Name.IsNull():
+		diags.AddAttributeError(path.Root(nameAttr), "Missing dashboard name",
+			"name is required when the dashboard is defined with tile blocks.")
+	}
+	return diags
+}
+
+// validateTypedDashboard checks what the schema validators cannot see on
+// their own: tiles that leave the grid or overlap, duplicate tile names
+// (they anchor tile IDs on update), select entries the API's write schema
+// rejects, and variable references. Unknown values are skipped.
+func (m *dashboardResourceModel) validateTypedDashboard() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vars := map[string]bool{}
+	for i, v := range m.Variables {
+		if v.Name.IsUnknown() {
+			continue
+		}
+		if vars[v.Name.ValueString()] {
+			diags.AddAttributeError(path.Root(dashboardVariableAttr).AtListIndex(i).AtName(nameAttr),
+				"Duplicate variable", fmt.Sprintf("variable %q is declared more than once.", v.Name.ValueString()))
+		}
+		vars[v.Name.ValueString()] = true
+	}
+	checkRefs := func(p path.Path, v types.String) {
+		if v.IsNull() || v.IsUnknown() {
+			return
+		}
+		for _, ref := range dashboardVariableRef.FindAllStringSubmatch(v.ValueString(), -1) {
+			if !vars[ref[1]] {
+				diags.AddAttributeError(p, "Undefined variable",
+					fmt.Sprintf("{{%s}} does not match any variable block.", ref[1]))
+			}
+		}
+	}
+
+	names := map[string]int{}
+	for i, t := range m.Tiles {
+		tp := path.Root(dashboardTileAttr).AtListIndex(i)
+		if !t.Name.IsUnknown() {
+			if j, dup := names[t.Name.ValueString()]; dup {
+				diags.AddAttributeError(tp.AtName(nameAttr), "Duplicate tile name",
+					fmt.Sprintf("tile %d has the same name as tile %d. Tile names must be unique: they carry each tile's ID, and the alerts bound to it, across updates.", i, j))
+			} else {
+				names[t.Name.ValueString()] = i
+			}
+		}
+		if allKnown(t.X, t.W) && t.X.ValueInt64()+t.W.ValueInt64() > dashboardGridColumns {
+			diags.AddAttributeError(tp.AtName("w"), "Tile outside the dashboard grid",
+				fmt.Sprintf("x + w is %d; the grid is %d columns wide.", t.X.ValueInt64()+t.W.ValueInt64(), dashboardGridColumns))
+		}
+		for j := range i {
+			if tilesOverlap(m.Tiles[j], t) {
+				diags.AddAttributeError(tp, "Overlapping tiles",
+					fmt.Sprintf("tile %q overlaps tile %q. The dashboard would move one of them, so the stored layout would not match the configuration.", t.Name.ValueString(), m.Tiles[j].Name.ValueString()))
+			}
+		}
+		checkRefs(tp.AtName("where"), t.Where)
+		checkRefs(tp.AtName("group_by"), t.GroupBy)
+		for k, s := range t.Select {
+			sp := tp.AtName("select").AtListIndex(k)
+			checkRefs(sp.AtName("value_expression"), s.ValueExpression)
+			if s.AggFn.IsUnknown() {
+				continue
+			}
+			aggFn := s.AggFn.ValueString()
+			if !s.Level.IsNull() && aggFn != aggFnQuantile {
+				diags.AddAttributeError(sp.AtName("level"), "Invalid select",
+					"level can only be used with the quantile aggregation.")
+			}
+			if s.Level.IsNull() && aggFn == aggFnQuantile {
+				diags.AddAttributeError(sp.AtName("level"), "Invalid select",
+					"the quantile aggregation requires level.")
+			}
+			if !s.ValueExpression.IsNull() && aggFn == aggFnCount {
+				diags.AddAttributeError(sp.AtName("value_expression"), "Invalid select",
+					"value_expression cannot be used with the count aggregation.")
+			}
+		}
+	}
+	return diags
+}
+
+// tilesOverlap reports whether two tiles with known geometry share a cell.
+func tilesOverlap(a, b dashboardTileModel) bool {
+	if !allKnown(a.X, a.Y, a.W, a.H, b.X, b.Y, b.W, b.H) {
+		return false
+	}
+	return a.X.ValueInt64() < b.X.ValueInt64()+b.W.ValueInt64() &&
+		b.X.ValueInt64() < a.X.ValueInt64()+a.W.ValueInt64() &&
+		a.Y.ValueInt64() < b.Y.ValueInt64()+b.H.ValueInt64() &&
+		b.Y.ValueInt64() < a.Y.ValueInt64()+a.H.ValueInt64()
+}
+
+// allKnown reports whether every value is known; see known.
+func allKnown(vals ...types.Int64) bool {
+	for _, v := range vals {
+		if !known(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// --- compilation ---
+
+// compileDashboard builds the v2 API body from the typed schema. Every value
+// must be known.
+func (m *dashboardResourceModel) compileDashboard(ctx context.Context) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vars := make(map[string]string, len(m.Variables))
+	for _, v := range m.Variables {
+		vars[v.Name.ValueString()] = v.Value.ValueString()
+	}
+	expand := func(v types.String) string {
+		return dashboardVariableRef.ReplaceAllStringFunc(v.ValueString(), func(ref string) string {
+			name := dashboardVariableRef.FindStringSubmatch(ref)[1]
+			if val, ok := vars[name]; ok {
+				return val
+			}
+			return ref
+		})
+	}
+
+	body := dashboardBody{
+		Name:  m.Name.ValueString(),
+		Tiles: make([]dashboardTileBody, 0, len(m.Tiles)),
+	}
+	if !m.Tags.IsNull() {
+		diags.Append(m.Tags.ElementsAs(ctx, &body.Tags, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	for _, t := range m.Tiles {
+		tile := dashboardTileBody{
+			ID:   t.ID.ValueString(),
+			Name: t.Name.ValueString(),
+			X:    t.X.ValueInt64(),
+			Y:    t.Y.ValueInt64(),
+			W:    t.W.ValueInt64(),
+			H:    t.H.ValueInt64(),
+			Config: dashboardTileConfig{
+				DisplayType:   t.DisplayType.ValueString(),
+				SourceID:      t.SourceID.ValueString(),
+				Where:         expand(t.Where),
+				WhereLanguage: t.WhereLanguage.ValueString(),
+				GroupBy:       expand(t.GroupBy),
+				Select:        make([]dashboardSelectBody, 0, len(t.Select)),
+			},
+		}
+		for _, s := range t.Select {
+			tile.Config.Select = append(tile.Config.Select, dashboardSelectBody{
+				AggFn:           s.AggFn.ValueString(),
+				ValueExpression: expand(s.ValueExpression),
+				Alias:           s.Alias.ValueString(),
+				Level:           s.Level.ValueFloat64Pointer(),
+				MetricType:      s.MetricType.ValueString(),
+				MetricName:      s.MetricName.ValueString(),
+			})
+		}
+		body.Tiles = append(body.Tiles, tile)
+	}
+	for _, f := range m.Filters {
+		body.Filters = append(body.Filters, dashboardFilterBody{
+			Type:          dashboardFilterTypeQuery,
+			Name:          f.Name.ValueString(),
+			Expression:    f.Expression.ValueString(),
+			SourceID:      f.SourceID.ValueString(),
+			WhereLanguage: f.WhereLanguage.ValueString(),
+		})
+	}
+
+	out, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Invalid dashboard", "could not encode the dashboard body: "+err.Error())
+		return nil, diags
+	}
+	return out, diags
+}
+
+// typedDashboardPath maps a validate-endpoint error path ("tiles.2.config.
+// select.0") onto the typed block it came from (tile[2]). Paths it cannot
+// place refer to the whole resource.
+func typedDashboardPath(apiPath string) path.Path {
+	parts := strings.Split(apiPath, ".")
+	blocks := map[string]string{"tiles": dashboardTileAttr, "filters": dashboardFilterAttr}
+	switch {
+	case parts[0] == nameAttr || parts[0] == dashboardTagsAttr:
+		return path.Root(parts[0])
+	case blocks[parts[0]] != "" && len(parts) > 1:
+		if i, err := strconv.Atoi(parts[1]); err == nil {
+			return path.Root(blocks[parts[0]]).AtListIndex(i)
+		}
+	}
+	return path.Empty()
+}
@@ -0,0 +1,208 @@
+package clickstack
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func typedTile(name string, x, y, w, h int64) dashboardTileModel {
+	return dashboardTileModel{
+		ID:            types.StringNull(),
+		Name:          types.StringValue(name),
+		X:             types.Int64Value(x),
+		Y:             types.Int64Value(y),
+		W:             types.Int64Value(w),
+		H:             types.Int64Value(h),
+		DisplayType:   types.StringValue("line"),
+		SourceID:      types.StringValue("src1"),
+		Where:         types.StringNull(),
+		WhereLanguage: types.StringNull(),
+		GroupBy:       types.StringNull(),
+		Select: []dashboardSelectModel{{
+			AggFn:           types.StringValue("count"),
+			ValueExpression: types.StringNull(),
+			Alias:           types.StringNull(),
+			Level:           types.Float64Null(),
+			MetricType:      types.StringNull(),
+			MetricName:      types.StringNull(),
+		}},
+	}
+}
+
+func typedDashboard(tiles ...dashboardTileModel) dashboardResourceModel {
+	return dashboardResourceModel{
+		DashboardJSON: types.StringNull(),
+		Name:          types.StringValue("D"),
+		Tags:          types.SetNull(types.StringType),
+		Tiles:         tiles,
+	}
+}
+
+func TestCompileDashboard(t *testing.T) {
+	t.Parallel()
+
+	tile := typedTile("Errors", 0, 0, 12, 4)
+	tile.Where = types.StringValue("ServiceName = '{{service}}'")
+	tile.WhereLanguage = types.StringValue("sql")
+	tile.GroupBy = types.StringValue("SeverityText")
+	m := typedDashboard(tile)
+	m.Tags = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("otel")})
+	m.Filters = []dashboardFilterModel{{
+		Name:          types.StringValue("Service"),
+		Expression:    types.StringValue("ServiceName"),
+		SourceID:      types.StringValue("src1"),
+		WhereLanguage: types.StringNull(),
+	}}
+	m.Variables = []dashboardVariableModel{{Name: types.StringValue("service"), Value: types.StringValue("checkout")}}
+
+	got, diags := m.compileDashboard(context.Background())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := `{"name":"D","tags":["otel"],"tiles":[{"name":"Errors","x":0,"y":0,"w":12,"h":4,` +
+		`"config":{"displayType":"line","sourceId":"src1","select":[{"aggFn":"count"}],` +
+		`"where":"ServiceName = 'checkout'","whereLanguage":"sql","groupBy":"SeverityText"}}],` +
+		`"filters":[{"type":"QUERY_EXPRESSION","name":"Service","expression":"ServiceName","sourceId":"src1"}]}`
+	if string(got) != want {
+		t.Errorf("body =\n%s\nwant\n%s", got, want)
+	}
+	if err := parseDashboardJSON(string(got)); err != nil {
+		t.Errorf("compiled body is not a dashboard object: %v", err)
+	}
+}
+
+// TestCompileDashboard_TileIDsMerge checks that a compiled body goes through
+// the same tile-ID carry-forward as dashboard_json on update.
+func TestCompileDashboard_TileIDsMerge(t *testing.T) {
+	t.Parallel()
+
+	m := typedDashboard(typedTile("a", 0, 0, 6, 3), typedTile("b", 6, 0, 6, 3))
+	m.Tiles[1].ID = types.StringValue("pinned")
+	body, diags := m.compileDashboard(context.Background())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	merged, err := mergeTileIDs(body, json.RawMessage(`{"tiles":[{"id":"t-a","name":"a"},{"id":"t-b","name":"b"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc dashboardBody
+	if err := json.Unmarshal(merged, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Tiles[0].ID != "t-a" || doc.Tiles[1].ID != "pinned" {
+		t.Errorf("tile ids = %q, %q; want t-a, pinned", doc.Tiles[0].ID, doc.Tiles[1].ID)
+	}
+}
+
+func TestValidateTypedDashboard(t *testing.T) {
+	t.Parallel()
+
+	quantile := func(level types.Float64) dashboardTileModel {
+		tile := typedTile("q", 0, 0, 6, 3)
+		tile.Select[0].AggFn = types.StringValue(aggFnQuantile)
+		tile.Select[0].ValueExpression = types.StringValue("Duration")
+		tile.Select[0].Level = level
+		return tile
+	}
+	countWithValue := typedTile("c", 0, 0, 6, 3)
+	countWithValue.Select[0].ValueExpression = types.StringValue("Duration")
+	undefinedRef := typedTile("u", 0, 0, 6, 3)
+	undefinedRef.Where = types.StringValue("ServiceName = '{{svc}}'")
+	unknownGeometry := typedTile("b", 0, 0, 6, 3)
+	unknownGeometry.X = types.Int64Unknown()
+
+	cases := []struct {
+		name    string
+		model   dashboardResourceModel
+		wantErr string
+	}{
+		{"side by side", typedDashboard(typedTile("a", 0, 0, 12, 3), typedTile("b", 12, 0, 12, 3)), ""},
+		{"stacked", typedDashboard(typedTile("a", 0, 0, 6, 3), typedTile("b", 0, 3, 6, 3)), ""},
+		{"overlap", typedDashboard(typedTile("a", 0, 0, 6, 3), typedTile("b", 5, 2, 6, 3)), "Overlapping tiles"},
+		{"past the grid edge", typedDashboard(typedTile("a", 20, 0, 6, 3)), "Tile outside the dashboard grid"},
+		{"unknown geometry is skipped", typedDashboard(typedTile("a", 0, 0, 6, 3), unknownGeometry), ""},
+		{"duplicate names", typedDashboard(typedTile("a", 0, 0, 6, 3), typedTile("a", 6, 0, 6, 3)), "Duplicate tile name"},
+		{"quantile with level", typedDashboard(quantile(types.Float64Value(0.95))), ""},
+		{"quantile without level", typedDashboard(quantile(types.Float64Null())), "Invalid select"},
+		{"count with value_expression", typedDashboard(countWithValue), "Invalid select"},
+		{"undefined variable", typedDashboard(undefinedRef), "Undefined variable"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			diags := tc.model.validateTypedDashboard()
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantErr {
+				t.Errorf("errors = %v, want %q", diags, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDashboardMode(t *testing.T) {
+	t.Parallel()
+
+	jsonOnly := dashboardResourceModel{DashboardJSON: types.StringValue(`{}`), Name: types.StringNull(), Tags: types.SetNull(types.StringType)}
+	both := typedDashboard(typedTile("a", 0, 0, 6, 3))
+	both.DashboardJSON = types.StringValue(`{}`)
+	neither := dashboardResourceModel{DashboardJSON: types.StringNull(), Name: types.StringNull(), Tags: types.SetNull(types.StringType)}
+	tilesWithoutName := typedDashboard(typedTile("a", 0, 0, 6, 3))
+	tilesWithoutName.Name = types.StringNull()
+
+	cases := []struct {
+		name    string
+		model   dashboardResourceModel
+		wantErr string
+	}{
+		{"dashboard_json", jsonOnly, ""},
+		{"typed", typedDashboard(typedTile("a", 0, 0, 6, 3)), ""},
+		{"both", both, "Conflicting dashboard definitions"},
+		{"neither", neither, "Missing dashboard definition"},
+		{"tiles without name", tilesWithoutName, "Missing dashboard name"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			diags := tc.model.validateDashboardMode()
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Summary(), tc.wantErr) {
+				t.Errorf("errors = %v, want %q", diags, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTypedDashboardPath(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]path.Path{
+		"tiles.2.config.select.0": path.Root(dashboardTileAttr).AtListIndex(2),
+		"filters.0":               path.Root(dashboardFilterAttr).AtListIndex(0),
+		"name":                    path.Root(nameAttr),
+		"containers.0":            path.Empty(),
+		"tiles":                   path.Empty(),
+		"":                        path.Empty(),
+	}
+	for in, want := range cases {
+		if got := typedDashboardPath(in); !got.Equal(want) {
+			t.Errorf("typedDashboardPath(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
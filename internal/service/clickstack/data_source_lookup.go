@@ -0,0 +1,101 @@
+package clickstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+// configureDataSource extracts the ClickStack client from the provider data.
+// It returns nil when there is nothing to configure yet or on error, which it
+// reports in resp.
+func configureDataSource(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return nil
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "data source")
+		return nil
+	}
+	return providerData.ClickStack
+}
+
+// teamLookupAttribute is the optional team attribute of every ClickStack data
+// source.
+func teamLookupAttribute(object string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Team ID to look the " + object + " up under, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+	}
+}
+
+// idOrNameAttributes returns the id and name attributes of a data source that
+// looks an object up by either. Exactly one of the two must be set; the other
+// is filled in from the object found.
+func idOrNameAttributes(object string) (id, name schema.StringAttribute) {
+	exactlyOne := []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot(idAttr), path.MatchRoot(nameAttr))}
+	id = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Identifier of the " + object + ". Exactly one of `id` and `name` must be set.",
+		Validators:  exactlyOne,
+	}
+	name = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Description: "Name of the " + object + " to look up. Exactly one of `id` and `name` must be set. " +
+			"Names are not unique in ClickStack; a name that matches more than one " + object + " is an error.",
+		Validators: exactlyOne,
+	}
+	return id, name
+}
+
+// findByIDOrName returns the single item whose ID is id or, when id is empty,
+// whose name is name. keys returns an item's ID and name. The list APIs are
+// the only lookup by name, so both lookups go through a list for consistency.
+func findByIDOrName[T any](items []T, object, id, name string, keys func(T) (string, string)) (*T, error) {
+	var found []int
+	for i, item := range items {
+		itemID, itemName := keys(item)
+		if (id != "" && itemID == id) || (id == "" && itemName == name) {
+			found = append(found, i)
+		}
+	}
+	switch {
+	case len(found) == 1:
+		return &items[found[0]], nil
+	case id != "":
+		return nil, fmt.Errorf("no %s with ID %q was found for the team", object, id)
+	case len(found) == 0:
+		return nil, fmt.Errorf("no %s named %q was found for the team", object, name)
+	default:
+		return nil, fmt.Errorf("the name %q is ambiguous: %d %s entries have it; look the %s up by id instead", name, len(found), object, object)
+	}
+}
+
+// emptyPtrToNull maps an optional API string to state. The API returns unset
+// fields both as absent and as "", and a data source reports both as null.
+func emptyPtrToNull(p *string) types.String {
+	if p == nil {
+		return types.StringNull()
+	}
+	return emptyToNull(*p)
+}
@@ -0,0 +1,162 @@
+package clickstack
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFindByIDOrName(t *testing.T) {
+	t.Parallel()
+	type item struct{ id, name string }
+	items := []item{{"1", "logs"}, {"2", "traces"}, {"3", "dup"}, {"4", "dup"}}
+	keys := func(i item) (string, string) { return i.id, i.name }
+
+	cases := []struct {
+		name    string
+		id      string
+		lookup  string
+		wantID  string
+		wantErr string
+	}{
+		{name: "by id", id: "2", wantID: "2"},
+		{name: "by name", lookup: "logs", wantID: "1"},
+		{name: "id wins over name", id: "1", lookup: "traces", wantID: "1"},
+		{name: "unknown id", id: "9", wantErr: `no source with ID "9"`},
+		{name: "unknown name", lookup: "nope", wantErr: `no source named "nope"`},
+		{name: "ambiguous name", lookup: "dup", wantErr: "ambiguous"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := findByIDOrName(items, "source", tc.id, tc.lookup, keys)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("err = %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.id != tc.wantID {
+				t.Errorf("found %q, want %q", got.id, tc.wantID)
+			}
+		})
+	}
+}
+
+// readDataSource runs ds.Read against a test server running h, with the given
+// config values (every other attribute null), and returns the response.
+func readDataSource(t *testing.T, ds fwdatasource.DataSource, h http.HandlerFunc, vals map[string]tftypes.Value) *fwdatasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &fwdatasource.SchemaResponse{}
+	ds.Schema(ctx, fwdatasource.SchemaRequest{}, schemaResp)
+	sch := schemaResp.Schema
+
+	objType := sch.Type().TerraformType(ctx).(tftypes.Object)
+	all := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if v, ok := vals[name]; ok {
+			all[name] = v
+			continue
+		}
+		all[name] = tftypes.NewValue(typ, nil)
+	}
+
+	switch d := ds.(type) {
+	case *sourceDataSource:
+		d.client = dashboardTestClient(t, h)
+	case *webhooksDataSource:
+		d.client = dashboardTestClient(t, h)
+	case *teamMembersDataSource:
+		d.client = dashboardTestClient(t, h)
+	default:
+		t.Fatalf("readDataSource: unsupported data source %T", ds)
+	}
+
+	resp := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	ds.Read(ctx, fwdatasource.ReadRequest{Config: tfsdk.Config{Schema: sch, Raw: tftypes.NewValue(objType, all)}}, resp)
+	return resp
+}
+
+func TestSourceDataSource_Read(t *testing.T) {
+	t.Parallel()
+	list := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"s1","name":"Logs","kind":"log","connection":"c1","from":{"databaseName":"default","tableName":"otel_logs"},
+			 "timestampValueExpression":"TimestampTime","traceSourceId":"s2","metricSourceId":""},
+			{"id":"s2","name":"Traces","kind":"trace","connection":"c1","from":{"databaseName":"default","tableName":"otel_traces"},
+			 "timestampValueExpression":"Timestamp"}]}`))
+	}
+
+	resp := readDataSource(t, &sourceDataSource{}, list, map[string]tftypes.Value{
+		nameAttr: tftypes.NewValue(tftypes.String, "Logs"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	var got sourceDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "s1" || got.Kind.ValueString() != "log" || got.From.TableName.ValueString() != "otel_logs" {
+		t.Errorf("unexpected source: id=%s kind=%s from=%+v", got.ID, got.Kind, got.From)
+	}
+	if got.TraceSourceID.ValueString() != "s2" || !got.MetricSourceID.IsNull() {
+		t.Errorf("correlated ids: trace=%s metric=%s, want s2 and null", got.TraceSourceID, got.MetricSourceID)
+	}
+
+	resp = readDataSource(t, &sourceDataSource{}, list, map[string]tftypes.Value{
+		nameAttr: tftypes.NewValue(tftypes.String, "Sessions"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a name with no source")
+	}
+}
+
+func TestWebhooksDataSource_ServiceFilter(t *testing.T) {
+	t.Parallel()
+	list := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[
+			{"id":"w1","name":"alerts","service":"slack","url":"https://hooks.slack.com/x"},
+			{"id":"w2","name":"alerts","service":"generic","url":"https://example.com","description":"ops"}],
+			"meta":{"total":2,"limit":200,"offset":0}}`))
+	}
+
+	resp := readDataSource(t, &webhooksDataSource{}, list, map[string]tftypes.Value{
+		"service": tftypes.NewValue(tftypes.String, "generic"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	var got webhooksDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if len(got.Webhooks) != 1 || got.Webhooks[0].ID.ValueString() != "w2" || got.Webhooks[0].Description.ValueString() != "ops" {
+		t.Errorf("unexpected webhooks: %+v", got.Webhooks)
+	}
+}
+
+func TestTeamMembersDataSource_Read(t *testing.T) {
+	t.Parallel()
+	resp := readDataSource(t, &teamMembersDataSource{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-hdx-team") != "t1" {
+			t.Errorf("x-hdx-team = %q, want t1", r.Header.Get("x-hdx-team"))
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"u1","email":"a@example.com","roleId":"r1","roleName":"Admin","isVirtual":false}]}`))
+	}, map[string]tftypes.Value{
+		teamAttr: tftypes.NewValue(tftypes.String, "t1"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	var got teamMembersDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if len(got.Members) != 1 || got.Members[0].Email.ValueString() != "a@example.com" || !got.Members[0].Name.IsNull() {
+		t.Errorf("unexpected members: %+v", got.Members)
+	}
+}
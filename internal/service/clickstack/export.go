@@ -0,0 +1,374 @@
+package clickstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+// Resource types written by GenerateConfig.
+const (
+	exportWebhookType     = "clickhouse_clickstack_webhook"
+	exportSavedSearchType = "clickhouse_clickstack_saved_search"
+	exportDashboardType   = "clickhouse_clickstack_dashboard"
+	exportAlertType       = "clickhouse_clickstack_alert"
+)
+
+// GenerateConfig reads the webhooks, saved searches, dashboards and alerts of
+// a ClickStack team and renders them as Terraform configuration: one file per
+// resource type plus imports.tf, whose import blocks adopt the existing
+// objects on the next apply. The result maps file names to contents.
+//
+// Dashboards are exported as dashboard_json, cleaned up the way an imported
+// dashboard is (server-assigned ids and invalid select fields dropped; tile
+// ids kept so alerts stay bound). References between exported objects — an
+// alert's saved search, dashboard and webhook — become resource references;
+// anything else, such as source IDs, stays a literal ID. Webhook headers and
+// query parameters are not returned by the API and must be added by hand. A
+// tile alert whose tile cannot be named unambiguously is left out, with a
+// comment in alerts.tf.
+//
+// team is the team to export ("" for the API key's team); it is set on every
+// resource and prefixed to the import IDs.
+func GenerateConfig(ctx context.Context, c *client.Client, team string) (map[string][]byte, error) {
+	c = c.WithTeam(team)
+	webhooks, err := c.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	searches, err := c.ListSavedSearches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list saved searches: %w", err)
+	}
+	dashboards, err := c.ListDashboards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dashboards: %w", err)
+	}
+	alerts, err := c.ListAlerts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list alerts: %w", err)
+	}
+
+	g := newConfigGenerator(team)
+	for _, wh := range webhooks {
+		g.webhook(wh)
+	}
+	for _, ss := range searches {
+		g.savedSearch(ss)
+	}
+	for _, body := range dashboards {
+		if err := g.dashboard(body); err != nil {
+			return nil, err
+		}
+	}
+	for _, al := range alerts {
+		g.alert(al)
+	}
+	return g.files(), nil
+}
+
+// configGenerator accumulates the exported resources. refs maps an exported
+// object's ID to its resource address, so later objects can reference it.
+type configGenerator struct {
+	team       string
+	out        map[string]*hclwrite.File
+	imports    *hclwrite.File
+	labels     map[string]int
+	refs       map[string]hcl.Traversal
+	dashboards map[string]json.RawMessage
+}
+
+func newConfigGenerator(team string) *configGenerator {
+	return &configGenerator{
+		team:       team,
+		out:        map[string]*hclwrite.File{},
+		imports:    hclwrite.NewEmptyFile(),
+		labels:     map[string]int{},
+		refs:       map[string]hcl.Traversal{},
+		dashboards: map[string]json.RawMessage{},
+	}
+}
+
+// files renders every non-empty file, formatted like `terraform fmt`.
+func (g *configGenerator) files() map[string][]byte {
+	out := make(map[string][]byte, len(g.out)+1)
+	for name, f := range g.out {
+		out[name] = hclwrite.Format(f.Bytes())
+	}
+	if len(g.imports.Body().Blocks()) > 0 {
+		out["imports.tf"] = hclwrite.Format(g.imports.Bytes())
+	}
+	return out
+}
+
+// file returns the body of file, separated by a blank line from what is
+// already in it.
+func (g *configGenerator) file(name string) *hclwrite.Body {
+	f, ok := g.out[name]
+	if !ok {
+		f = hclwrite.NewEmptyFile()
+		g.out[name] = f
+	} else {
+		f.Body().AppendNewline()
+	}
+	return f.Body()
+}
+
+// comment adds a comment line to file.
+func (g *configGenerator) comment(file, text string) {
+	g.file(file).AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + text + "\n")},
+	})
+}
+
+// resource starts a resource block for the object id in file, registers its
+// address for references and adds the matching import block.
+func (g *configGenerator) resource(file, typ, name, id string) *hclwrite.Body {
+	fb := g.file(file)
+
+	label := g.label(typ, name)
+	addr := hcl.Traversal{hcl.TraverseRoot{Name: typ}, hcl.TraverseAttr{Name: label}}
+	g.refs[id] = append(addr, hcl.TraverseAttr{Name: idAttr})
+
+	importID := id
+	if g.team != "" {
+		importID = g.team + "/" + id
+	}
+	imp := g.imports.Body()
+	if len(imp.Blocks()) > 0 {
+		imp.AppendNewline()
+	}
+	ib := imp.AppendNewBlock("import", nil).Body()
+	ib.SetAttributeTraversal("to", addr)
+	ib.SetAttributeValue(idAttr, cty.StringVal(importID))
+
+	body := fb.AppendNewBlock("resource", []string{typ, label}).Body()
+	if g.team != "" {
+		body.SetAttributeValue(teamAttr, cty.StringVal(g.team))
+	}
+	return body
+}
+
+// label turns name into a resource label unique within typ: lower case, with
+// runs of other characters replaced by "_", and a numeric suffix on repeats.
+func (g *configGenerator) label(typ, name string) string {
+	var b strings.Builder
+	sep := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if sep && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+			sep = false
+		} else {
+			sep = true
+		}
+	}
+	label := b.String()
+	if label == "" || unicode.IsDigit(rune(label[0])) {
+		label = "r_" + label
+	}
+	key := typ + "." + label
+	g.labels[key]++
+	if n := g.labels[key]; n > 1 {
+		label += "_" + strconv.Itoa(n)
+	}
+	return label
+}
+
+// setRef sets attr to a reference to the exported object id, or to id itself
+// when that object was not exported.
+func (g *configGenerator) setRef(body *hclwrite.Body, attr, id string) {
+	if ref, ok := g.refs[id]; ok {
+		body.SetAttributeTraversal(attr, ref)
+		return
+	}
+	body.SetAttributeValue(attr, cty.StringVal(id))
+}
+
+// refTokens is setRef for a value nested in an object.
+func (g *configGenerator) refTokens(id string) hclwrite.Tokens {
+	if ref, ok := g.refs[id]; ok {
+		return hclwrite.TokensForTraversal(ref)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(id))
+}
+
+func setOptString(body *hclwrite.Body, attr string, v *string) {
+	if v != nil {
+		body.SetAttributeValue(attr, cty.StringVal(*v))
+	}
+}
+
+// setJSON sets attr to jsonencode(<raw as an HCL value>), which reads better
+// and diffs better than an escaped JSON string.
+func setJSON(body *hclwrite.Body, attr string, raw json.RawMessage) error {
+	ty, err := ctyjson.ImpliedType(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", attr, err)
+	}
+	v, err := ctyjson.Unmarshal(raw, ty)
+	if err != nil {
+		return fmt.Errorf("%s: %w", attr, err)
+	}
+	body.SetAttributeRaw(attr, hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(v)))
+	return nil
+}
+
+func (g *configGenerator) webhook(wh client.Webhook) {
+	body := g.resource("webhooks.tf", exportWebhookType, wh.Name, wh.ID)
+	body.SetAttributeValue(nameAttr, cty.StringVal(wh.Name))
+	body.SetAttributeValue("service", cty.StringVal(wh.Service))
+	body.SetAttributeValue("url", cty.StringVal(wh.URL))
+	setOptString(body, descriptionAttr, wh.Description)
+	setOptString(body, "body", wh.Body)
+}
+
+func (g *configGenerator) savedSearch(ss client.SavedSearch) {
+	body := g.resource("saved_searches.tf", exportSavedSearchType, ss.Name, ss.ID)
+	body.SetAttributeValue(nameAttr, cty.StringVal(ss.Name))
+	body.SetAttributeValue("source_id", cty.StringVal(ss.SourceID))
+	body.SetAttributeValue("select", cty.StringVal(ss.Select))
+	body.SetAttributeValue("where", cty.StringVal(ss.Where))
+	body.SetAttributeValue("where_language", cty.StringVal(ss.WhereLanguage))
+	body.SetAttributeValue("order_by", cty.StringVal(ss.OrderBy))
+	if len(ss.Tags) > 0 {
+		tags := make([]cty.Value, len(ss.Tags))
+		for i, t := range ss.Tags {
+			tags[i] = cty.StringVal(t)
+		}
+		body.SetAttributeValue("tags", cty.ListVal(tags))
+	}
+	if f := strings.TrimSpace(string(ss.Filters)); f != "" && f != "[]" && f != "null" {
+		if err := setJSON(body, "filters", ss.Filters); err != nil {
+			body.SetAttributeValue("filters", cty.StringVal(f))
+		}
+	}
+}
+
+func (g *configGenerator) dashboard(raw json.RawMessage) error {
+	var meta struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return fmt.Errorf("decode dashboard: %w", err)
+	}
+	g.dashboards[meta.ID] = raw
+
+	authored, err := stripServerIDs(raw)
+	if err != nil {
+		return fmt.Errorf("dashboard %s: %w", meta.ID, err)
+	}
+	if authored, err = dropInvalidSelectFields(authored); err != nil {
+		return fmt.Errorf("dashboard %s: %w", meta.ID, err)
+	}
+	// Timestamps are server-owned; the resource ignores them, so leave them out.
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(authored, &doc); err != nil {
+		return fmt.Errorf("dashboard %s: %w", meta.ID, err)
+	}
+	for k := range volatileDashboardKeys {
+		delete(doc, k)
+	}
+	if authored, err = json.Marshal(doc); err != nil {
+		return fmt.Errorf("dashboard %s: %w", meta.ID, err)
+	}
+
+	body := g.resource("dashboards.tf", exportDashboardType, meta.Name, meta.ID)
+	if err := setJSON(body, dashboardJSONAttr, authored); err != nil {
+		return fmt.Errorf("dashboard %s: %w", meta.ID, err)
+	}
+	return nil
+}
+
+func (g *configGenerator) alert(al client.Alert) {
+	var tileName string
+	if al.Source == client.AlertSourceTile {
+		// A tile alert is bound by tile_name only, so one whose tile cannot be
+		// named is left out rather than exported bound to the wrong tile.
+		var err error
+		if tileName, err = g.alertTileName(al); err != nil {
+			g.comment("alerts.tf", fmt.Sprintf("Alert %s was not exported: %v.", al.ID, err))
+			return
+		}
+	}
+
+	name := "alert"
+	if al.Name != nil && *al.Name != "" {
+		name = *al.Name
+	}
+	body := g.resource("alerts.tf", exportAlertType, name, al.ID)
+
+	switch al.Source {
+	case client.AlertSourceTile:
+		g.setRef(body, "dashboard_id", al.DashboardID)
+		body.SetAttributeValue("tile_name", cty.StringVal(tileName))
+	default:
+		g.setRef(body, "saved_search_id", al.SavedSearchID)
+	}
+	setOptString(body, "group_by", al.GroupBy)
+
+	channel := []hclwrite.ObjectAttrTokens{{
+		Name:  hclwrite.TokensForIdentifier("type"),
+		Value: hclwrite.TokensForValue(cty.StringVal(al.Channel.Type)),
+	}}
+	if al.Channel.WebhookID != "" {
+		channel = append(channel, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier("webhook_id"),
+			Value: g.refTokens(al.Channel.WebhookID),
+		})
+	}
+	body.SetAttributeRaw("channel", hclwrite.TokensForObject(channel))
+
+	body.SetAttributeValue("threshold", cty.NumberFloatVal(al.Threshold))
+	body.SetAttributeValue("threshold_type", cty.StringVal(al.ThresholdType))
+	if isRangeThresholdType(al.ThresholdType) && al.ThresholdMax != nil {
+		body.SetAttributeValue("threshold_max", cty.NumberFloatVal(*al.ThresholdMax))
+	}
+	body.SetAttributeValue("interval", cty.StringVal(al.Interval))
+	if al.NumConsecutiveWindows != nil {
+		body.SetAttributeValue("num_consecutive_windows", cty.NumberIntVal(int64(*al.NumConsecutiveWindows)))
+	}
+	if al.ScheduleOffsetMinutes != nil && *al.ScheduleOffsetMinutes != 0 {
+		body.SetAttributeValue("schedule_offset_minutes", cty.NumberIntVal(int64(*al.ScheduleOffsetMinutes)))
+	}
+	setOptString(body, "schedule_start_at", al.ScheduleStartAt)
+	setOptString(body, nameAttr, al.Name)
+	setOptString(body, "message", al.Message)
+	setOptString(body, "note", al.Note)
+}
+
+// alertTileName returns the name of the tile a tile alert is on. The name has
+// to resolve back to that tile: one shared by several tiles does not.
+func (g *configGenerator) alertTileName(al client.Alert) (string, error) {
+	db, ok := g.dashboards[al.DashboardID]
+	if !ok {
+		return "", fmt.Errorf("dashboard %s was not found", al.DashboardID)
+	}
+	name, found, err := tileNameByID(db, al.TileID)
+	switch {
+	case err != nil:
+		return "", err
+	case !found:
+		return "", fmt.Errorf("the dashboard has no tile with ID %s", al.TileID)
+	case name == "":
+		return "", fmt.Errorf("tile %s has no name", al.TileID)
+	}
+	if _, err := resolveTileID(db, name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,133 @@
+package clickstack
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	t.Parallel()
+	responses := map[string]string{
+		"/api/v2/webhooks":       `{"data":[{"id":"wh1","name":"On-call Slack","service":"slack","url":"https://hooks.slack.com/x"}]}`,
+		"/api/v2/saved-searches": `{"data":[{"id":"ss1","name":"Checkout errors","sourceId":"src1","select":"","where":"level:error","whereLanguage":"lucene","orderBy":"","tags":["checkout"],"filters":[]}]}`,
+		"/api/v2/dashboards": `{"data":[{"id":"d1","name":"Checkout","createdAt":"2026-01-01T00:00:00Z","tags":[],` +
+			`"tiles":[{"id":"t1","name":"Error rate","x":0,"y":0,"w":6,"h":4,"config":{"displayType":"line","where":"${x}"}}],` +
+			`"filters":[{"id":"f1","type":"QUERY_EXPRESSION","name":"Service","expression":"ServiceName","sourceId":"src1"}]}]}`,
+		"/api/v2/alerts": `{"data":[` +
+			`{"id":"al1","source":"saved_search","savedSearchId":"ss1","interval":"5m","threshold":10,"thresholdType":"above","channel":{"type":"webhook","webhookId":"wh1"},"name":"Checkout errors"},` +
+			`{"id":"al2","source":"tile","dashboardId":"d1","tileId":"t1","interval":"1h","threshold":1,"thresholdType":"below","channel":{"type":"webhook","webhookId":"wh-gone"}}]}`,
+	}
+	c := dashboardTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-hdx-team") != "team1" {
+			t.Errorf("team header = %q", r.Header.Get("x-hdx-team"))
+		}
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+
+	files, err := GenerateConfig(context.Background(), c, "team1")
+	if err != nil {
+		t.Fatalf("GenerateConfig: %v", err)
+	}
+	for name, src := range files {
+		if _, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("%s does not parse: %s\n%s", name, diags, src)
+		}
+	}
+
+	want := map[string][]string{
+		"webhooks.tf": {`resource "clickhouse_clickstack_webhook" "on_call_slack"`, `team    = "team1"`},
+		"saved_searches.tf": {
+			`resource "clickhouse_clickstack_saved_search" "checkout_errors"`, `tags           = ["checkout"]`,
+		},
+		"dashboards.tf": {`dashboard_json = jsonencode(`, `"t1"`, `"$${x}"`},
+		"alerts.tf": {
+			`saved_search_id = clickhouse_clickstack_saved_search.checkout_errors.id`,
+			`webhook_id = clickhouse_clickstack_webhook.on_call_slack.id`,
+			`dashboard_id = clickhouse_clickstack_dashboard.checkout.id`,
+			`tile_name    = "Error rate"`,
+			`webhook_id = "wh-gone"`,
+			`resource "clickhouse_clickstack_alert" "alert"`,
+		},
+		"imports.tf": {`to = clickhouse_clickstack_alert.checkout_errors`, `id = "team1/al1"`},
+	}
+	for name, parts := range want {
+		got := string(files[name])
+		for _, p := range parts {
+			if !strings.Contains(got, p) {
+				t.Errorf("%s does not contain %q:\n%s", name, p, got)
+			}
+		}
+	}
+	dash := string(files["dashboards.tf"])
+	for _, absent := range []string{"createdAt", `"f1"`, `id = "d1"`} {
+		if strings.Contains(dash, absent) {
+			t.Errorf("dashboards.tf must not contain %s:\n%s", absent, dash)
+		}
+	}
+}
+
+func TestGenerateConfig_skipsUnboundTileAlerts(t *testing.T) {
+	t.Parallel()
+	responses := map[string]string{
+		"/api/v2/webhooks":       `{"data":[]}`,
+		"/api/v2/saved-searches": `{"data":[]}`,
+		"/api/v2/dashboards": `{"data":[{"id":"d1","name":"Checkout","tiles":[` +
+			`{"id":"t1","name":"Latency","x":0,"y":0,"w":6,"h":4,"config":{"displayType":"line"}},` +
+			`{"id":"t2","name":"Latency","x":6,"y":0,"w":6,"h":4,"config":{"displayType":"line"}},` +
+			`{"id":"t3","name":"Errors","x":0,"y":4,"w":6,"h":4,"config":{"displayType":"line"}}]}]}`,
+		"/api/v2/alerts": `{"data":[` +
+			`{"id":"al1","source":"tile","dashboardId":"d1","tileId":"t1","interval":"5m","threshold":1,"thresholdType":"above","channel":{"type":"webhook"}},` +
+			`{"id":"al2","source":"tile","dashboardId":"d-gone","tileId":"t9","interval":"5m","threshold":1,"thresholdType":"above","channel":{"type":"webhook"}},` +
+			`{"id":"al3","source":"tile","dashboardId":"d1","tileId":"t9","interval":"5m","threshold":1,"thresholdType":"above","channel":{"type":"webhook"}},` +
+			`{"id":"al4","source":"tile","dashboardId":"d1","tileId":"t3","interval":"5m","threshold":1,"thresholdType":"above","channel":{"type":"webhook"}}]}`,
+	}
+	c := dashboardTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(responses[r.URL.Path]))
+	}))
+
+	files, err := GenerateConfig(context.Background(), c, "")
+	if err != nil {
+		t.Fatalf("GenerateConfig: %v", err)
+	}
+	alerts := string(files["alerts.tf"])
+	if _, diags := hclsyntax.ParseConfig(files["alerts.tf"], "alerts.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("alerts.tf does not parse: %s\n%s", diags, alerts)
+	}
+	for _, p := range []string{
+		`# Alert al1 was not exported: the dashboard has 2 tiles named "Latency"`,
+		`# Alert al2 was not exported: dashboard d-gone was not found.`,
+		`# Alert al3 was not exported: the dashboard has no tile with ID t9.`,
+		`tile_name    = "Errors"`,
+	} {
+		if !strings.Contains(alerts, p) {
+			t.Errorf("alerts.tf does not contain %q:\n%s", p, alerts)
+		}
+	}
+	if got := strings.Count(string(files["imports.tf"]), "clickhouse_clickstack_alert."); got != 1 {
+		t.Errorf("want 1 alert import, got %d:\n%s", got, files["imports.tf"])
+	}
+}
+
+func TestConfigGeneratorLabel(t *testing.T) {
+	t.Parallel()
+	g := newConfigGenerator("")
+	for _, tc := range []struct{ name, want string }{
+		{"Checkout — p99 latency!", "checkout_p99_latency"},
+		{"Checkout p99 latency", "checkout_p99_latency_2"},
+		{"5xx rate", "r_5xx_rate"},
+		{"", "r_"},
+	} {
+		if got := g.label(exportAlertType, tc.name); got != tc.want {
+			t.Errorf("label(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
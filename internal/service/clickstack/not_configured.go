@@ -3,9 +3,9 @@ package clickstack
 import "github.com/hashicorp/terraform-plugin-framework/diag"
 
 // addNotConfiguredError reports the shared "ClickStack not configured" error
-// emitted by every clickhouse_clickstack_* resource and data source whose
-// Configure runs without a ClickStack client. kind is "resource" or
-// "data source".
+// emitted by every clickhouse_clickstack_* resource, data source and ephemeral
+// resource whose Configure runs without a ClickStack client. kind is
+// "resource", "data source" or "ephemeral resource".
 func addNotConfiguredError(diags *diag.Diagnostics, kind string) {
 	diags.AddError("ClickStack not configured",
 		"This "+kind+" requires ClickStack credentials. For self-hosted ClickStack, set clickstack_endpoint and "+
@@ -0,0 +1,118 @@
+package clickstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = (*savedSearchDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*savedSearchDataSource)(nil)
+)
+
+// NewSavedSearchDataSource is a helper to register the data source with the provider.
+func NewSavedSearchDataSource() datasource.DataSource {
+	return &savedSearchDataSource{}
+}
+
+// savedSearchDataSource looks up a ClickStack saved search by ID or name,
+// typically to point a clickstack_alert at a search kept elsewhere.
+type savedSearchDataSource struct {
+	client *client.Client
+}
+
+// savedSearchDataSourceModel maps the data source schema data.
+type savedSearchDataSourceModel struct {
+	Team          types.String `tfsdk:"team"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	SourceID      types.String `tfsdk:"source_id"`
+	Select        types.String `tfsdk:"select"`
+	Where         types.String `tfsdk:"where"`
+	WhereLanguage types.String `tfsdk:"where_language"`
+	OrderBy       types.String `tfsdk:"order_by"`
+	Tags          types.List   `tfsdk:"tags"`
+	Filters       types.String `tfsdk:"filters"`
+}
+
+func (d *savedSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_saved_search"
+}
+
+func (d *savedSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	id, name := idOrNameAttributes("saved search")
+	resp.Schema = schema.Schema{
+		Description: "Looks up a ClickStack saved search by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr:         teamLookupAttribute("saved search"),
+			idAttr:           id,
+			nameAttr:         name,
+			"source_id":      schema.StringAttribute{Computed: true, Description: "ID of the ClickStack source the saved search queries."},
+			"select":         schema.StringAttribute{Computed: true, Description: "Comma-separated column expressions selected."},
+			"where":          schema.StringAttribute{Computed: true, Description: "Row filter expression."},
+			"where_language": schema.StringAttribute{Computed: true, Description: "Language of `where`: `lucene` or `sql`."},
+			"order_by":       schema.StringAttribute{Computed: true, Description: "Order-by expression."},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Tags applied to the saved search.",
+			},
+			"filters": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pinned sidebar filters as a JSON array string, as returned by the API.",
+			},
+		},
+	}
+}
+
+func (d *savedSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *savedSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_saved_search", &resp.Diagnostics)
+	var config savedSearchDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searches, err := d.client.WithTeam(config.Team.ValueString()).ListSavedSearches(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Saved Searches", err.Error())
+		return
+	}
+	ss, err := findByIDOrName(searches, "saved search", config.ID.ValueString(), config.Name.ValueString(),
+		func(s client.SavedSearch) (string, string) { return s.ID, s.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Saved Search Not Found", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(ss.ID)
+	config.Name = types.StringValue(ss.Name)
+	config.SourceID = types.StringValue(ss.SourceID)
+	config.Select = types.StringValue(ss.Select)
+	config.Where = types.StringValue(ss.Where)
+	config.WhereLanguage = types.StringValue(ss.WhereLanguage)
+	config.OrderBy = types.StringValue(ss.OrderBy)
+	tags, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, ss.Tags...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Tags = tags
+	filters := "[]"
+	if len(ss.Filters) > 0 && string(ss.Filters) != "null" {
+		filters = string(ss.Filters)
+	}
+	config.Filters = types.StringValue(filters)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
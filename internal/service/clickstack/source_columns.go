@@ -0,0 +1,333 @@
+package clickstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sqlKeywords are the bare words that may appear in a source expression
+// without naming a column. Interval units are included so that
+// "INTERVAL 1 DAY" is not read as a reference to a DAY column, and so are the
+// words the special argument forms use, as in EXTRACT(DAY FROM ts),
+// TRIM(BOTH ' ' FROM s), CAST(x AS T) and window functions' OVER (PARTITION
+// BY ... ORDER BY ... ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW).
+var sqlKeywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "XOR": true, "AS": true, "IN": true,
+	"IS": true, "NULL": true, "TRUE": true, "FALSE": true, "LIKE": true,
+	"ILIKE": true, "ESCAPE": true, "BETWEEN": true, "CASE": true, "WHEN": true,
+	"THEN": true, "ELSE": true, "END": true, "ASC": true, "DESC": true,
+	"ASCENDING": true, "DESCENDING": true, "NULLS": true, "FIRST": true,
+	"LAST": true, "COLLATE": true, "DISTINCT": true, "ALL": true, "ANY": true,
+	"GLOBAL": true, "EXISTS": true, "DIV": true, "MOD": true,
+	"INTERVAL": true, "WITH": true, "FILL": true, "STEP": true, "TO": true,
+	"CAST": true, "EXTRACT": true, "FROM": true, "FOR": true,
+	"BOTH": true, "LEADING": true, "TRAILING": true,
+	"OVER": true, "PARTITION": true, "ORDER": true, "BY": true, "ROWS": true,
+	"RANGE": true, "UNBOUNDED": true, "PRECEDING": true, "FOLLOWING": true,
+	"CURRENT": true, "ROW": true,
+	"NANOSECOND": true, "MICROSECOND": true, "MILLISECOND": true,
+	"SECOND": true, "MINUTE": true, "HOUR": true, "DAY": true, "WEEK": true,
+	"MONTH": true, "QUARTER": true, "YEAR": true,
+}
+
+// expressionColumns returns the column names a ClickHouse SQL expression
+// refers to, in order of appearance. It is a scanner, not a parser: string
+// literals, numbers, keywords, function names (a word followed by "(") and
+// aliases introduced by AS are skipped; a dotted name such as Events.Name is
+// returned whole. ok is false for expressions it cannot judge — lambdas,
+// whose parameters look like columns, and unbalanced quotes.
+func expressionColumns(expr string) (cols []string, ok bool) {
+	if strings.Contains(expr, "->") {
+		return nil, false
+	}
+	aliases := map[string]bool{}
+	var refs []string
+	afterAS := false
+
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+		switch {
+		case ch == '\'':
+			end := closingQuote(expr, i)
+			if end < 0 {
+				return nil, false
+			}
+			i = end + 1
+			afterAS = false
+		case ch == '`' || ch == '"':
+			end := closingQuote(expr, i)
+			if end < 0 {
+				return nil, false
+			}
+			name := expr[i+1 : end]
+			i = end + 1
+			if afterAS {
+				aliases[name] = true
+			} else if !nextIs(expr, i, '(') {
+				refs = append(refs, name)
+			}
+			afterAS = false
+		case ch >= '0' && ch <= '9':
+			for i < len(expr) && (isWord(expr[i]) || expr[i] == '.') {
+				i++
+			}
+			afterAS = false
+		case isWord(ch):
+			start := i
+			for i < len(expr) && (isWord(expr[i]) || expr[i] == '.' && i+1 < len(expr) && isWord(expr[i+1])) {
+				i++
+			}
+			word := expr[start:i]
+			switch {
+			case afterAS:
+				aliases[word] = true
+				afterAS = false
+			case strings.EqualFold(word, "AS"):
+				afterAS = true
+			case sqlKeywords[strings.ToUpper(word)], nextIs(expr, i, '('):
+				// A keyword or a function name.
+			default:
+				refs = append(refs, word)
+			}
+		case ch == ':' && i+1 < len(expr) && expr[i+1] == ':':
+			i = skipType(expr, i+2)
+			afterAS = false
+		case ch == '{':
+			// A query parameter, {name:Type}.
+			end := strings.IndexByte(expr[i:], '}')
+			if end < 0 {
+				return nil, false
+			}
+			i += end + 1
+			afterAS = false
+		default:
+			i++
+			if ch != ' ' && ch != '\t' && ch != '\n' {
+				afterAS = false
+			}
+		}
+	}
+
+	for _, r := range refs {
+		if !aliases[r] {
+			cols = append(cols, r)
+		}
+	}
+	return cols, true
+}
+
+// isWord reports whether b can be part of an unquoted identifier.
+func isWord(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+// closingQuote returns the index of the quote that closes the one at start,
+// honoring backslash escapes and doubled quotes, or -1.
+func closingQuote(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case q:
+			if i+1 < len(s) && s[i+1] == q {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// skipType returns the index just past the type name that starts at or after
+// i, as in x::Nullable(String), including its parenthesized arguments.
+func skipType(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+		i++
+	}
+	for i < len(s) && isWord(s[i]) {
+		i++
+	}
+	if !nextIs(s, i, '(') {
+		return i
+	}
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// nextIs reports whether the first non-blank byte of s at or after i is b.
+func nextIs(s string, i int, b byte) bool {
+	for ; i < len(s); i++ {
+		if s[i] != ' ' && s[i] != '\t' && s[i] != '\n' {
+			return s[i] == b
+		}
+	}
+	return false
+}
+
+// hasColumn reports whether name, or the column a dotted name reaches into
+// (a Map, Tuple, JSON or Nested column), is in columns.
+func hasColumn(columns map[string]bool, name string) bool {
+	for {
+		if columns[name] {
+			return true
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return false
+		}
+		name = name[:i]
+	}
+}
+
+// columnExpression is one expression of a source and where it is configured.
+type columnExpression struct {
+	path path.Path
+	expr types.String
+}
+
+// columnExpressions lists the expressions of m that refer to columns of the
+// source table. Values a preset fills in are included under the attribute
+// they default.
+func (m *sourceResourceModel) columnExpressions() []columnExpression {
+	p := presetFor(m.Preset)
+	withPreset := func(v types.String, def *string) types.String {
+		if v.IsNull() && def != nil {
+			return types.StringValue(*def)
+		}
+		return v
+	}
+	timestamp := m.TimestampValueExpression
+	if timestamp.IsNull() && p.TimestampValueExpression != "" {
+		timestamp = types.StringValue(p.TimestampValueExpression)
+	}
+
+	exprs := []columnExpression{
+		{path.Root("timestamp_value_expression"), timestamp},
+		{path.Root("default_table_select_expression"), withPreset(m.DefaultTableSelectExpression, p.DefaultTableSelectExpression)},
+		{path.Root("service_name_expression"), withPreset(m.ServiceNameExpression, p.ServiceNameExpression)},
+		{path.Root("severity_text_expression"), withPreset(m.SeverityTextExpression, p.SeverityTextExpression)},
+		{path.Root("body_expression"), withPreset(m.BodyExpression, p.BodyExpression)},
+		{path.Root("event_attributes_expression"), withPreset(m.EventAttributesExpression, p.EventAttributesExpression)},
+		{path.Root("resource_attributes_expression"), withPreset(m.ResourceAttributesExpression, p.ResourceAttributesExpression)},
+		{path.Root("displayed_timestamp_value_expression"), withPreset(m.DisplayedTimestampValueExpression, p.DisplayedTimestampValueExpression)},
+		{path.Root("trace_id_expression"), withPreset(m.TraceIDExpression, p.TraceIDExpression)},
+		{path.Root("span_id_expression"), withPreset(m.SpanIDExpression, p.SpanIDExpression)},
+		{path.Root("implicit_column_expression"), withPreset(m.ImplicitColumnExpression, p.ImplicitColumnExpression)},
+		{path.Root("known_columns_list_expression"), m.KnownColumnsListExpression},
+		{path.Root("order_by_expression"), m.OrderByExpression},
+		{path.Root("duration_expression"), withPreset(m.DurationExpression, p.DurationExpression)},
+		{path.Root("parent_span_id_expression"), withPreset(m.ParentSpanIDExpression, p.ParentSpanIDExpression)},
+		{path.Root("span_name_expression"), withPreset(m.SpanNameExpression, p.SpanNameExpression)},
+		{path.Root("span_kind_expression"), withPreset(m.SpanKindExpression, p.SpanKindExpression)},
+		{path.Root("sample_rate_expression"), m.SampleRateExpression},
+		{path.Root("status_code_expression"), withPreset(m.StatusCodeExpression, p.StatusCodeExpression)},
+		{path.Root("status_message_expression"), withPreset(m.StatusMessageExpression, p.StatusMessageExpression)},
+		{path.Root("span_events_value_expression"), withPreset(m.SpanEventsValueExpression, p.SpanEventsValueExpression)},
+	}
+	for i, h := range m.HighlightedTraceAttributeExpressions {
+		exprs = append(exprs, columnExpression{
+			path.Root("highlighted_trace_attribute_expressions").AtListIndex(i).AtName("sql_expression"), h.SQLExpression,
+		})
+	}
+	for i, h := range m.HighlightedRowAttributeExpressions {
+		exprs = append(exprs, columnExpression{
+			path.Root("highlighted_row_attribute_expressions").AtListIndex(i).AtName("sql_expression"), h.SQLExpression,
+		})
+	}
+	return exprs
+}
+
+// columnCheckApplies reports whether m names a single table whose columns
+// can be checked: metric sources read several tables via metric_tables, and
+// the location must be known at plan time.
+func (m *sourceResourceModel) columnCheckApplies() bool {
+	return m.ValidateColumns.ValueBool() && m.From != nil && known(m.Connection) &&
+		known(m.From.DatabaseName) && known(m.From.TableName) && m.From.TableName.ValueString() != ""
+}
+
+// checkColumns checks every known expression of m against the columns of its
+// table and returns an attribute error for each reference to a column the
+// table does not have. An empty column list means the table does not exist
+// (yet: it may be created in the same apply), which is only a warning.
+func (m *sourceResourceModel) checkColumns(columns []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	table := m.From.DatabaseName.ValueString() + "." + m.From.TableName.ValueString()
+	if len(columns) == 0 {
+		diags.AddAttributeWarning(path.Root("from").AtName("table_name"), "Table not found",
+			fmt.Sprintf("Table %s does not exist or has no columns visible to connection %s, so the expressions were not checked. "+
+				"This is expected when the table is created in the same apply.", table, m.Connection.ValueString()))
+		return diags
+	}
+
+	set := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		set[c] = true
+	}
+	for _, e := range m.columnExpressions() {
+		if !known(e.expr) {
+			continue
+		}
+		refs, ok := expressionColumns(e.expr.ValueString())
+		if !ok {
+			continue
+		}
+		var missing []string
+		for _, r := range refs {
+			if !hasColumn(set, r) {
+				missing = append(missing, r)
+			}
+		}
+		if len(missing) > 0 {
+			diags.AddAttributeError(e.path, "Unknown column",
+				fmt.Sprintf("%q refers to %s, which table %s does not have.", e.expr.ValueString(), quoteList(missing), table))
+		}
+	}
+	return diags
+}
+
+// quoteList renders names as "a", "b" for messages.
+func quoteList(names []string) string {
+	q := make([]string, len(names))
+	for i, n := range names {
+		q[i] = fmt.Sprintf("%q", n)
+	}
+	return strings.Join(q, ", ")
+}
+
+// checkPlanColumns runs the opt-in check of validate_columns: it lists the
+// columns of the source table through the source's connection and reports
+// expressions that refer to columns the table lacks. A failure to list the
+// columns (e.g. ClickStack on ClickHouse Cloud, which has no ClickHouse
+// proxy) is only a warning, so an unreachable database never blocks a plan.
+func (r *sourceResource) checkPlanColumns(ctx context.Context, plan sourceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.columnCheckApplies() || r.client == nil {
+		return diags
+	}
+	columns, err := r.client.WithTeam(plan.Team.ValueString()).ListColumns(ctx,
+		plan.Connection.ValueString(), plan.From.DatabaseName.ValueString(), plan.From.TableName.ValueString())
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("validate_columns"), "Could not validate source columns",
+			"The columns of the source table could not be listed, so the expressions were not checked: "+err.Error())
+		return diags
+	}
+	return plan.checkColumns(columns)
+}
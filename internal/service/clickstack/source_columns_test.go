@@ -0,0 +1,96 @@
+package clickstack
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionColumns(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		expr   string
+		want   []string
+		wantOK bool
+	}{
+		{"Timestamp", []string{"Timestamp"}, true},
+		{"Timestamp, ServiceName, round(Duration / 1e6), SpanName", []string{"Timestamp", "ServiceName", "Duration", "SpanName"}, true},
+		{"LogAttributes['http.method']", []string{"LogAttributes"}, true},
+		{"Events.Timestamp", []string{"Events.Timestamp"}, true},
+		{"toStartOfDay(TimestampTime) AS day, day", []string{"TimestampTime"}, true},
+		{"`Service Name`, \"Body\"", []string{"Service Name", "Body"}, true},
+		{"if(StatusCode = 'Error' AND NOT isNull(Body), 1, 0)", []string{"StatusCode", "Body"}, true},
+		{"Timestamp - INTERVAL 1 DAY", []string{"Timestamp"}, true},
+		{"SampleRate::Nullable(UInt64)", []string{"SampleRate"}, true},
+		{"Timestamp DESC", []string{"Timestamp"}, true},
+		{"EXTRACT(DAY FROM Timestamp)", []string{"Timestamp"}, true},
+		{"trim(BOTH ' ' FROM Body)", []string{"Body"}, true},
+		{"toStartOfInterval(Timestamp, INTERVAL 15 MILLISECOND)", []string{"Timestamp"}, true},
+		{"count() OVER (PARTITION BY ServiceName ORDER BY Timestamp ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)", []string{"ServiceName", "Timestamp"}, true},
+		{"it's", nil, false},
+		{"arrayMap(x -> x.1, Events)", nil, false},
+	}
+	for _, tc := range cases {
+		got, ok := expressionColumns(tc.expr)
+		if ok != tc.wantOK || !slices.Equal(got, tc.want) {
+			t.Errorf("expressionColumns(%q) = %q, %v; want %q, %v", tc.expr, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestSourceCheckColumns(t *testing.T) {
+	t.Parallel()
+	m := presetSource(sourcePresetLogs, "log")
+	m.ValidateColumns = types.BoolValue(true)
+	m.BodyExpression = types.StringValue("Message")
+	m.HighlightedRowAttributeExpressions = []highlightedAttrModel{{SQLExpression: types.StringValue("LogAttributes['user']")}}
+
+	columns := []string{"Timestamp", "TimestampTime", "ServiceName", "SeverityText", "Body",
+		"LogAttributes", "ResourceAttributes", "TraceId", "SpanId"}
+	diags := m.checkColumns(columns)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("want one error, got %s", diags)
+	}
+	if d, ok := diags[0].(interface{ Path() path.Path }); !ok || !d.Path().Equal(path.Root("body_expression")) {
+		t.Errorf("error is not on body_expression: %s", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), `"Message"`) {
+		t.Errorf("detail does not name the column: %s", diags[0].Detail())
+	}
+
+	if diags := m.checkColumns(nil); diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Table not found" {
+		t.Errorf("want a table-not-found warning, got %s", diags)
+	}
+}
+
+func TestSourceCheckPlanColumns(t *testing.T) {
+	t.Parallel()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-hyperdx-connection-id") != "c1" {
+			t.Errorf("connection header = %q", r.Header.Get("x-hyperdx-connection-id"))
+		}
+		http.Error(w, "Code: 60. DB::Exception: Unknown table", http.StatusInternalServerError)
+	})
+	r := &sourceResource{client: dashboardTestClient(t, handler)}
+
+	m := presetSource(sourcePresetLogs, "log")
+	if diags := r.checkPlanColumns(context.Background(), m); len(diags) != 0 {
+		t.Errorf("check must be opt-in, got %s", diags)
+	}
+
+	m.ValidateColumns = types.BoolValue(true)
+	diags := r.checkPlanColumns(context.Background(), m)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("a listing failure must only warn, got %s", diags)
+	}
+
+	m.From.TableName = types.StringUnknown()
+	if diags := r.checkPlanColumns(context.Background(), m); len(diags) != 0 {
+		t.Errorf("an unknown table must skip the check, got %s", diags)
+	}
+}
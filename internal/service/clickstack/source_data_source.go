@@ -0,0 +1,249 @@
+package clickstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ datasource.DataSource              = (*sourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*sourceDataSource)(nil)
+	_ datasource.DataSource              = (*sourcesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*sourcesDataSource)(nil)
+)
+
+// NewSourceDataSource is a helper to register the data source with the provider.
+func NewSourceDataSource() datasource.DataSource {
+	return &sourceDataSource{}
+}
+
+// NewSourcesDataSource is a helper to register the data source with the provider.
+func NewSourcesDataSource() datasource.DataSource {
+	return &sourcesDataSource{}
+}
+
+// sourceDataSource looks up a single ClickStack source by ID or name.
+type sourceDataSource struct {
+	client *client.Client
+}
+
+// sourcesDataSource lists the ClickStack sources of a team.
+type sourcesDataSource struct {
+	client *client.Client
+}
+
+// sourceDataSourceModel maps the clickstack_source data source schema data.
+// It exposes what other objects reference a source by: its location and the
+// sources it is correlated with. Kind-specific expressions stay on the
+// resource.
+type sourceDataSourceModel struct {
+	Team                     types.String       `tfsdk:"team"`
+	ID                       types.String       `tfsdk:"id"`
+	Name                     types.String       `tfsdk:"name"`
+	Kind                     types.String       `tfsdk:"kind"`
+	Connection               types.String       `tfsdk:"connection_id"`
+	From                     *sourceFromModel   `tfsdk:"from"`
+	Disabled                 types.Bool         `tfsdk:"disabled"`
+	TimestampValueExpression types.String       `tfsdk:"timestamp_value_expression"`
+	MetricSourceID           types.String       `tfsdk:"metric_source_id"`
+	TraceSourceID            types.String       `tfsdk:"trace_source_id"`
+	LogSourceID              types.String       `tfsdk:"log_source_id"`
+	SessionSourceID          types.String       `tfsdk:"session_source_id"`
+	MetricTables             *metricTablesModel `tfsdk:"metric_tables"`
+}
+
+// sourceSummaryModel is one entry of the clickstack_sources list.
+type sourceSummaryModel struct {
+	ID         types.String     `tfsdk:"id"`
+	Name       types.String     `tfsdk:"name"`
+	Kind       types.String     `tfsdk:"kind"`
+	Connection types.String     `tfsdk:"connection_id"`
+	From       *sourceFromModel `tfsdk:"from"`
+}
+
+// sourcesDataSourceModel maps the clickstack_sources data source schema data.
+type sourcesDataSourceModel struct {
+	Team    types.String         `tfsdk:"team"`
+	Kind    types.String         `tfsdk:"kind"`
+	Sources []sourceSummaryModel `tfsdk:"sources"`
+}
+
+// sourceFromAttribute is the computed from object shared by both data sources.
+func sourceFromAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Database and table the source reads from. `table_name` is empty for metric sources, which use `metric_tables`.",
+		Attributes: map[string]schema.Attribute{
+			"database_name": schema.StringAttribute{Computed: true, Description: "ClickHouse database name."},
+			"table_name":    schema.StringAttribute{Computed: true, Description: "ClickHouse table name."},
+		},
+	}
+}
+
+func (d *sourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_source"
+}
+
+func (d *sourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	id, name := idOrNameAttributes("source")
+	computedStr := func(desc string) schema.StringAttribute {
+		return schema.StringAttribute{Computed: true, Description: desc}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Looks up a ClickStack source by ID or name, for referencing a source managed in " +
+			"another configuration or created in the UI.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr:                     teamLookupAttribute("source"),
+			idAttr:                       id,
+			nameAttr:                     name,
+			"kind":                       computedStr("Source kind: `log`, `trace`, `metric`, `session` or `promql`."),
+			"connection_id":              computedStr("ID of the connection the source queries through."),
+			"from":                       sourceFromAttribute(),
+			"disabled":                   schema.BoolAttribute{Computed: true, Description: "Whether the source is disabled."},
+			"timestamp_value_expression": computedStr("DateTime column or expression that is part of the table's primary key."),
+			"metric_source_id":           computedStr("Correlated metric source ID."),
+			"trace_source_id":            computedStr("Correlated trace source ID."),
+			"log_source_id":              computedStr("Correlated log source ID."),
+			"session_source_id":          computedStr("Correlated session source ID."),
+			"metric_tables": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Table names per metric data type. Set for `metric` sources only.",
+				Attributes: map[string]schema.Attribute{
+					"gauge":                 computedStr("Gauge metrics table."),
+					"histogram":             computedStr("Histogram metrics table."),
+					"sum":                   computedStr("Sum metrics table."),
+					"summary":               computedStr("Summary metrics table."),
+					"exponential_histogram": computedStr("Exponential histogram metrics table."),
+				},
+			},
+		},
+	}
+}
+
+func (d *sourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *sourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_source", &resp.Diagnostics)
+	var config sourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := d.client.WithTeam(config.Team.ValueString()).ListSources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sources", err.Error())
+		return
+	}
+	src, err := findByIDOrName(sources, "source", config.ID.ValueString(), config.Name.ValueString(),
+		func(s client.Source) (string, string) { return s.ID, s.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Source Not Found", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(src.ID)
+	config.Name = types.StringValue(src.Name)
+	config.Kind = types.StringValue(src.Kind)
+	config.Connection = types.StringValue(src.Connection)
+	config.From = &sourceFromModel{
+		DatabaseName: types.StringValue(src.From.DatabaseName),
+		TableName:    types.StringValue(src.From.TableName),
+	}
+	config.Disabled = types.BoolValue(src.Disabled != nil && *src.Disabled)
+	config.TimestampValueExpression = types.StringValue(src.TimestampValueExpression)
+	config.MetricSourceID = emptyPtrToNull(src.MetricSourceID)
+	config.TraceSourceID = emptyPtrToNull(src.TraceSourceID)
+	config.LogSourceID = emptyPtrToNull(src.LogSourceID)
+	config.SessionSourceID = emptyPtrToNull(src.SessionSourceID)
+	config.MetricTables = nil
+	if mt := src.MetricTables; mt != nil {
+		config.MetricTables = &metricTablesModel{
+			Gauge:                emptyPtrToNull(mt.Gauge),
+			Histogram:            emptyPtrToNull(mt.Histogram),
+			Sum:                  emptyPtrToNull(mt.Sum),
+			Summary:              emptyPtrToNull(mt.Summary),
+			ExponentialHistogram: emptyPtrToNull(mt.ExponentialHistogram),
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *sourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_sources"
+}
+
+func (d *sourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the ClickStack sources of a team, optionally filtered by kind.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: schema.StringAttribute{
+				Optional:    true,
+				Description: "Team ID to list sources for, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+			},
+			"kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list sources of this kind: `log`, `trace`, `metric`, `session` or `promql`.",
+			},
+			"sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The sources, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						idAttr:          schema.StringAttribute{Computed: true, Description: "Identifier of the source."},
+						nameAttr:        schema.StringAttribute{Computed: true, Description: "Name of the source."},
+						"kind":          schema.StringAttribute{Computed: true, Description: "Source kind."},
+						"connection_id": schema.StringAttribute{Computed: true, Description: "ID of the connection the source queries through."},
+						"from":          sourceFromAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *sourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_sources", &resp.Diagnostics)
+	var config sourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := d.client.WithTeam(config.Team.ValueString()).ListSources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Sources", err.Error())
+		return
+	}
+
+	config.Sources = []sourceSummaryModel{}
+	for _, src := range sources {
+		if !config.Kind.IsNull() && src.Kind != config.Kind.ValueString() {
+			continue
+		}
+		config.Sources = append(config.Sources, sourceSummaryModel{
+			ID:         types.StringValue(src.ID),
+			Name:       types.StringValue(src.Name),
+			Kind:       types.StringValue(src.Kind),
+			Connection: types.StringValue(src.Connection),
+			From: &sourceFromModel{
+				DatabaseName: types.StringValue(src.From.DatabaseName),
+				TableName:    types.StringValue(src.From.TableName),
+			},
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,236 @@
+package clickstack
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+// Source presets fill in the expressions of a source that reads the tables
+// written by the default OpenTelemetry collector exporter (the ClickHouse
+// exporter's default schema, as used by the ClickStack distribution). The
+// values match what the HyperDX UI proposes when it detects those tables.
+const (
+	sourcePresetLogs     = "otel_logs"
+	sourcePresetTraces   = "otel_traces"
+	sourcePresetMetrics  = "otel_metrics"
+	sourcePresetSessions = "otel_sessions"
+)
+
+// Default table names of the OTel collector schema, used by the source_set
+// resource. Metric tables are in the otel_metrics preset itself.
+const (
+	otelLogsTable     = "otel_logs"
+	otelTracesTable   = "otel_traces"
+	otelSessionsTable = "hyperdx_sessions"
+)
+
+func strp(s string) *string { return &s }
+
+// sourcePresets maps a preset name to the source fields it defaults. Only
+// Kind, TimestampValueExpression, the optional expressions, DurationPrecision
+// and MetricTables are read from these values.
+var sourcePresets = map[string]client.Source{
+	sourcePresetLogs: {
+		Kind:                              "log",
+		TimestampValueExpression:          "TimestampTime",
+		DefaultTableSelectExpression:      strp("Timestamp, ServiceName, SeverityText, Body"),
+		ServiceNameExpression:             strp("ServiceName"),
+		SeverityTextExpression:            strp("SeverityText"),
+		BodyExpression:                    strp("Body"),
+		EventAttributesExpression:         strp("LogAttributes"),
+		ResourceAttributesExpression:      strp("ResourceAttributes"),
+		DisplayedTimestampValueExpression: strp("Timestamp"),
+		TraceIDExpression:                 strp("TraceId"),
+		SpanIDExpression:                  strp("SpanId"),
+		ImplicitColumnExpression:          strp("Body"),
+	},
+	sourcePresetTraces: {
+		Kind:                         "trace",
+		TimestampValueExpression:     "Timestamp",
+		DefaultTableSelectExpression: strp("Timestamp, ServiceName, StatusCode, round(Duration / 1e6), SpanName"),
+		ServiceNameExpression:        strp("ServiceName"),
+		EventAttributesExpression:    strp("SpanAttributes"),
+		ResourceAttributesExpression: strp("ResourceAttributes"),
+		TraceIDExpression:            strp("TraceId"),
+		SpanIDExpression:             strp("SpanId"),
+		ImplicitColumnExpression:     strp("SpanName"),
+		DurationExpression:           strp("Duration"),
+		DurationPrecision:            func() *int { p := 9; return &p }(),
+		ParentSpanIDExpression:       strp("ParentSpanId"),
+		SpanNameExpression:           strp("SpanName"),
+		SpanKindExpression:           strp("SpanKind"),
+		StatusCodeExpression:         strp("StatusCode"),
+		StatusMessageExpression:      strp("StatusMessage"),
+		SpanEventsValueExpression:    strp("Events"),
+	},
+	sourcePresetMetrics: {
+		Kind:                         "metric",
+		TimestampValueExpression:     "TimeUnix",
+		ServiceNameExpression:        strp("ServiceName"),
+		ResourceAttributesExpression: strp("ResourceAttributes"),
+		MetricTables: &client.MetricTables{
+			Gauge:                strp("otel_metrics_gauge"),
+			Histogram:            strp("otel_metrics_histogram"),
+			Sum:                  strp("otel_metrics_sum"),
+			Summary:              strp("otel_metrics_summary"),
+			ExponentialHistogram: strp("otel_metrics_exponential_histogram"),
+		},
+	},
+	sourcePresetSessions: {
+		Kind:                              "session",
+		TimestampValueExpression:          "TimestampTime",
+		DefaultTableSelectExpression:      strp("Timestamp, ServiceName, Body"),
+		ServiceNameExpression:             strp("ServiceName"),
+		SeverityTextExpression:            strp("SeverityText"),
+		BodyExpression:                    strp("Body"),
+		EventAttributesExpression:         strp("LogAttributes"),
+		ResourceAttributesExpression:      strp("ResourceAttributes"),
+		DisplayedTimestampValueExpression: strp("Timestamp"),
+		TraceIDExpression:                 strp("TraceId"),
+		SpanIDExpression:                  strp("SpanId"),
+		ImplicitColumnExpression:          strp("Body"),
+	},
+}
+
+// sourcePresetNames lists the presets in a stable order for messages and docs.
+func sourcePresetNames() []string {
+	return slices.Sorted(maps.Keys(sourcePresets))
+}
+
+// presetFor returns the preset named by v, or the zero Source when v is null,
+// unknown or not a preset. The zero Source defaults nothing, so callers can
+// use the result unconditionally.
+func presetFor(v types.String) client.Source {
+	if !known(v) {
+		return client.Source{}
+	}
+	return sourcePresets[v.ValueString()]
+}
+
+// validatePreset checks preset against the rest of the config: the preset
+// must exist and match kind, and without one timestamp_value_expression is
+// required.
+func (m *sourceResourceModel) validatePreset() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.Preset.IsNull() {
+		if m.TimestampValueExpression.IsNull() {
+			diags.AddAttributeError(path.Root("timestamp_value_expression"), "Missing timestamp_value_expression",
+				"timestamp_value_expression is required unless a preset is set.")
+		}
+		return diags
+	}
+	if !known(m.Preset) {
+		return diags
+	}
+	p, ok := sourcePresets[m.Preset.ValueString()]
+	if !ok {
+		diags.AddAttributeError(path.Root("preset"), "Invalid preset",
+			fmt.Sprintf("preset must be one of %s, got %q", strings.Join(sourcePresetNames(), ", "), m.Preset.ValueString()))
+		return diags
+	}
+	if known(m.Kind) && m.Kind.ValueString() != p.Kind {
+		diags.AddAttributeError(path.Root("kind"), "Kind does not match preset",
+			fmt.Sprintf("preset %q is for %s sources, but kind is %q.", m.Preset.ValueString(), p.Kind, m.Kind.ValueString()))
+	}
+	return diags
+}
+
+// applyPreset fills every field of src the config left unset with the
+// preset's value. Fields the config sets, including to "", are kept.
+func applyPreset(src *client.Source, p client.Source) {
+	if src.TimestampValueExpression == "" {
+		src.TimestampValueExpression = p.TimestampValueExpression
+	}
+	for _, f := range presetStringFields(src, &p) {
+		if *f.dst == nil {
+			*f.dst = f.def
+		}
+	}
+	if src.DurationPrecision == nil {
+		src.DurationPrecision = p.DurationPrecision
+	}
+	if src.MetricTables == nil && p.MetricTables != nil {
+		mt := *p.MetricTables
+		src.MetricTables = &mt
+	}
+}
+
+// presetDurationPrecision plans duration_precision for an update. The
+// attribute keeps its prior value when the config leaves it unset, so a new
+// preset's value would never be applied: when the preset changes it is
+// planned instead, or left unknown for the server default when the preset has
+// none.
+func presetDurationPrecision(configured, planned types.Int64, preset, priorPreset types.String) types.Int64 {
+	if !configured.IsNull() || preset.Equal(priorPreset) {
+		return planned
+	}
+	if p := presetFor(preset).DurationPrecision; p != nil {
+		return types.Int64Value(int64(*p))
+	}
+	return types.Int64Unknown()
+}
+
+// presetField pairs an optional source field with the preset's default for it.
+type presetField struct {
+	dst **string
+	def *string
+}
+
+// presetStringFields lists the optional expression fields a preset can set.
+// Correlated source IDs are not among them: they depend on the team.
+func presetStringFields(src, p *client.Source) []presetField {
+	return []presetField{
+		{&src.DefaultTableSelectExpression, p.DefaultTableSelectExpression},
+		{&src.ServiceNameExpression, p.ServiceNameExpression},
+		{&src.SeverityTextExpression, p.SeverityTextExpression},
+		{&src.BodyExpression, p.BodyExpression},
+		{&src.EventAttributesExpression, p.EventAttributesExpression},
+		{&src.ResourceAttributesExpression, p.ResourceAttributesExpression},
+		{&src.DisplayedTimestampValueExpression, p.DisplayedTimestampValueExpression},
+		{&src.TraceIDExpression, p.TraceIDExpression},
+		{&src.SpanIDExpression, p.SpanIDExpression},
+		{&src.ImplicitColumnExpression, p.ImplicitColumnExpression},
+		{&src.DurationExpression, p.DurationExpression},
+		{&src.ParentSpanIDExpression, p.ParentSpanIDExpression},
+		{&src.SpanNameExpression, p.SpanNameExpression},
+		{&src.SpanKindExpression, p.SpanKindExpression},
+		{&src.StatusCodeExpression, p.StatusCodeExpression},
+		{&src.StatusMessageExpression, p.StatusMessageExpression},
+		{&src.SpanEventsValueExpression, p.SpanEventsValueExpression},
+	}
+}
+
+// keepPreset is keepUnset for a field a preset may have filled in: an unset
+// attribute also stays null when the server returns the preset's value, so a
+// preset-backed source plans clean. A server value that differs from the
+// preset shows up as drift and is put back on the next apply.
+func keepPreset(cur types.String, v, def *string) types.String {
+	if cur.IsNull() && v != nil && def != nil && *v == *def {
+		return cur
+	}
+	return keepUnset(cur, v)
+}
+
+// metricTablesEqual reports whether two metric table mappings name the same
+// tables.
+func metricTablesEqual(a, b *client.MetricTables) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	eq := func(x, y *string) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return *x == *y
+	}
+	return eq(a.Gauge, b.Gauge) && eq(a.Histogram, b.Histogram) && eq(a.Sum, b.Sum) &&
+		eq(a.Summary, b.Summary) && eq(a.ExponentialHistogram, b.ExponentialHistogram)
+}
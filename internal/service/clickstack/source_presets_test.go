@@ -0,0 +1,133 @@
+package clickstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// presetSource builds a preset-backed source model that sets only what a
+// minimal configuration would.
+func presetSource(preset, kind string) sourceResourceModel {
+	return sourceResourceModel{
+		Name:       types.StringValue("Logs"),
+		Kind:       types.StringValue(kind),
+		Preset:     types.StringValue(preset),
+		Connection: types.StringValue("c1"),
+		From:       &sourceFromModel{DatabaseName: types.StringValue("default"), TableName: types.StringValue("otel_logs")},
+		// duration_precision is Computed, so it is unknown on create.
+		DurationPrecision: types.Int64Unknown(),
+	}
+}
+
+func TestSourcePreset_ToClientFillsDefaults(t *testing.T) {
+	t.Parallel()
+	m := presetSource(sourcePresetTraces, "trace")
+	m.SpanNameExpression = types.StringValue("Name")
+	m.StatusMessageExpression = types.StringValue("")
+
+	src := m.toClient()
+	if src.TimestampValueExpression != "Timestamp" {
+		t.Errorf("timestamp = %q, want the preset's Timestamp", src.TimestampValueExpression)
+	}
+	if src.DurationExpression == nil || *src.DurationExpression != "Duration" {
+		t.Errorf("duration_expression = %v, want the preset's Duration", src.DurationExpression)
+	}
+	if src.DurationPrecision == nil || *src.DurationPrecision != 9 {
+		t.Errorf("duration_precision = %v, want 9", src.DurationPrecision)
+	}
+	if *src.SpanNameExpression != "Name" {
+		t.Errorf("span_name_expression = %q, want the configured override", *src.SpanNameExpression)
+	}
+	if *src.StatusMessageExpression != "" {
+		t.Errorf("status_message_expression = %q, an explicit empty value must be kept", *src.StatusMessageExpression)
+	}
+}
+
+func TestSourcePreset_ApplySourceKeepsPresetFieldsNull(t *testing.T) {
+	t.Parallel()
+	m := presetSource(sourcePresetMetrics, "metric")
+	m.From.TableName = types.StringNull()
+	src := m.toClient()
+	src.ID = "s1"
+
+	m.applySource(&src)
+	if !m.TimestampValueExpression.IsNull() || !m.ServiceNameExpression.IsNull() || m.MetricTables != nil {
+		t.Errorf("preset values must stay null in state: timestamp=%s service=%s metric_tables=%+v",
+			m.TimestampValueExpression, m.ServiceNameExpression, m.MetricTables)
+	}
+
+	// A server-side change away from the preset is drift.
+	src.ServiceNameExpression = strp("Service")
+	m.applySource(&src)
+	if m.ServiceNameExpression.ValueString() != "Service" {
+		t.Errorf("service_name_expression = %s, want the drifted server value", m.ServiceNameExpression)
+	}
+}
+
+func TestSourcePreset_Validate(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		mod     func(*sourceResourceModel)
+		wantErr bool
+	}{
+		{"preset matches kind", nil, false},
+		{"unknown preset", func(m *sourceResourceModel) { m.Preset = types.StringValue("otel_profiles") }, true},
+		{"kind mismatch", func(m *sourceResourceModel) { m.Kind = types.StringValue("trace") }, true},
+		{"no preset needs a timestamp", func(m *sourceResourceModel) { m.Preset = types.StringNull() }, true},
+		{
+			"no preset with a timestamp",
+			func(m *sourceResourceModel) {
+				m.Preset = types.StringNull()
+				m.TimestampValueExpression = types.StringValue("Timestamp")
+			},
+			false,
+		},
+		{"unknown preset value passes", func(m *sourceResourceModel) { m.Preset = types.StringUnknown() }, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := presetSource(sourcePresetLogs, "log")
+			if tc.mod != nil {
+				tc.mod(&m)
+			}
+			if diags := m.validatePreset(); diags.HasError() != tc.wantErr {
+				t.Fatalf("HasError()=%v, want %v: %s", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestSourcePreset_DurationPrecisionOnPresetChange(t *testing.T) {
+	t.Parallel()
+	logs, traces := types.StringValue(sourcePresetLogs), types.StringValue(sourcePresetTraces)
+	cases := []struct {
+		name          string
+		configured    types.Int64
+		preset, prior types.String
+		want          types.Int64
+	}{
+		{"preset unchanged keeps the prior value", types.Int64Null(), traces, traces, types.Int64Value(3)},
+		{"switch to otel_traces applies its precision", types.Int64Null(), traces, logs, types.Int64Value(9)},
+		{"preset added applies its precision", types.Int64Null(), traces, types.StringNull(), types.Int64Value(9)},
+		{"preset without a precision leaves the server default", types.Int64Null(), logs, traces, types.Int64Unknown()},
+		{"preset removed leaves the server default", types.Int64Null(), types.StringNull(), traces, types.Int64Unknown()},
+		{"configured value wins", types.Int64Value(6), traces, logs, types.Int64Value(6)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			// The planned value is the configured one, or else the prior 3
+			// carried over by UseStateForUnknown.
+			planned := types.Int64Value(3)
+			if !c.configured.IsNull() {
+				planned = c.configured
+			}
+			if got := presetDurationPrecision(c.configured, planned, c.preset, c.prior); !got.Equal(c.want) {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
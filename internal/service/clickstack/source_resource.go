@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = (*sourceResource)(nil)
 	_ resource.ResourceWithConfigure   = (*sourceResource)(nil)
 	_ resource.ResourceWithImportState = (*sourceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*sourceResource)(nil)
 )
 
 // NewSourceResource is a helper to register the resource with the provider.
@@ -93,11 +94,14 @@ type sourceResourceModel struct {
 	Team       types.String     `tfsdk:"team"`
 	Name       types.String     `tfsdk:"name"`
 	Kind       types.String     `tfsdk:"kind"`
+	Preset     types.String     `tfsdk:"preset"`
 	Connection types.String     `tfsdk:"connection_id"`
 	From       *sourceFromModel `tfsdk:"from"`
 	Section    types.String     `tfsdk:"section"`
 	Disabled   types.Bool       `tfsdk:"disabled"`
 
+	ValidateColumns types.Bool `tfsdk:"validate_columns"`
+
 	QuerySettings            []querySettingModel `tfsdk:"query_settings"`
 	TimestampValueExpression types.String        `tfsdk:"timestamp_value_expression"`
 
@@ -176,6 +180,14 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 				Description: "Source kind: one of `log`, `trace`, `metric`, `session`, `promql`.",
 			},
+			"preset": schema.StringAttribute{
+				Optional: true,
+				Description: "Fills in the expressions and metric tables for a table written by the " +
+					"default OpenTelemetry collector schema: one of `otel_logs`, `otel_metrics`, " +
+					"`otel_sessions`, `otel_traces`. Must match `kind`. Any attribute set in the " +
+					"configuration overrides the preset's value; set it to `\"\"` to send an empty value " +
+					"instead. Attributes left to the preset stay null in state.",
+			},
 			"connection_id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the ClickHouse connection used by this source.",
@@ -196,8 +208,8 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"timestamp_value_expression": schema.StringAttribute{
-				Required:    true,
-				Description: "DateTime column or expression that is part of the table's primary key.",
+				Optional:    true,
+				Description: "DateTime column or expression that is part of the table's primary key. Required unless `preset` is set.",
 			},
 			"section": optStr("Optional grouping label used to organize sources in the source selector."),
 			"disabled": schema.BoolAttribute{
@@ -206,6 +218,15 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description:   "When true, the source is hidden from source selectors in the UI. Defaults to false.",
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
+			"validate_columns": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, every plan lists the columns of `from` through the source's " +
+					"connection and fails if an expression refers to a column the table does not have. " +
+					"A table that does not exist yet only gets a warning. Lambdas are not checked, and " +
+					"`metric` sources are skipped. Requires the ClickHouse proxy " +
+					"of a self-hosted ClickStack API; if the columns cannot be listed (e.g. ClickStack on " +
+					"ClickHouse Cloud), the plan only gets a warning.",
+			},
 			"query_settings": schema.ListNestedAttribute{
 				Optional:    true,
 				Description: "Optional ClickHouse query settings applied when querying this source.",
@@ -342,8 +363,41 @@ func (r *sourceResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = providerData.ClickStack
 }
 
-func (r *sourceResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+func (r *sourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	utils.BetaWarning("clickhouse_clickstack_source", &resp.Diagnostics)
+	var config sourceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(config.validatePreset()...)
+}
+
+// ModifyPlan plans the new preset's duration_precision when the preset
+// changes, and checks the planned expressions against the source table when
+// validate_columns is set.
+func (r *sourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan sourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state, config sourceResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		precision := presetDurationPrecision(config.DurationPrecision, plan.DurationPrecision, plan.Preset, state.Preset)
+		if !precision.Equal(plan.DurationPrecision) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("duration_precision"), precision)...)
+		}
+	}
+	resp.Diagnostics.Append(r.checkPlanColumns(ctx, plan)...)
 }
 
 func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -565,6 +619,7 @@ func (m *sourceResourceModel) toClient() client.Source {
 		}
 	}
 
+	applyPreset(&src, presetFor(m.Preset))
 	return src
 }
 
@@ -589,7 +644,10 @@ func (m *sourceResourceModel) applySource(src *client.Source) {
 	m.Name = types.StringValue(src.Name)
 	m.Kind = types.StringValue(src.Kind)
 	m.Connection = types.StringValue(src.Connection)
-	m.TimestampValueExpression = types.StringValue(src.TimestampValueExpression)
+	preset := presetFor(m.Preset)
+	if !m.TimestampValueExpression.IsNull() || src.TimestampValueExpression != preset.TimestampValueExpression {
+		m.TimestampValueExpression = types.StringValue(src.TimestampValueExpression)
+	}
 	m.Section = keepUnset(m.Section, src.Section)
 	m.Disabled = types.BoolPointerValue(src.Disabled)
 
@@ -604,37 +662,37 @@ func (m *sourceResourceModel) applySource(src *client.Source) {
 		TableName: keepUnset(curFrom.TableName, &src.From.TableName),
 	}
 
-	m.DefaultTableSelectExpression = keepUnset(m.DefaultTableSelectExpression, src.DefaultTableSelectExpression)
-	m.ServiceNameExpression = keepUnset(m.ServiceNameExpression, src.ServiceNameExpression)
-	m.SeverityTextExpression = keepUnset(m.SeverityTextExpression, src.SeverityTextExpression)
-	m.BodyExpression = keepUnset(m.BodyExpression, src.BodyExpression)
-	m.EventAttributesExpression = keepUnset(m.EventAttributesExpression, src.EventAttributesExpression)
-	m.ResourceAttributesExpression = keepUnset(m.ResourceAttributesExpression, src.ResourceAttributesExpression)
-	m.DisplayedTimestampValueExpression = keepUnset(m.DisplayedTimestampValueExpression, src.DisplayedTimestampValueExpression)
+	m.DefaultTableSelectExpression = keepPreset(m.DefaultTableSelectExpression, src.DefaultTableSelectExpression, preset.DefaultTableSelectExpression)
+	m.ServiceNameExpression = keepPreset(m.ServiceNameExpression, src.ServiceNameExpression, preset.ServiceNameExpression)
+	m.SeverityTextExpression = keepPreset(m.SeverityTextExpression, src.SeverityTextExpression, preset.SeverityTextExpression)
+	m.BodyExpression = keepPreset(m.BodyExpression, src.BodyExpression, preset.BodyExpression)
+	m.EventAttributesExpression = keepPreset(m.EventAttributesExpression, src.EventAttributesExpression, preset.EventAttributesExpression)
+	m.ResourceAttributesExpression = keepPreset(m.ResourceAttributesExpression, src.ResourceAttributesExpression, preset.ResourceAttributesExpression)
+	m.DisplayedTimestampValueExpression = keepPreset(m.DisplayedTimestampValueExpression, src.DisplayedTimestampValueExpression, preset.DisplayedTimestampValueExpression)
 	m.MetricSourceID = keepUnset(m.MetricSourceID, src.MetricSourceID)
 	m.TraceSourceID = keepUnset(m.TraceSourceID, src.TraceSourceID)
 	m.LogSourceID = keepUnset(m.LogSourceID, src.LogSourceID)
 	m.SessionSourceID = keepUnset(m.SessionSourceID, src.SessionSourceID)
-	m.TraceIDExpression = keepUnset(m.TraceIDExpression, src.TraceIDExpression)
-	m.SpanIDExpression = keepUnset(m.SpanIDExpression, src.SpanIDExpression)
-	m.ImplicitColumnExpression = keepUnset(m.ImplicitColumnExpression, src.ImplicitColumnExpression)
+	m.TraceIDExpression = keepPreset(m.TraceIDExpression, src.TraceIDExpression, preset.TraceIDExpression)
+	m.SpanIDExpression = keepPreset(m.SpanIDExpression, src.SpanIDExpression, preset.SpanIDExpression)
+	m.ImplicitColumnExpression = keepPreset(m.ImplicitColumnExpression, src.ImplicitColumnExpression, preset.ImplicitColumnExpression)
 	m.KnownColumnsListExpression = keepUnset(m.KnownColumnsListExpression, src.KnownColumnsListExpression)
 	m.OrderByExpression = keepUnset(m.OrderByExpression, src.OrderByExpression)
 	m.UseTextIndexForImplicitColumn = keepUnset(m.UseTextIndexForImplicitColumn, src.UseTextIndexForImplicitColumn)
 
-	m.DurationExpression = keepUnset(m.DurationExpression, src.DurationExpression)
+	m.DurationExpression = keepPreset(m.DurationExpression, src.DurationExpression, preset.DurationExpression)
 	if src.DurationPrecision != nil {
 		m.DurationPrecision = types.Int64Value(int64(*src.DurationPrecision))
 	} else {
 		m.DurationPrecision = types.Int64Null()
 	}
-	m.ParentSpanIDExpression = keepUnset(m.ParentSpanIDExpression, src.ParentSpanIDExpression)
-	m.SpanNameExpression = keepUnset(m.SpanNameExpression, src.SpanNameExpression)
-	m.SpanKindExpression = keepUnset(m.SpanKindExpression, src.SpanKindExpression)
+	m.ParentSpanIDExpression = keepPreset(m.ParentSpanIDExpression, src.ParentSpanIDExpression, preset.ParentSpanIDExpression)
+	m.SpanNameExpression = keepPreset(m.SpanNameExpression, src.SpanNameExpression, preset.SpanNameExpression)
+	m.SpanKindExpression = keepPreset(m.SpanKindExpression, src.SpanKindExpression, preset.SpanKindExpression)
 	m.SampleRateExpression = keepUnset(m.SampleRateExpression, src.SampleRateExpression)
-	m.StatusCodeExpression = keepUnset(m.StatusCodeExpression, src.StatusCodeExpression)
-	m.StatusMessageExpression = keepUnset(m.StatusMessageExpression, src.StatusMessageExpression)
-	m.SpanEventsValueExpression = keepUnset(m.SpanEventsValueExpression, src.SpanEventsValueExpression)
+	m.StatusCodeExpression = keepPreset(m.StatusCodeExpression, src.StatusCodeExpression, preset.StatusCodeExpression)
+	m.StatusMessageExpression = keepPreset(m.StatusMessageExpression, src.StatusMessageExpression, preset.StatusMessageExpression)
+	m.SpanEventsValueExpression = keepPreset(m.SpanEventsValueExpression, src.SpanEventsValueExpression, preset.SpanEventsValueExpression)
 
 	if len(src.QuerySettings) > 0 {
 		m.QuerySettings = make([]querySettingModel, 0, len(src.QuerySettings))
@@ -648,7 +706,10 @@ func (m *sourceResourceModel) applySource(src *client.Source) {
 		m.QuerySettings = nil
 	}
 
-	if src.MetricTables != nil {
+	switch {
+	case m.MetricTables == nil && preset.MetricTables != nil && metricTablesEqual(src.MetricTables, preset.MetricTables):
+		// Left to the preset: keep it unset.
+	case src.MetricTables != nil:
 		var cur metricTablesModel
 		if m.MetricTables != nil {
 			cur = *m.MetricTables
@@ -660,7 +721,7 @@ func (m *sourceResourceModel) applySource(src *client.Source) {
 			Summary:              keepUnset(cur.Summary, src.MetricTables.Summary),
 			ExponentialHistogram: keepUnset(cur.ExponentialHistogram, src.MetricTables.ExponentialHistogram),
 		}
-	} else {
+	default:
 		m.MetricTables = nil
 	}
 
@@ -0,0 +1,374 @@
+package clickstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = (*sourceSetResource)(nil)
+	_ resource.ResourceWithConfigure      = (*sourceSetResource)(nil)
+	_ resource.ResourceWithImportState    = (*sourceSetResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*sourceSetResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*sourceSetResource)(nil)
+)
+
+// NewSourceSetResource is a helper to register the resource with the provider.
+func NewSourceSetResource() resource.Resource {
+	return &sourceSetResource{}
+}
+
+// sourceSetResource manages the four sources of a default OpenTelemetry
+// collector schema — logs, traces, metrics and sessions — as one unit, with
+// every correlated source ID wired up.
+type sourceSetResource struct {
+	client *client.Client
+}
+
+// sourceSetResourceModel maps the resource schema data.
+type sourceSetResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Team            types.String `tfsdk:"team"`
+	Connection      types.String `tfsdk:"connection_id"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	NamePrefix      types.String `tfsdk:"name_prefix"`
+	LogSourceID     types.String `tfsdk:"log_source_id"`
+	TraceSourceID   types.String `tfsdk:"trace_source_id"`
+	MetricSourceID  types.String `tfsdk:"metric_source_id"`
+	SessionSourceID types.String `tfsdk:"session_source_id"`
+}
+
+// sourceSetMember is one source of the set.
+type sourceSetMember struct {
+	preset string
+	name   string // appended to name_prefix
+	table  string // "" for metrics, which use the preset's metric tables
+	id     *types.String
+}
+
+// members lists the sources of the set in creation order: a session source
+// must reference an existing trace source.
+func (m *sourceSetResourceModel) members() []sourceSetMember {
+	return []sourceSetMember{
+		{sourcePresetLogs, "Logs", otelLogsTable, &m.LogSourceID},
+		{sourcePresetTraces, "Traces", otelTracesTable, &m.TraceSourceID},
+		{sourcePresetMetrics, "Metrics", "", &m.MetricSourceID},
+		{sourcePresetSessions, "Sessions", otelSessionsTable, &m.SessionSourceID},
+	}
+}
+
+// desired returns the source body for a member, linked to every source of the
+// set whose ID is known so far.
+func (m *sourceSetResourceModel) desired(mem sourceSetMember) client.Source {
+	preset := sourcePresets[mem.preset]
+	src := client.Source{
+		Name:       m.NamePrefix.ValueString() + mem.name,
+		Kind:       preset.Kind,
+		Connection: m.Connection.ValueString(),
+		From:       client.SourceFrom{DatabaseName: m.DatabaseName.ValueString(), TableName: mem.table},
+	}
+	applyPreset(&src, preset)
+
+	switch mem.preset {
+	case sourcePresetLogs:
+		src.TraceSourceID = optStringPtr(m.TraceSourceID)
+		src.MetricSourceID = optStringPtr(m.MetricSourceID)
+	case sourcePresetTraces:
+		src.LogSourceID = optStringPtr(m.LogSourceID)
+		src.MetricSourceID = optStringPtr(m.MetricSourceID)
+		src.SessionSourceID = optStringPtr(m.SessionSourceID)
+	case sourcePresetMetrics:
+		src.LogSourceID = optStringPtr(m.LogSourceID)
+	case sourcePresetSessions:
+		src.TraceSourceID = optStringPtr(m.TraceSourceID)
+	}
+	return src
+}
+
+// setID derives the resource ID from the member IDs; it is also the import ID.
+func (m *sourceSetResourceModel) setID() {
+	ids := make([]string, 0, 4)
+	for _, mem := range m.members() {
+		ids = append(ids, mem.id.ValueString())
+	}
+	m.ID = types.StringValue(strings.Join(ids, ","))
+}
+
+func (r *sourceSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_source_set"
+}
+
+func (r *sourceSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sourceID := func(kind string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:      true,
+			Description:   "ID of the " + kind + " source.",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages the four ClickStack sources of the default OpenTelemetry collector schema — " +
+			"logs (`otel_logs`), traces (`otel_traces`), metrics (`otel_metrics_*`) and sessions " +
+			"(`hyperdx_sessions`) — in one database, linked to each other so that the UI can jump " +
+			"between correlated logs, traces, metrics and sessions. Each source uses the matching " +
+			"`preset` of `clickhouse_clickstack_source`; use that resource instead to customize a " +
+			"source. A source of the set deleted outside Terraform is re-created on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			idAttr: schema.StringAttribute{
+				Computed: true,
+				Description: "The IDs of the logs, traces, metrics and sessions sources, comma-separated " +
+					"in that order. This is also the import ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			teamAttr: schema.StringAttribute{
+				Optional: true,
+				Description: "Team ID to manage the sources under, sent as the `x-hdx-team` header. " +
+					"Defaults to the API key's team. Changing this forces the sources to be replaced.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the ClickHouse connection the sources query through.",
+			},
+			"database_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Description: "ClickHouse database holding the OTel tables. Defaults to `default`.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Description: "Prefix of the source names, which are `<name_prefix>Logs`, `<name_prefix>Traces`, " +
+					"`<name_prefix>Metrics` and `<name_prefix>Sessions`. Defaults to none.",
+			},
+			"log_source_id":     sourceID("logs"),
+			"trace_source_id":   sourceID("traces"),
+			"metric_source_id":  sourceID("metrics"),
+			"session_source_id": sourceID("sessions"),
+		},
+	}
+}
+
+func (r *sourceSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "resource")
+		return
+	}
+	r.client = providerData.ClickStack
+}
+
+func (r *sourceSetResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_clickstack_source_set", &resp.Diagnostics)
+}
+
+// ModifyPlan plans the ID of a source that Read found missing as unknown, so
+// the next apply re-creates it and re-links the set.
+func (r *sourceSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state sourceSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	missing := false
+	for _, mem := range state.members() {
+		if mem.id.IsNull() {
+			missing = true
+			break
+		}
+	}
+	if !missing {
+		return
+	}
+	for attr, v := range map[string]types.String{
+		"log_source_id":     state.LogSourceID,
+		"trace_source_id":   state.TraceSourceID,
+		"metric_source_id":  state.MetricSourceID,
+		"session_source_id": state.SessionSourceID,
+	} {
+		if v.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.StringUnknown())...)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(idAttr), types.StringUnknown())...)
+}
+
+// sync creates the sources of the set that do not exist yet, then writes every
+// source with its links to the others. It returns the IDs of the sources it
+// created so that a failed Create can remove them.
+func (r *sourceSetResource) sync(ctx context.Context, m *sourceSetResourceModel) ([]string, error) {
+	c := r.client.WithTeam(m.Team.ValueString())
+	var created []string
+	for _, mem := range m.members() {
+		if known(*mem.id) {
+			continue
+		}
+		src, err := c.CreateSource(ctx, m.desired(mem))
+		if err != nil {
+			return created, fmt.Errorf("create %s source: %w", mem.name, err)
+		}
+		*mem.id = types.StringValue(src.ID)
+		created = append(created, src.ID)
+	}
+	for _, mem := range m.members() {
+		if _, err := c.UpdateSource(ctx, mem.id.ValueString(), m.desired(mem)); err != nil {
+			return created, fmt.Errorf("link %s source: %w", mem.name, err)
+		}
+	}
+	m.setID()
+	return created, nil
+}
+
+func (r *sourceSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sourceSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.sync(ctx, &plan)
+	if err != nil {
+		// Leave nothing behind that Terraform does not track.
+		c := r.client.WithTeam(plan.Team.ValueString())
+		for _, id := range created {
+			if derr := c.DeleteSource(ctx, id); derr != nil && !errors.Is(derr, client.ErrNotFound) {
+				resp.Diagnostics.AddWarning("Could not remove a partially created source",
+					fmt.Sprintf("Source %s was created before the error and could not be deleted: %s", id, derr))
+			}
+		}
+		resp.Diagnostics.AddError("Error Creating Source Set", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "created source set resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sourceSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sourceSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client.WithTeam(state.Team.ValueString())
+	found := 0
+	for _, mem := range state.members() {
+		if mem.id.IsNull() {
+			continue
+		}
+		src, err := c.GetSource(ctx, mem.id.ValueString())
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				*mem.id = types.StringNull()
+				continue
+			}
+			resp.Diagnostics.AddError("Error Reading Source Set", err.Error())
+			return
+		}
+		found++
+		state.Connection = types.StringValue(src.Connection)
+		state.DatabaseName = types.StringValue(src.From.DatabaseName)
+		if prefix, ok := strings.CutSuffix(src.Name, mem.name); ok {
+			state.NamePrefix = types.StringValue(prefix)
+		}
+	}
+	if found == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sourceSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sourceSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.sync(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Error Updating Source Set", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sourceSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sourceSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reverse creation order: the session source goes before the trace source
+	// it references.
+	c := r.client.WithTeam(state.Team.ValueString())
+	members := state.members()
+	for i := len(members) - 1; i >= 0; i-- {
+		id := members[i].id
+		if id.IsNull() {
+			continue
+		}
+		if err := c.DeleteSource(ctx, id.ValueString()); err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError("Error Deleting Source Set", fmt.Sprintf("delete %s source: %s", members[i].name, err))
+		}
+	}
+}
+
+func (r *sourceSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "<logs>,<traces>,<metrics>,<sessions>", optionally prefixed with
+	// "<team>/" for a non-default team.
+	ids := req.ID
+	if team, rest, ok := strings.Cut(req.ID, "/"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(teamAttr), team)...)
+		ids = rest
+	}
+	parts := strings.Split(ids, ",")
+	if len(parts) != 4 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("Invalid Import ID",
+			fmt.Sprintf("expected \"[<team>/]<logs id>,<traces id>,<metrics id>,<sessions id>\", got %q", req.ID))
+		return
+	}
+	for i, attr := range []string{"log_source_id", "trace_source_id", "metric_source_id", "session_source_id"} {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), parts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttr), ids)...)
+}
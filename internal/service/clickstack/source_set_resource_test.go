@@ -0,0 +1,135 @@
+package clickstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+)
+
+// fakeSources is an in-memory /api/v2/sources endpoint.
+type fakeSources struct {
+	mu      sync.Mutex
+	next    int
+	sources map[string]client.Source
+	failOn  string // a source name whose create fails
+}
+
+func (f *fakeSources) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api/v2/sources"), "/")
+	write := func(src client.Source) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": src})
+	}
+	switch r.Method {
+	case http.MethodPost:
+		var src client.Source
+		_ = json.NewDecoder(r.Body).Decode(&src)
+		if src.Name == f.failOn {
+			http.Error(w, `{"message":"boom"}`, http.StatusBadRequest)
+			return
+		}
+		f.next++
+		src.ID = fmt.Sprintf("s%d", f.next)
+		f.sources[src.ID] = src
+		write(src)
+	case http.MethodPut:
+		if _, ok := f.sources[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var src client.Source
+		_ = json.NewDecoder(r.Body).Decode(&src)
+		src.ID = id
+		f.sources[id] = src
+		write(src)
+	case http.MethodDelete:
+		delete(f.sources, id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newSourceSet() sourceSetResourceModel {
+	return sourceSetResourceModel{
+		Team:            types.StringNull(),
+		Connection:      types.StringValue("c1"),
+		DatabaseName:    types.StringValue("otel"),
+		NamePrefix:      types.StringValue("Prod "),
+		LogSourceID:     types.StringUnknown(),
+		TraceSourceID:   types.StringUnknown(),
+		MetricSourceID:  types.StringUnknown(),
+		SessionSourceID: types.StringUnknown(),
+	}
+}
+
+func TestSourceSet_SyncLinksSources(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSources{sources: map[string]client.Source{}}
+	r := &sourceSetResource{client: dashboardTestClient(t, fake)}
+
+	m := newSourceSet()
+	if _, err := r.sync(context.Background(), &m); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	logs, traces := fake.sources[m.LogSourceID.ValueString()], fake.sources[m.TraceSourceID.ValueString()]
+	metrics, sessions := fake.sources[m.MetricSourceID.ValueString()], fake.sources[m.SessionSourceID.ValueString()]
+
+	if logs.Name != "Prod Logs" || logs.From.DatabaseName != "otel" || logs.From.TableName != otelLogsTable {
+		t.Errorf("unexpected logs source: %+v", logs)
+	}
+	if *logs.TraceSourceID != m.TraceSourceID.ValueString() || *logs.MetricSourceID != m.MetricSourceID.ValueString() {
+		t.Errorf("logs source not linked: trace=%v metric=%v", logs.TraceSourceID, logs.MetricSourceID)
+	}
+	if *traces.LogSourceID != m.LogSourceID.ValueString() || *traces.SessionSourceID != m.SessionSourceID.ValueString() {
+		t.Errorf("traces source not linked: log=%v session=%v", traces.LogSourceID, traces.SessionSourceID)
+	}
+	if *metrics.LogSourceID != m.LogSourceID.ValueString() || metrics.MetricTables == nil {
+		t.Errorf("unexpected metrics source: %+v", metrics)
+	}
+	if sessions.Kind != "session" || *sessions.TraceSourceID != m.TraceSourceID.ValueString() {
+		t.Errorf("unexpected sessions source: %+v", sessions)
+	}
+	want := strings.Join([]string{m.LogSourceID.ValueString(), m.TraceSourceID.ValueString(),
+		m.MetricSourceID.ValueString(), m.SessionSourceID.ValueString()}, ",")
+	if m.ID.ValueString() != want {
+		t.Errorf("id = %s, want %s", m.ID, want)
+	}
+
+	// A source deleted out of band is re-created and the others re-linked.
+	delete(fake.sources, m.MetricSourceID.ValueString())
+	oldMetric := m.MetricSourceID.ValueString()
+	m.MetricSourceID = types.StringUnknown()
+	if _, err := r.sync(context.Background(), &m); err != nil {
+		t.Fatalf("re-sync: %v", err)
+	}
+	if m.MetricSourceID.ValueString() == oldMetric || len(fake.sources) != 4 {
+		t.Fatalf("metrics source not re-created: id=%s sources=%d", m.MetricSourceID, len(fake.sources))
+	}
+	if got := *fake.sources[m.LogSourceID.ValueString()].MetricSourceID; got != m.MetricSourceID.ValueString() {
+		t.Errorf("logs source links metric %s, want %s", got, m.MetricSourceID)
+	}
+}
+
+func TestSourceSet_SyncReportsCreated(t *testing.T) {
+	t.Parallel()
+	fake := &fakeSources{sources: map[string]client.Source{}, failOn: "Prod Metrics"}
+	r := &sourceSetResource{client: dashboardTestClient(t, fake)}
+
+	m := newSourceSet()
+	created, err := r.sync(context.Background(), &m)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(created) != 2 {
+		t.Errorf("created = %v, want the logs and traces sources", created)
+	}
+}
@@ -0,0 +1,181 @@
+package clickstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ datasource.DataSource              = (*teamDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*teamDataSource)(nil)
+	_ datasource.DataSource              = (*teamMembersDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*teamMembersDataSource)(nil)
+)
+
+// NewTeamDataSource is a helper to register the data source with the provider.
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+// NewTeamMembersDataSource is a helper to register the data source with the provider.
+func NewTeamMembersDataSource() datasource.DataSource {
+	return &teamMembersDataSource{}
+}
+
+// teamDataSource reads the settings of the API key's team, or of the team
+// named by the team attribute.
+type teamDataSource struct {
+	client *client.Client
+}
+
+// teamMembersDataSource lists the members of a team.
+type teamMembersDataSource struct {
+	client *client.Client
+}
+
+// teamDataSourceModel maps the clickstack_team data source schema data.
+type teamDataSourceModel struct {
+	Team              types.String `tfsdk:"team"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	DefaultUserRoleID types.String `tfsdk:"default_user_role_id"`
+}
+
+// teamMemberSummaryModel is one entry of the clickstack_team_members list.
+type teamMemberSummaryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	RoleID    types.String `tfsdk:"role_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+	IsVirtual types.Bool   `tfsdk:"is_virtual"`
+}
+
+// teamMembersDataSourceModel maps the clickstack_team_members data source schema data.
+type teamMembersDataSourceModel struct {
+	Team    types.String             `tfsdk:"team"`
+	Members []teamMemberSummaryModel `tfsdk:"members"`
+}
+
+func (d *teamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_team"
+}
+
+func (d *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of a ClickStack team. Without `team`, this is the team the API key belongs to. " +
+			"**Note:** on ClickHouse Cloud, teams are managed through ClickHouse Cloud; this data source is for self-hosted ClickStack.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: schema.StringAttribute{
+				Optional:    true,
+				Description: "Team ID to read, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+			},
+			idAttr: schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the team.",
+			},
+			nameAttr: schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the team.",
+			},
+			"default_user_role_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the role assigned to new users who join the team. Null when none is configured.",
+			},
+		},
+	}
+}
+
+func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_team", &resp.Diagnostics)
+	var config teamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := d.client.WithTeam(config.Team.ValueString()).GetTeam(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Team", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(team.ID)
+	config.Name = types.StringValue(team.Name)
+	config.DefaultUserRoleID = emptyPtrToNull(team.DefaultUserRole)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *teamMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_team_members"
+}
+
+func (d *teamMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the members of a ClickStack team with their roles. Pending invitations are not included. " +
+			"**Note:** on ClickHouse Cloud, teams are managed through ClickHouse Cloud; this data source is for self-hosted ClickStack.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: schema.StringAttribute{
+				Optional:    true,
+				Description: "Team ID to list members for, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The team's members, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						idAttr:       schema.StringAttribute{Computed: true, Description: "User ID of the member."},
+						emailAttr:    schema.StringAttribute{Computed: true, Description: "Email address of the member."},
+						nameAttr:     schema.StringAttribute{Computed: true, Description: "Display name of the member, if set."},
+						roleIDAttr:   schema.StringAttribute{Computed: true, Description: "ID of the member's role."},
+						"role_name":  schema.StringAttribute{Computed: true, Description: "Name of the member's role."},
+						"is_virtual": schema.BoolAttribute{Computed: true, Description: "Whether the member is a virtual (API-only) user."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teamMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *teamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_team_members", &resp.Diagnostics)
+	var config teamMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.WithTeam(config.Team.ValueString()).ListTeamMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Team Members", err.Error())
+		return
+	}
+
+	config.Members = make([]teamMemberSummaryModel, 0, len(members))
+	for _, m := range members {
+		config.Members = append(config.Members, teamMemberSummaryModel{
+			ID:        types.StringValue(m.ID),
+			Email:     types.StringValue(m.Email),
+			Name:      emptyPtrToNull(m.Name),
+			RoleID:    types.StringValue(m.RoleID),
+			RoleName:  types.StringValue(m.RoleName),
+			IsVirtual: types.BoolValue(m.IsVirtual),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
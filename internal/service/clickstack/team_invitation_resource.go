@@ -0,0 +1,366 @@
+package clickstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Invitation status values tracked in state, besides memberStatusPending.
+const (
+	invitationStatusAccepted = "accepted"
+	invitationStatusExpired  = "expired"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = (*teamInvitationResource)(nil)
+	_ resource.ResourceWithConfigure      = (*teamInvitationResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*teamInvitationResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*teamInvitationResource)(nil)
+	_ resource.ResourceWithImportState    = (*teamInvitationResource)(nil)
+)
+
+// NewTeamInvitationResource is a helper to register the resource with the provider.
+func NewTeamInvitationResource() resource.Resource {
+	return &teamInvitationResource{}
+}
+
+// teamInvitationResource manages a pending invitation to join a team. Unlike
+// teamMemberResource it lets go of the user once the invitation is accepted,
+// and it re-issues invitations older than expires_in.
+type teamInvitationResource struct {
+	client *client.Client
+}
+
+// teamInvitationResourceModel maps the resource schema data.
+type teamInvitationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Team      types.String `tfsdk:"team"`
+	Email     types.String `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	RoleID    types.String `tfsdk:"role_id"`
+	ExpiresIn types.String `tfsdk:"expires_in"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Status    types.String `tfsdk:"status"`
+	InviteURL types.String `tfsdk:"invite_url"`
+}
+
+func (r *teamInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_team_invitation"
+}
+
+func (r *teamInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an invitation to join a ClickStack team. The invitation stays `pending` until the " +
+			"invitee signs up through `invite_url`, after which it is `accepted` and the resource has nothing " +
+			"left to manage: destroying it no longer removes the member. When `expires_in` is set, a pending " +
+			"invitation older than that is `expired` and re-issued with a new `invite_url` on the next apply. " +
+			"Use `clickhouse_clickstack_team_member` instead to keep managing the member's role after they " +
+			"join; do not manage the same email with both. This resource is for self-hosted ClickStack.",
+		Attributes: map[string]schema.Attribute{
+			idAttr: schema.StringAttribute{
+				Computed: true,
+				Description: "Identifier of the invitation. When the email already had an account and was " +
+					"added to the team straight away, this is the user ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			teamAttr: schema.StringAttribute{
+				Optional: true,
+				Description: "Team ID to invite to, sent as the `x-hdx-team` header. Defaults to the API key's team. " +
+					"Changing this forces a new invitation.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			emailAttr: schema.StringAttribute{
+				Required:      true,
+				Description:   "Email address to invite. Changing this forces a new invitation.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			nameAttr: schema.StringAttribute{
+				Optional:      true,
+				Description:   "Display name of the invitee. Changing this forces a new invitation.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			roleIDAttr: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "ID of the role the invitee gets on joining. Omit on OSS deployments, which have no RBAC. " +
+					"Changing this forces a new invitation, as the role is part of the invitation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Optional: true,
+				Description: "How long the invitation stays valid, as a Go duration (e.g. `168h`). When unset the " +
+					"invitation never expires on the Terraform side. Changing it moves `expires_at` without " +
+					"re-issuing the invitation, unless the new value has already passed.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "RFC 3339 time the invitation was issued.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC 3339 time the invitation expires: `created_at` plus `expires_in`. Null when `expires_in` is unset.",
+			},
+			statusAttr: schema.StringAttribute{
+				Computed:    true,
+				Description: "Invitation status: `pending`, `expired` (re-issued on the next apply) or `accepted`.",
+			},
+			inviteURLAttr: schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Join URL to send to the invitee. Empty once the invitation is accepted.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *teamInvitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("expected *service.ProviderData, got: %T. This is a bug in the provider.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ClickStack == nil {
+		addNotConfiguredError(&resp.Diagnostics, "resource")
+		return
+	}
+	r.client = providerData.ClickStack
+}
+
+func (r *teamInvitationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_clickstack_team_invitation", &resp.Diagnostics)
+
+	var expiresIn types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_in"), &expiresIn)...)
+	if !known(expiresIn) {
+		return
+	}
+	if d, err := time.ParseDuration(expiresIn.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid expires_in",
+			fmt.Sprintf("expires_in must be a positive Go duration such as 168h, got %q.", expiresIn.ValueString()))
+	}
+}
+
+// ModifyPlan recomputes expires_at for the planned expires_in and replaces an
+// invitation that has expired, which Read alone cannot do when the plan runs
+// with -refresh=false or expires_in was just shortened.
+func (r *teamInvitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state teamInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.ExpiresIn.IsUnknown() {
+		return
+	}
+
+	plan.CreatedAt = state.CreatedAt
+	plan.ExpiresAt = plan.expiresAt()
+	plan.Status = state.Status
+	if state.Status.ValueString() != invitationStatusAccepted && plan.expiredAt(time.Now()) {
+		plan.ID = types.StringUnknown()
+		plan.CreatedAt = types.StringUnknown()
+		plan.ExpiresAt = types.StringUnknown()
+		plan.Status = types.StringUnknown()
+		plan.InviteURL = types.StringUnknown()
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(statusAttr))
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *teamInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.WithTeam(plan.Team.ValueString()).InviteTeamMember(ctx, client.InviteTeamMemberInput{
+		Email:  plan.Email.ValueString(),
+		RoleID: plan.RoleID.ValueString(),
+		Name:   plan.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Team Invitation", err.Error())
+		return
+	}
+
+	// As for team members, the invite API does not echo the role; Read
+	// fills in a server-assigned one.
+	if plan.RoleID.IsUnknown() {
+		plan.RoleID = types.StringNull()
+	}
+	plan.CreatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	plan.ExpiresAt = plan.expiresAt()
+	plan.Status = types.StringValue(memberStatusPending)
+	plan.InviteURL = types.StringValue(result.URL)
+	switch {
+	case result.Status == memberStatusActive && result.UserID != nil:
+		plan.ID = types.StringValue(*result.UserID)
+		plan.Status = types.StringValue(invitationStatusAccepted)
+		plan.InviteURL = types.StringValue("")
+	case result.InvitationID != nil:
+		plan.ID = types.StringValue(*result.InvitationID)
+	default:
+		resp.Diagnostics.AddError("Error Creating Team Invitation",
+			fmt.Sprintf("The API returned neither an invitation nor a user for %s (status %q).", plan.Email.ValueString(), result.Status))
+		return
+	}
+	tflog.Trace(ctx, "created team invitation resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *teamInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scoped := r.client.WithTeam(state.Team.ValueString())
+	email := state.Email.ValueString()
+
+	if state.Status.ValueString() != invitationStatusAccepted {
+		invitations, err := scoped.ListInvitations(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Team Invitations", err.Error())
+			return
+		}
+		for _, inv := range invitations {
+			if !strings.EqualFold(inv.Email, email) {
+				continue
+			}
+			state.ID = types.StringValue(inv.ID)
+			state.RoleID = resolveRoleID(state.RoleID, inv.RoleID)
+			if state.CreatedAt.IsNull() && inv.CreatedAt != "" {
+				state.CreatedAt = types.StringValue(inv.CreatedAt)
+			}
+			if state.InviteURL.IsNull() {
+				// Imported: the list does not carry the join URL.
+				state.InviteURL = types.StringValue("")
+			}
+			state.ExpiresAt = state.expiresAt()
+			state.Status = types.StringValue(memberStatusPending)
+			if state.expiredAt(time.Now()) {
+				state.Status = types.StringValue(invitationStatusExpired)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// No pending invitation: either the invitee joined, or the invitation
+	// was revoked outside Terraform.
+	members, err := scoped.ListTeamMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Team Members", err.Error())
+		return
+	}
+	for _, m := range members {
+		if strings.EqualFold(m.Email, email) {
+			state.Status = types.StringValue(invitationStatusAccepted)
+			state.InviteURL = types.StringValue("")
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only ever changes expires_in; everything else forces a new invitation.
+func (r *teamInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state teamInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = state.CreatedAt
+	plan.InviteURL = state.InviteURL
+	plan.ExpiresAt = plan.expiresAt()
+	plan.Status = state.Status
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *teamInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted invitation no longer exists; the member it created stays.
+	if state.Status.ValueString() == invitationStatusAccepted {
+		return
+	}
+	err := r.client.WithTeam(state.Team.ValueString()).DeleteInvitation(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error Deleting Team Invitation", err.Error())
+	}
+}
+
+func (r *teamInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "<email>" or "<team>/<email>", as for team members.
+	if team, email, ok := strings.Cut(req.ID, "/"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(emailAttr), email)...)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root(emailAttr), req, resp)
+}
+
+// expiresAt returns created_at plus expires_in, or null when either is unset
+// or unparsable.
+func (m teamInvitationResourceModel) expiresAt() types.String {
+	if !known(m.CreatedAt) || !known(m.ExpiresIn) {
+		return types.StringNull()
+	}
+	created, err := time.Parse(time.RFC3339, m.CreatedAt.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	d, err := time.ParseDuration(m.ExpiresIn.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(created.Add(d).UTC().Format(time.RFC3339))
+}
+
+// expiredAt reports whether the invitation's expires_at has passed at now.
+func (m teamInvitationResourceModel) expiredAt(now time.Time) bool {
+	at, err := time.Parse(time.RFC3339, m.expiresAt().ValueString())
+	return err == nil && !now.Before(at)
+}
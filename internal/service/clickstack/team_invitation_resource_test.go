@@ -0,0 +1,129 @@
+package clickstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTeamInvitation_Expiry(t *testing.T) {
+	t.Parallel()
+
+	m := teamInvitationResourceModel{
+		CreatedAt: types.StringValue("2026-10-01T12:00:00Z"),
+		ExpiresIn: types.StringValue("168h"),
+	}
+	if got := m.expiresAt().ValueString(); got != "2026-10-08T12:00:00Z" {
+		t.Errorf("expires_at = %q, want 2026-10-08T12:00:00Z", got)
+	}
+	if m.expiredAt(time.Date(2026, 10, 8, 11, 59, 0, 0, time.UTC)) {
+		t.Error("expired a minute early")
+	}
+	if !m.expiredAt(time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)) {
+		t.Error("not expired at expires_at")
+	}
+
+	m.ExpiresIn = types.StringNull()
+	if !m.expiresAt().IsNull() || m.expiredAt(time.Now().AddDate(10, 0, 0)) {
+		t.Error("an invitation without expires_in must never expire")
+	}
+}
+
+func TestTeamInvitationResource_Read(t *testing.T) {
+	t.Parallel()
+
+	const email = "new@example.com"
+	schemaResp := &fwresource.SchemaResponse{}
+	(&teamInvitationResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, schemaResp)
+
+	cases := []struct {
+		name        string
+		createdAt   string
+		members     string
+		invs        string
+		wantRemoved bool
+		wantStatus  string
+	}{
+		{
+			name:       "recent invitation is pending",
+			createdAt:  time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			members:    `[]`,
+			invs:       `[{"id":"inv1","email":"` + email + `","roleId":"r1"}]`,
+			wantStatus: memberStatusPending,
+		},
+		{
+			name:       "old invitation is expired",
+			createdAt:  time.Now().Add(-200 * time.Hour).UTC().Format(time.RFC3339),
+			members:    `[]`,
+			invs:       `[{"id":"inv1","email":"` + email + `","roleId":"r1"}]`,
+			wantStatus: invitationStatusExpired,
+		},
+		{
+			name:       "joined invitee is accepted",
+			createdAt:  time.Now().Add(-200 * time.Hour).UTC().Format(time.RFC3339),
+			members:    `[{"id":"u1","email":"` + email + `","roleId":"r1"}]`,
+			invs:       `[]`,
+			wantStatus: invitationStatusAccepted,
+		},
+		{
+			name:        "revoked invitation is removed",
+			createdAt:   time.Now().UTC().Format(time.RFC3339),
+			members:     `[]`,
+			invs:        `[]`,
+			wantRemoved: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v2/team/members", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"data":` + tc.members + `}`))
+			})
+			mux.HandleFunc("/api/v2/team/invitations", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"data":` + tc.invs + `}`))
+			})
+			r := &teamInvitationResource{client: dashboardTestClient(t, mux)}
+
+			prior := tfsdk.State{Schema: schemaResp.Schema}
+			prior.Set(context.Background(), &teamInvitationResourceModel{
+				ID:        types.StringValue("inv1"),
+				Team:      types.StringNull(),
+				Email:     types.StringValue(email),
+				Name:      types.StringNull(),
+				RoleID:    types.StringValue("r1"),
+				ExpiresIn: types.StringValue("168h"),
+				CreatedAt: types.StringValue(tc.createdAt),
+				ExpiresAt: types.StringNull(),
+				Status:    types.StringValue(memberStatusPending),
+				InviteURL: types.StringValue("https://app/join-team?token=x"),
+			})
+			resp := &fwresource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Read(context.Background(), fwresource.ReadRequest{State: prior}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+			}
+			if tc.wantRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Error("expected resource removed from state")
+				}
+				return
+			}
+			var got teamInvitationResourceModel
+			resp.State.Get(context.Background(), &got)
+			if got.Status.ValueString() != tc.wantStatus {
+				t.Errorf("status=%q, want %q", got.Status.ValueString(), tc.wantStatus)
+			}
+			if tc.wantStatus == invitationStatusAccepted && got.InviteURL.ValueString() != "" {
+				t.Errorf("invite_url=%q, want empty once accepted", got.InviteURL.ValueString())
+			}
+		})
+	}
+}
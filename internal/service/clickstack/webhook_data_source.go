@@ -0,0 +1,216 @@
+package clickstack
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/clickstack/client"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ datasource.DataSource              = (*webhookDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhookDataSource)(nil)
+	_ datasource.DataSource              = (*webhooksDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhooksDataSource)(nil)
+)
+
+// NewWebhookDataSource is a helper to register the data source with the provider.
+func NewWebhookDataSource() datasource.DataSource {
+	return &webhookDataSource{}
+}
+
+// NewWebhooksDataSource is a helper to register the data source with the provider.
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+// webhookDataSource looks up a ClickStack webhook by ID, or by name within a
+// service. Headers and query params are write-only and never exposed.
+type webhookDataSource struct {
+	client *client.Client
+}
+
+// webhooksDataSource lists the ClickStack webhooks of a team.
+type webhooksDataSource struct {
+	client *client.Client
+}
+
+// webhookDataSourceModel maps the clickstack_webhook data source schema data.
+type webhookDataSourceModel struct {
+	Team        types.String `tfsdk:"team"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Service     types.String `tfsdk:"service"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	Body        types.String `tfsdk:"body"`
+}
+
+// webhookSummaryModel is one entry of the clickstack_webhooks list. The URL
+// is left out so that the list is not sensitive as a whole.
+type webhookSummaryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Service     types.String `tfsdk:"service"`
+	Description types.String `tfsdk:"description"`
+}
+
+// webhooksDataSourceModel maps the clickstack_webhooks data source schema data.
+type webhooksDataSourceModel struct {
+	Team     types.String          `tfsdk:"team"`
+	Service  types.String          `tfsdk:"service"`
+	Webhooks []webhookSummaryModel `tfsdk:"webhooks"`
+}
+
+func (d *webhookDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_webhook"
+}
+
+func (d *webhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	id, name := idOrNameAttributes("webhook")
+	resp.Schema = schema.Schema{
+		Description: "Looks up a ClickStack webhook by ID or name. Webhook names are unique per " +
+			"service, so set `service` as well when the same name is used by several services. " +
+			"Headers and query parameters are write-only in the API and are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: teamLookupAttribute("webhook"),
+			idAttr:   id,
+			nameAttr: name,
+			"service": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Webhook service: `slack`, `generic` or `incidentio`. Narrows a lookup by name.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Destination URL of the webhook. Sensitive because Slack URLs embed a channel token.",
+			},
+			descriptionAttr: schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the webhook.",
+			},
+			"body": schema.StringAttribute{
+				Computed:    true,
+				Description: "Request body template. Only returned for `generic` webhooks.",
+			},
+		},
+	}
+}
+
+func (d *webhookDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *webhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_webhook", &resp.Diagnostics)
+	var config webhookDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhooks, err := d.client.WithTeam(config.Team.ValueString()).ListWebhooks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Webhooks", err.Error())
+		return
+	}
+	webhooks = filterWebhooks(webhooks, config.Service)
+	wh, err := findByIDOrName(webhooks, "webhook", config.ID.ValueString(), config.Name.ValueString(),
+		func(w client.Webhook) (string, string) { return w.ID, w.Name })
+	if err != nil {
+		resp.Diagnostics.AddError("Webhook Not Found", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(wh.ID)
+	config.Name = types.StringValue(wh.Name)
+	config.Service = types.StringValue(wh.Service)
+	config.URL = types.StringValue(wh.URL)
+	config.Description = emptyPtrToNull(wh.Description)
+	config.Body = emptyPtrToNull(wh.Body)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickstack_webhooks"
+}
+
+func (d *webhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the ClickStack webhooks of a team, optionally filtered by service. " +
+			"URLs are not included; look a single webhook up with `clickhouse_clickstack_webhook` to read its URL.",
+		Attributes: map[string]schema.Attribute{
+			teamAttr: schema.StringAttribute{
+				Optional:    true,
+				Description: "Team ID to list webhooks for, sent as the `x-hdx-team` header. Defaults to the API key's team.",
+			},
+			"service": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list webhooks of this service: `slack`, `generic` or `incidentio`.",
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The webhooks, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						idAttr:          schema.StringAttribute{Computed: true, Description: "Identifier of the webhook."},
+						nameAttr:        schema.StringAttribute{Computed: true, Description: "Name of the webhook."},
+						"service":       schema.StringAttribute{Computed: true, Description: "Webhook service."},
+						descriptionAttr: schema.StringAttribute{Computed: true, Description: "Description of the webhook."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *webhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSource(req, resp)
+}
+
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	utils.BetaWarning("clickhouse_clickstack_webhooks", &resp.Diagnostics)
+	var config webhooksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhooks, err := d.client.WithTeam(config.Team.ValueString()).ListWebhooks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Webhooks", err.Error())
+		return
+	}
+
+	config.Webhooks = []webhookSummaryModel{}
+	for _, wh := range filterWebhooks(webhooks, config.Service) {
+		config.Webhooks = append(config.Webhooks, webhookSummaryModel{
+			ID:          types.StringValue(wh.ID),
+			Name:        types.StringValue(wh.Name),
+			Service:     types.StringValue(wh.Service),
+			Description: emptyPtrToNull(wh.Description),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// filterWebhooks keeps the webhooks of service, or all of them when service
+// is null.
+func filterWebhooks(webhooks []client.Webhook, service types.String) []client.Webhook {
+	if service.IsNull() {
+		return webhooks
+	}
+	var out []client.Webhook
+	for _, wh := range webhooks {
+		if wh.Service == service.ValueString() {
+			out = append(out, wh)
+		}
+	}
+	return out
+}
@@ -35,8 +35,9 @@ var webhookServices = []string{"slack", "generic", "incidentio"}
 // Attribute names for the write-only secret maps, referenced from the schema and
 // from ValidateConfig.
 const (
-	headersAttr     = "headers"
-	queryParamsAttr = "query_params"
+	headersAttr      = "headers"
+	queryParamsAttr  = "query_params"
+	bodyTemplateAttr = "body_template"
 )
 
 // NewWebhookResource is a helper to register the resource with the provider.
@@ -67,6 +68,8 @@ type webhookResourceModel struct {
 	HeadersVersion     types.String `tfsdk:"headers_version"`
 	QueryParamsVersion types.String `tfsdk:"query_params_version"`
 	Body               types.String `tfsdk:"body"`
+
+	BodyTemplate *webhookBodyTemplateModel `tfsdk:"body_template"`
 }
 
 func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -153,7 +156,32 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:  true,
 				Sensitive: true,
 				Description: "Request body template for `generic` and `incidentio` services. Not allowed " +
-					"for the `slack` service.",
+					"for the `slack` service. Conflicts with `body_template`.",
+			},
+			bodyTemplateAttr: schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Ready-made request body for a `generic` webhook that notifies an incident or " +
+					"chat tool the alerts API has no channel type for. Conflicts with `body`. Types: " +
+					"`pagerduty` (Events API v2; url `https://events.pagerduty.com/v2/enqueue`), " +
+					"`opsgenie` (Alert API; url `https://api.opsgenie.com/v2/alerts`, with an " +
+					"`Authorization = \"GenieKey <key>\"` header) and `msteams` (an Adaptive Card for a " +
+					"Teams workflow webhook url). The body carries the alert title, message and link.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "Template: one of `pagerduty`, `opsgenie`, `msteams`.",
+					},
+					"routing_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "PagerDuty integration routing key. Required for `pagerduty`.",
+					},
+					"severity": schema.StringAttribute{
+						Optional: true,
+						Description: "PagerDuty event severity: one of `critical`, `error`, `warning`, " +
+							"`info`. Defaults to `error`. Only for `pagerduty`.",
+					},
+				},
 			},
 		},
 	}
@@ -217,6 +245,9 @@ func (m *webhookResourceModel) validate() diag.Diagnostics {
 			diags.AddAttributeError(path.Root("body"), "body not allowed for slack", "The slack service does not support a custom body.")
 		}
 	}
+	if m.BodyTemplate != nil {
+		diags.Append(m.BodyTemplate.validate(m)...)
+	}
 	return diags
 }
 
@@ -337,6 +368,14 @@ func (m *webhookResourceModel) toClient(ctx context.Context, cfg *webhookResourc
 		Description: optStringPtr(m.Description),
 		Body:        optStringPtr(m.Body),
 	}
+	if m.BodyTemplate != nil {
+		body, err := m.BodyTemplate.render()
+		if err != nil {
+			diags.AddAttributeError(path.Root(bodyTemplateAttr), "Invalid body template", err.Error())
+			return wh, diags
+		}
+		wh.Body = &body
+	}
 
 	headers, d := mapToStringMap(ctx, cfg.Headers)
 	diags.Append(d...)
@@ -363,11 +402,28 @@ func (m *webhookResourceModel) applyWebhook(wh *client.Webhook) {
 	// value, otherwise keep the configured/prior value so a body-bearing incidentio
 	// webhook does not produce an "inconsistent result after apply" (state null vs
 	// planned value).
-	if wh.Body != nil {
+	//
+	// A templated body stays null unless the server's body no longer matches
+	// the template, in which case it is recorded so the plan shows the drift.
+	if wh.Body != nil && !m.bodyMatchesTemplate(*wh.Body) {
 		m.Body = types.StringValue(*wh.Body)
 	}
 }
 
+// bodyMatchesTemplate reports whether body is what body_template renders.
+func (m *webhookResourceModel) bodyMatchesTemplate(body string) bool {
+	if m.BodyTemplate == nil {
+		return false
+	}
+	want, err := m.BodyTemplate.render()
+	if err != nil {
+		return false
+	}
+	a, errA := canonicalizeJSON(want)
+	b, errB := canonicalizeJSON(body)
+	return errA == nil && errB == nil && a == b
+}
+
 // mapToStringMap converts a types.Map of strings to a Go map, returning nil for
 // a null/unknown map.
 func mapToStringMap(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -242,3 +243,100 @@ func TestWebhookResource_CRUD(t *testing.T) {
 		}
 	})
 }
+
+func TestWebhookResource_ValidateBodyTemplate(t *testing.T) {
+	t.Parallel()
+
+	tmpl := func(typ string, key, severity types.String) *webhookBodyTemplateModel {
+		return &webhookBodyTemplateModel{Type: types.StringValue(typ), RoutingKey: key, Severity: severity}
+	}
+	cases := []struct {
+		name    string
+		model   webhookResourceModel
+		wantErr bool
+	}{
+		{
+			name:  "pagerduty with routing key",
+			model: webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("pagerduty", types.StringValue("k"), types.StringValue("critical"))},
+		},
+		{
+			name:  "opsgenie",
+			model: webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("opsgenie", types.StringNull(), types.StringNull())},
+		},
+		{
+			name:    "pagerduty without routing key",
+			model:   webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("pagerduty", types.StringNull(), types.StringNull())},
+			wantErr: true,
+		},
+		{
+			name:    "pagerduty with unknown severity",
+			model:   webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("pagerduty", types.StringValue("k"), types.StringValue("sev1"))},
+			wantErr: true,
+		},
+		{
+			name:    "routing key on msteams",
+			model:   webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("msteams", types.StringValue("k"), types.StringNull())},
+			wantErr: true,
+		},
+		{
+			name:    "template with body",
+			model:   webhookResourceModel{Service: types.StringValue("generic"), Body: types.StringValue("{}"), BodyTemplate: tmpl("opsgenie", types.StringNull(), types.StringNull())},
+			wantErr: true,
+		},
+		{
+			name:    "template on incidentio",
+			model:   webhookResourceModel{Service: types.StringValue("incidentio"), BodyTemplate: tmpl("opsgenie", types.StringNull(), types.StringNull())},
+			wantErr: true,
+		},
+		{
+			name:    "unknown template",
+			model:   webhookResourceModel{Service: types.StringValue("generic"), BodyTemplate: tmpl("email", types.StringNull(), types.StringNull())},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			diags := tc.model.validate()
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("HasError()=%v, want %v: %s", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestWebhookResource_BodyTemplateRoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	m := webhookResourceModel{
+		Service:      types.StringValue("generic"),
+		Name:         types.StringValue("pd"),
+		URL:          types.StringValue("https://events.pagerduty.com/v2/enqueue"),
+		BodyTemplate: &webhookBodyTemplateModel{Type: types.StringValue("pagerduty"), RoutingKey: types.StringValue("R0UT1NG")},
+	}
+	wh, diags := m.toClient(ctx, &webhookResourceModel{})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if wh.Body == nil || !strings.Contains(*wh.Body, `"routing_key":"R0UT1NG"`) || !strings.Contains(*wh.Body, `"severity":"error"`) {
+		t.Fatalf("body = %v, want the rendered pagerduty template", wh.Body)
+	}
+
+	// The server echoing the rendered body (reformatted) keeps body null.
+	echoed := strings.ReplaceAll(*wh.Body, ",", ", ")
+	wh.ID = "w1"
+	wh.Body = &echoed
+	m.applyWebhook(&wh)
+	if !m.Body.IsNull() {
+		t.Errorf("body = %v, want null while it matches the template", m.Body)
+	}
+
+	// A body edited out-of-band is recorded so the plan shows it.
+	edited := `{"edited":true}`
+	wh.Body = &edited
+	m.applyWebhook(&wh)
+	if m.Body.ValueString() != edited {
+		t.Errorf("body = %v, want the drifted server body", m.Body)
+	}
+}
@@ -0,0 +1,142 @@
+package clickstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The alerts API has a single channel type, webhook. Slack has its own webhook
+// service; other incident and chat tools are reached through a generic
+// webhook whose body matches the tool's intake API. The body templates below
+// save writing that body by hand. {{title}}, {{body}} and {{link}} are
+// expanded by the ClickStack server when the alert fires.
+
+// Body template types.
+const (
+	webhookTemplatePagerDuty = "pagerduty"
+	webhookTemplateOpsgenie  = "opsgenie"
+	webhookTemplateMSTeams   = "msteams"
+)
+
+var webhookTemplateTypes = []string{webhookTemplatePagerDuty, webhookTemplateOpsgenie, webhookTemplateMSTeams}
+
+// webhookBodyTemplateModel maps the nested body_template attribute.
+type webhookBodyTemplateModel struct {
+	Type       types.String `tfsdk:"type"`
+	RoutingKey types.String `tfsdk:"routing_key"`
+	Severity   types.String `tfsdk:"severity"`
+}
+
+// pagerDutySeverities are the severities the PagerDuty Events API v2 accepts.
+var pagerDutySeverities = []string{"critical", "error", "warning", "info"}
+
+// validate checks the template against the webhook it belongs to: templates
+// render the body of a generic webhook, and each type takes its own fields.
+func (t *webhookBodyTemplateModel) validate(m *webhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	p := path.Root(bodyTemplateAttr)
+
+	if !m.Body.IsNull() {
+		diags.AddAttributeError(p, "Conflicting body",
+			"body_template renders the request body; remove body, or remove body_template to write the body by hand.")
+	}
+	if known(m.Service) && m.Service.ValueString() != "generic" {
+		diags.AddAttributeError(p, "body_template requires the generic service",
+			fmt.Sprintf("body_template is only supported with service = \"generic\", got %q.", m.Service.ValueString()))
+	}
+	if !known(t.Type) {
+		return diags
+	}
+	switch typ := t.Type.ValueString(); typ {
+	case webhookTemplatePagerDuty:
+		if t.RoutingKey.IsNull() {
+			diags.AddAttributeError(p.AtName("routing_key"), "routing_key required",
+				"The pagerduty template needs the integration's routing key.")
+		}
+		if known(t.Severity) && !slices.Contains(pagerDutySeverities, t.Severity.ValueString()) {
+			diags.AddAttributeError(p.AtName("severity"), "Invalid severity",
+				fmt.Sprintf("severity must be one of %s, got %q", strings.Join(pagerDutySeverities, ", "), t.Severity.ValueString()))
+		}
+	case webhookTemplateOpsgenie, webhookTemplateMSTeams:
+		if !t.RoutingKey.IsNull() {
+			diags.AddAttributeError(p.AtName("routing_key"), "routing_key not allowed",
+				fmt.Sprintf("routing_key only applies to the pagerduty template, not %q.", typ))
+		}
+		if !t.Severity.IsNull() {
+			diags.AddAttributeError(p.AtName("severity"), "severity not allowed",
+				fmt.Sprintf("severity only applies to the pagerduty template, not %q.", typ))
+		}
+	default:
+		diags.AddAttributeError(p.AtName("type"), "Invalid body template",
+			fmt.Sprintf("type must be one of %s, got %q", strings.Join(webhookTemplateTypes, ", "), typ))
+	}
+	return diags
+}
+
+// render returns the request body for the template. It must be called with
+// known values only (apply time).
+func (t *webhookBodyTemplateModel) render() (string, error) {
+	var body any //nolint:forbidigo // each template has its own document shape
+	switch t.Type.ValueString() {
+	case webhookTemplatePagerDuty:
+		// Events API v2: https://events.pagerduty.com/v2/enqueue
+		severity := "error"
+		if known(t.Severity) {
+			severity = t.Severity.ValueString()
+		}
+		body = map[string]any{ //nolint:forbidigo // each template has its own document shape
+			"routing_key":  t.RoutingKey.ValueString(),
+			"event_action": "trigger",
+			"payload": map[string]any{ //nolint:forbidigo // each template has its own document shape
+				"summary":        "{{title}}",
+				"source":         "ClickStack",
+				"severity":       severity,
+				"custom_details": map[string]string{"body": "{{body}}"},
+			},
+			"links": []map[string]string{{"href": "{{link}}", "text": "Open in ClickStack"}},
+		}
+	case webhookTemplateOpsgenie:
+		// Alert API: https://api.opsgenie.com/v2/alerts with an
+		// "Authorization: GenieKey <key>" header.
+		body = map[string]any{ //nolint:forbidigo // each template has its own document shape
+			"message":     "{{title}}",
+			"description": "{{body}}",
+			"source":      "ClickStack",
+			"details":     map[string]string{"link": "{{link}}"},
+		}
+	case webhookTemplateMSTeams:
+		// An Adaptive Card, as accepted by a Teams "When a Teams webhook
+		// request is received" workflow.
+		body = map[string]any{ //nolint:forbidigo // each template has its own document shape
+			"type": "message",
+			"attachments": []map[string]any{{ //nolint:forbidigo // each template has its own document shape
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]any{ //nolint:forbidigo // each template has its own document shape
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body": []map[string]any{ //nolint:forbidigo // each template has its own document shape
+						{"type": "TextBlock", "text": "{{title}}", "weight": "Bolder", "wrap": true},
+						{"type": "TextBlock", "text": "{{body}}", "wrap": true},
+					},
+					"actions": []map[string]string{
+						{"type": "Action.OpenUrl", "title": "Open in ClickStack", "url": "{{link}}"},
+					},
+				},
+			}},
+		}
+	default:
+		return "", fmt.Errorf("unknown body template type %q", t.Type.ValueString())
+	}
+	out, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("render %s body template: %w", t.Type.ValueString(), err)
+	}
+	return string(out), nil
+}
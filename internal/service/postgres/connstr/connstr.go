@@ -0,0 +1,135 @@
+// Package connstr builds the client connection strings of a Managed Postgres
+// instance in the three forms drivers take: a libpq URI, a pgJDBC URL and a
+// libpq keyword/value string. Every form verifies the server certificate
+// (sslmode=verify-full) against the CA at SSLRootCert.
+package connstr
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DirectPort is the Postgres server's own port.
+	DirectPort = 5432
+	// PoolerPort is PgBouncer's port on the same host.
+	PoolerPort = 6432
+	// DefaultDatabase is the database every instance is created with.
+	DefaultDatabase = "postgres"
+)
+
+// Params are the inputs shared by every connection string. An empty Password
+// is left out, for clients that take it from PGPASSWORD or a pgpass file; an
+// empty SSLRootCert leaves sslrootcert out, so libpq and pgJDBC read the CA
+// from their default ~/.postgresql/root.crt.
+type Params struct {
+	Host        string
+	Database    string
+	Username    string
+	Password    string
+	SSLRootCert string
+}
+
+// Forms is one endpoint rendered in each supported syntax.
+type Forms struct {
+	URI          string
+	JDBC         string
+	KeywordValue string
+}
+
+// Strings holds the connection strings of one instance. ReadOnly is nil for
+// a primary: only a read replica serves read-only sessions.
+type Strings struct {
+	Direct   Forms
+	Pooled   Forms
+	ReadOnly *Forms
+}
+
+// Build renders the connection strings for an instance; replica selects
+// whether the read-only variant is included.
+func Build(p Params, replica bool) Strings {
+	s := Strings{
+		Direct: render(p, DirectPort, nil, nil),
+		// PgBouncer runs in transaction mode, where pgJDBC's server-side
+		// prepared statements break; prepareThreshold=0 turns them off.
+		Pooled: render(p, PoolerPort, nil, [][2]string{{"prepareThreshold", "0"}}),
+	}
+	if replica {
+		// Refuse the session if the replica has since been promoted.
+		ro := render(p, DirectPort,
+			[][2]string{{"target_session_attrs", "read-only"}},
+			[][2]string{{"targetServerType", "secondary"}})
+		s.ReadOnly = &ro
+	}
+	return s
+}
+
+// render formats one endpoint. libpqExtra and jdbcExtra are parameters added
+// to the libpq forms and to the JDBC URL respectively.
+func render(p Params, port int, libpqExtra, jdbcExtra [][2]string) Forms {
+	db := p.Database
+	if db == "" {
+		db = DefaultDatabase
+	}
+	hostPort := net.JoinHostPort(p.Host, strconv.Itoa(port))
+
+	ssl := [][2]string{{"sslmode", "verify-full"}}
+	if p.SSLRootCert != "" {
+		ssl = append(ssl, [2]string{"sslrootcert", p.SSLRootCert})
+	}
+
+	// libpq URI: credentials in the userinfo, everything else in the query.
+	u := url.URL{Scheme: "postgresql", Host: hostPort, Path: "/" + db}
+	if p.Password != "" {
+		u.User = url.UserPassword(p.Username, p.Password)
+	} else {
+		u.User = url.User(p.Username)
+	}
+	u.RawQuery = query(ssl, libpqExtra)
+
+	// pgJDBC takes no userinfo, so credentials go in the query as well.
+	creds := [][2]string{{"user", p.Username}}
+	if p.Password != "" {
+		creds = append(creds, [2]string{"password", p.Password})
+	}
+	jdbc := "jdbc:postgresql://" + hostPort + "/" + url.PathEscape(db) + "?" + query(creds, ssl, jdbcExtra)
+
+	kv := [][2]string{{"host", p.Host}, {"port", strconv.Itoa(port)}, {"dbname", db}, {"user", p.Username}}
+	if p.Password != "" {
+		kv = append(kv, [2]string{"password", p.Password})
+	}
+	kv = append(append(kv, ssl...), libpqExtra...)
+
+	return Forms{URI: u.String(), JDBC: jdbc, KeywordValue: keywordValue(kv)}
+}
+
+// query encodes params in the given order; url.Values would sort them.
+func query(groups ...[][2]string) string {
+	var parts []string
+	for _, g := range groups {
+		for _, kv := range g {
+			parts = append(parts, url.QueryEscape(kv[0])+"="+url.QueryEscape(kv[1]))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// keywordValue formats a libpq keyword/value string, quoting values that are
+// empty or contain whitespace, quotes or backslashes.
+func keywordValue(params [][2]string) string {
+	parts := make([]string, 0, len(params))
+	for _, kv := range params {
+		parts = append(parts, kv[0]+"="+quoteValue(kv[1]))
+	}
+	return strings.Join(parts, " ")
+}
+
+func quoteValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, " \t\n\r\f\v'\\") {
+		return v
+	}
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + r.Replace(v) + "'"
+}
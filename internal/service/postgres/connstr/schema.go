@@ -0,0 +1,68 @@
+package connstr
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// The attributes of ObjectType, described once for the resource, data source
+// and ephemeral resource schemas below.
+var (
+	formsDescriptions = map[string]string{
+		"uri":           "libpq connection URI (`postgresql://...`).",
+		"jdbc":          "pgJDBC URL (`jdbc:postgresql://...`).",
+		"keyword_value": "libpq keyword/value connection string (`host=... port=...`).",
+	}
+	endpointDescriptions = map[string]string{
+		"direct":    "Direct connection to Postgres on port 5432.",
+		"pooled":    "Connection through PgBouncer on port 6432.",
+		"read_only": "Read-only connection, set on a read replica. Null for a primary: the API does not list a primary's replicas, so take it from the replica's own `connection_strings.read_only`.",
+	}
+	caCertificateDescription = "PEM-encoded CA certificate chain that signs the server certificate."
+)
+
+// ResourceAttributes describes ObjectType in a resource schema.
+func ResourceAttributes() map[string]rschema.Attribute {
+	forms := make(map[string]rschema.Attribute, len(formsDescriptions))
+	for name, desc := range formsDescriptions {
+		forms[name] = rschema.StringAttribute{Description: desc, Computed: true}
+	}
+	attrs := map[string]rschema.Attribute{
+		"ca_certificate": rschema.StringAttribute{Description: caCertificateDescription, Computed: true},
+	}
+	for name, desc := range endpointDescriptions {
+		attrs[name] = rschema.SingleNestedAttribute{Description: desc, Computed: true, Attributes: forms}
+	}
+	return attrs
+}
+
+// DataSourceAttributes describes ObjectType in a data source schema.
+func DataSourceAttributes() map[string]dschema.Attribute {
+	forms := make(map[string]dschema.Attribute, len(formsDescriptions))
+	for name, desc := range formsDescriptions {
+		forms[name] = dschema.StringAttribute{Description: desc, Computed: true}
+	}
+	attrs := map[string]dschema.Attribute{
+		"ca_certificate": dschema.StringAttribute{Description: caCertificateDescription, Computed: true},
+	}
+	for name, desc := range endpointDescriptions {
+		attrs[name] = dschema.SingleNestedAttribute{Description: desc, Computed: true, Attributes: forms}
+	}
+	return attrs
+}
+
+// EphemeralAttributes describes ObjectType in an ephemeral resource schema.
+func EphemeralAttributes() map[string]eschema.Attribute {
+	forms := make(map[string]eschema.Attribute, len(formsDescriptions))
+	for name, desc := range formsDescriptions {
+		forms[name] = eschema.StringAttribute{Description: desc, Computed: true}
+	}
+	attrs := map[string]eschema.Attribute{
+		"ca_certificate": eschema.StringAttribute{Description: caCertificateDescription, Computed: true},
+	}
+	for name, desc := range endpointDescriptions {
+		attrs[name] = eschema.SingleNestedAttribute{Description: desc, Computed: true, Attributes: forms}
+	}
+	return attrs
+}
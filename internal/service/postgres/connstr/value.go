@@ -0,0 +1,55 @@
+package connstr
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FormsType is the object type of one endpoint (direct, pooled, read_only).
+var FormsType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"uri":           types.StringType,
+		"jdbc":          types.StringType,
+		"keyword_value": types.StringType,
+	},
+}
+
+// ObjectType is the type of the connection_strings attribute shared by the
+// Postgres service resource, its data source and the ephemeral resource.
+var ObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"direct":         FormsType,
+		"pooled":         FormsType,
+		"read_only":      FormsType,
+		"ca_certificate": types.StringType,
+	},
+}
+
+// Null is a null connection_strings value, for an instance that has no
+// hostname yet.
+func Null() types.Object {
+	return types.ObjectNull(ObjectType.AttrTypes)
+}
+
+// ObjectValue converts s into a connection_strings value; caPEM is exposed
+// alongside for clients that take the CA inline rather than from a file.
+func ObjectValue(s Strings, caPEM string) types.Object {
+	readOnly := types.ObjectNull(FormsType.AttrTypes)
+	if s.ReadOnly != nil {
+		readOnly = formsValue(*s.ReadOnly)
+	}
+	return types.ObjectValueMust(ObjectType.AttrTypes, map[string]attr.Value{
+		"direct":         formsValue(s.Direct),
+		"pooled":         formsValue(s.Pooled),
+		"read_only":      readOnly,
+		"ca_certificate": types.StringValue(caPEM),
+	})
+}
+
+func formsValue(f Forms) types.Object {
+	return types.ObjectValueMust(FormsType.AttrTypes, map[string]attr.Value{
+		"uri":           types.StringValue(f.URI),
+		"jdbc":          types.StringValue(f.JDBC),
+		"keyword_value": types.StringValue(f.KeywordValue),
+	})
+}
@@ -0,0 +1,154 @@
+package datasource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+)
+
+//go:embed descriptions/postgres_backups.md
+var postgresBackupsDataSourceDescription string
+
+var _ datasource.DataSource = &postgresBackupsDataSource{}
+
+// NewPostgresBackupsDataSource lists a Managed Postgres service's backups and
+// its point-in-time restore window.
+func NewPostgresBackupsDataSource() datasource.DataSource {
+	return &postgresBackupsDataSource{}
+}
+
+type postgresBackupsDataSource struct {
+	client api.Client
+}
+
+type postgresBackupsDataSourceModel struct {
+	ServiceID             types.String `tfsdk:"service_id"`
+	EarliestRestoreTarget types.String `tfsdk:"earliest_restore_target"`
+	LatestRestoreTarget   types.String `tfsdk:"latest_restore_target"`
+	Backups               types.List   `tfsdk:"backups"`
+}
+
+// postgresBackupObjectType is the element type of the backups list.
+func postgresBackupObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":          types.StringType,
+			"status":      types.StringType,
+			"started_at":  types.StringType,
+			"finished_at": types.StringType,
+		},
+	}
+}
+
+func (d *postgresBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	d.client = providerData.API
+}
+
+func (d *postgresBackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_backups"
+}
+
+func (d *postgresBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresBackupsDataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Description: "ID of the Postgres service whose backups to list.",
+				Required:    true,
+			},
+			"earliest_restore_target": schema.StringAttribute{
+				Description: "Earliest RFC3339 timestamp a point-in-time restore can target. Null until the first backup completes.",
+				Computed:    true,
+			},
+			"latest_restore_target": schema.StringAttribute{
+				Description: "Latest RFC3339 timestamp a point-in-time restore can currently target. Null until the first backup completes.",
+				Computed:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "The service's base backups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"status":      schema.StringAttribute{Computed: true},
+						"started_at":  schema.StringAttribute{Computed: true},
+						"finished_at": schema.StringAttribute{Computed: true, Description: "Null while the backup is in progress."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *postgresBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data postgresBackupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backups, err := d.client.ListPostgresBackups(ctx, data.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Postgres backups",
+			"Could not list backups for Postgres service "+data.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	objType := postgresBackupObjectType()
+	elems := make([]attr.Value, 0, len(backups.Backups))
+	for _, b := range backups.Backups {
+		obj, diags := types.ObjectValue(objType.AttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(b.Id),
+			"status":      types.StringValue(b.Status),
+			"started_at":  types.StringValue(b.StartedAt),
+			"finished_at": optionalString(b.FinishedAt),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elems = append(elems, obj)
+	}
+	list, diags := types.ListValue(objType, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.EarliestRestoreTarget = optionalString(backups.EarliestRestoreTarget)
+	data.LatestRestoreTarget = optionalString(backups.LatestRestoreTarget)
+	data.Backups = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalString maps an omitted (empty) server field to null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
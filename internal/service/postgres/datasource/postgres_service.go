@@ -13,13 +13,14 @@ import (
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
 )
 
 //go:embed descriptions/postgres_service.md
 var postgresServiceDataSourceDescription string
 
 // postgresDefaultPort mirrors the resource's hardcoded listening port.
-const postgresDefaultPort int64 = 5432
+const postgresDefaultPort int64 = connstr.DirectPort
 
 var _ datasource.DataSource = &postgresServiceDataSource{}
 
@@ -49,6 +50,19 @@ type postgresServiceDataSourceModel struct {
 	Tags            types.Map    `tfsdk:"tags"`
 	PgConfig        types.Map    `tfsdk:"pg_config"`
 	PgBouncerConfig types.Map    `tfsdk:"pgbouncer_config"`
+
+	IpAccess           types.Set `tfsdk:"ip_access"`
+	PrivateEndpointIDs types.Set `tfsdk:"private_endpoint_ids"`
+
+	SSLRootCertPath   types.String `tfsdk:"ssl_root_cert_path"`
+	ConnectionStrings types.Object `tfsdk:"connection_strings"`
+}
+
+var postgresIPAccessType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"source":      types.StringType,
+		"description": types.StringType,
+	},
 }
 
 func (d *postgresServiceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -105,6 +119,31 @@ func (d *postgresServiceDataSource) Schema(_ context.Context, _ datasource.Schem
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"ip_access": schema.SetNestedAttribute{
+				Description: "IP addresses allowed to connect to the instance.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source":      schema.StringAttribute{Description: "IP address or CIDR range.", Computed: true},
+						"description": schema.StringAttribute{Description: "Description of the entry.", Computed: true},
+					},
+				},
+			},
+			"private_endpoint_ids": schema.SetAttribute{
+				Description: "IDs of private endpoints attached to the instance.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"ssl_root_cert_path": schema.StringAttribute{
+				Description: "Path the client reads the CA certificate from, used as `sslrootcert` in `connection_strings`. When unset, `sslrootcert` is omitted and libpq and pgJDBC fall back to `~/.postgresql/root.crt`.",
+				Optional:    true,
+			},
+			"connection_strings": schema.SingleNestedAttribute{
+				Description: "Connection strings for the instance, without a password (the API does not return it). Null until the instance has a hostname.",
+				Computed:    true,
+				Sensitive:   true,
+				Attributes:  connstr.DataSourceAttributes(),
+			},
 		},
 	}
 }
@@ -151,12 +190,37 @@ func (d *postgresServiceDataSource) Read(ctx context.Context, req datasource.Rea
 	resp.Diagnostics.Append(diags...)
 	pbCfg, diags := pgConfigToStringMap(cfg.PgBouncerConfig)
 	resp.Diagnostics.Append(diags...)
+	ipAccess, diags := apiIPAccessToSet(pg.IpAccessList)
+	resp.Diagnostics.Append(diags...)
+	ids := pg.PrivateEndpointIds
+	if ids == nil {
+		ids = []string{} // empty set, not null, like tags
+	}
+	endpointIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	data.Tags = tags
 	data.PgConfig = pgCfg
 	data.PgBouncerConfig = pbCfg
+	data.IpAccess = ipAccess
+	data.PrivateEndpointIDs = endpointIDs
+
+	data.ConnectionStrings = connstr.Null()
+	if pg.Hostname != "" {
+		ca, err := d.client.GetPostgresCaCertificates(ctx, pg.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Postgres CA certificates", "Could not fetch CA certificates for Postgres service "+pg.Id+": "+err.Error())
+			return
+		}
+		strs := connstr.Build(connstr.Params{
+			Host:        pg.Hostname,
+			Username:    pg.Username,
+			SSLRootCert: data.SSLRootCertPath.ValueString(),
+		}, !pg.IsPrimary)
+		data.ConnectionStrings = connstr.ObjectValue(strs, string(ca))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -195,3 +259,17 @@ func pgConfigToStringMap(c api.PgConfigMap) (types.Map, diag.Diagnostics) {
 	}
 	return types.MapValue(types.StringType, m)
 }
+
+// apiIPAccessToSet converts the server's ipAccessList to the ip_access set,
+// matching the resource layer's apiIPAccessToSetValue (empty input is an
+// empty set; an empty description is null).
+func apiIPAccessToSet(entries []api.IpAccess) (types.Set, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(entries))
+	for _, e := range entries {
+		values = append(values, types.ObjectValueMust(postgresIPAccessType.AttrTypes, map[string]attr.Value{
+			"source":      types.StringValue(e.Source),
+			"description": strOrNull(e.Description),
+		}))
+	}
+	return types.SetValue(postgresIPAccessType, values)
+}
@@ -0,0 +1,145 @@
+package ephemeral
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
+)
+
+//go:embed descriptions/postgres_connection_strings.md
+var postgresConnectionStringsDescription string
+
+var _ ephemeral.EphemeralResourceWithConfigure = &postgresConnectionStringsEphemeralResource{}
+
+// NewPostgresConnectionStringsEphemeralResource builds a Managed Postgres
+// service's connection strings without persisting them.
+func NewPostgresConnectionStringsEphemeralResource() ephemeral.EphemeralResource {
+	return &postgresConnectionStringsEphemeralResource{}
+}
+
+type postgresConnectionStringsEphemeralResource struct {
+	client api.Client
+}
+
+type postgresConnectionStringsModel struct {
+	ServiceID         types.String `tfsdk:"service_id"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	Database          types.String `tfsdk:"database"`
+	SSLRootCertPath   types.String `tfsdk:"ssl_root_cert_path"`
+	ConnectionStrings types.Object `tfsdk:"connection_strings"`
+}
+
+func (e *postgresConnectionStringsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	e.client = providerData.API
+}
+
+func (e *postgresConnectionStringsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_connection_strings"
+}
+
+func (e *postgresConnectionStringsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresConnectionStringsDescription,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Description: "ID of the Postgres service to connect to.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "User to connect as. Defaults to the instance's superuser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password to embed. When unset, the password the API returns for the superuser is used if credential redaction is off; otherwise the strings carry no password.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"database": schema.StringAttribute{
+				Description: "Database to connect to. Defaults to `postgres`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssl_root_cert_path": schema.StringAttribute{
+				Description: "Path the client reads the CA certificate from, used as `sslrootcert`. When unset, `sslrootcert` is omitted and libpq and pgJDBC fall back to `~/.postgresql/root.crt`.",
+				Optional:    true,
+			},
+			"connection_strings": schema.SingleNestedAttribute{
+				Description: "Connection strings with `sslmode=verify-full`, each as a libpq URI, a JDBC URL and a keyword/value string.",
+				Computed:    true,
+				Sensitive:   true,
+				Attributes:  connstr.EphemeralAttributes(),
+			},
+		},
+	}
+}
+
+func (e *postgresConnectionStringsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data postgresConnectionStringsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := data.ServiceID.ValueString()
+
+	pg, err := e.client.GetPostgres(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres service", "Could not read Postgres service "+id+": "+err.Error())
+		return
+	}
+	if pg.Hostname == "" {
+		resp.Diagnostics.AddError("Postgres service is not ready",
+			fmt.Sprintf("Postgres service %s has no hostname yet (state %q).", id, pg.State))
+		return
+	}
+	ca, err := e.client.GetPostgresCaCertificates(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres CA certificates", "Could not fetch CA certificates for Postgres service "+id+": "+err.Error())
+		return
+	}
+
+	if data.Username.IsNull() || data.Username.IsUnknown() {
+		data.Username = types.StringValue(pg.Username)
+	}
+	if data.Database.IsNull() || data.Database.IsUnknown() {
+		data.Database = types.StringValue(connstr.DefaultDatabase)
+	}
+	password := data.Password.ValueString()
+	if data.Password.IsNull() && data.Username.ValueString() == pg.Username {
+		password = pg.Password
+	}
+
+	strs := connstr.Build(connstr.Params{
+		Host:        pg.Hostname,
+		Database:    data.Database.ValueString(),
+		Username:    data.Username.ValueString(),
+		Password:    password,
+		SSLRootCert: data.SSLRootCertPath.ValueString(),
+	}, !pg.IsPrimary)
+	data.ConnectionStrings = connstr.ObjectValue(strs, string(ca))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
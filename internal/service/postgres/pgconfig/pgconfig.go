@@ -0,0 +1,213 @@
+// Package pgconfig is a snapshot of the Postgres and PgBouncer parameters a
+// Managed Postgres instance accepts in pg_config / pgbouncer_config, with their
+// type, range, unit and whether changing them needs a restart. Bump
+// catalog.json in a patch release when the server allows new parameters.
+package pgconfig
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Sections of the catalog, named after the resource attributes.
+const (
+	SectionPg        = "pg_config"
+	SectionPgBouncer = "pgbouncer_config"
+)
+
+// Parameter types.
+const (
+	TypeBool    = "bool"
+	TypeInteger = "integer"
+	TypeReal    = "real"
+	TypeEnum    = "enum"
+	TypeString  = "string"
+)
+
+// Parameter describes one settable parameter. Min and Max are in Unit, the
+// unit a bare number is taken in (e.g. `8kB` for shared_buffers); Unit is
+// empty for unitless parameters. Versions lists the Postgres majors the entry
+// applies to; empty means all.
+type Parameter struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Unit     string   `json:"unit,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	Values   []string `json:"values,omitempty"`
+	Restart  bool     `json:"restart,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+//go:embed catalog.json
+var catalogJSON []byte
+
+var catalog = mustLoadCatalog(catalogJSON)
+
+func mustLoadCatalog(data []byte) map[string][]Parameter {
+	var c map[string][]Parameter
+	if err := json.Unmarshal(data, &c); err != nil {
+		panic("pgconfig: invalid catalog.json: " + err.Error())
+	}
+	return c
+}
+
+// Lookup returns the parameter called name in section for the given Postgres
+// major version. Names are case-insensitive, as in Postgres. An empty version
+// matches an entry for any version, for when the version is not known yet.
+func Lookup(section, version, name string) (Parameter, bool) {
+	name = strings.ToLower(name)
+	for _, p := range catalog[section] {
+		if p.Name != name {
+			continue
+		}
+		if version == "" || len(p.Versions) == 0 || slices.Contains(p.Versions, version) {
+			return p, true
+		}
+	}
+	return Parameter{}, false
+}
+
+// Validate reports whether value is acceptable for p.
+func (p Parameter) Validate(value string) error {
+	switch p.Type {
+	case TypeBool:
+		if _, ok := parseBool(value); !ok {
+			return fmt.Errorf("%q is not a boolean; use on or off", value)
+		}
+	case TypeEnum:
+		if !slices.ContainsFunc(p.Values, func(v string) bool { return strings.EqualFold(v, value) }) {
+			return fmt.Errorf("%q is not one of %s", value, strings.Join(p.Values, ", "))
+		}
+	case TypeInteger, TypeReal:
+		n, err := p.parseNumber(value)
+		if err != nil {
+			return err
+		}
+		if (p.Min != nil && n < *p.Min) || (p.Max != nil && n > *p.Max) {
+			return fmt.Errorf("%q is out of range; must be between %s and %s%s", value, formatBound(p.Min), formatBound(p.Max), p.unitSuffix())
+		}
+	}
+	return nil
+}
+
+// Equivalent reports whether a and b denote the same setting, e.g. `256MB` and
+// `262144kB`, or `on` and `true`. Values that do not parse are compared
+// verbatim.
+func (p Parameter) Equivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	switch p.Type {
+	case TypeBool:
+		x, okA := parseBool(a)
+		y, okB := parseBool(b)
+		return okA && okB && x == y
+	case TypeEnum:
+		return strings.EqualFold(a, b)
+	case TypeInteger, TypeReal:
+		x, errA := p.parseNumber(a)
+		y, errB := p.parseNumber(b)
+		if errA != nil || errB != nil {
+			return false
+		}
+		if p.Type == TypeInteger {
+			// Postgres rounds a value given in another unit to the base unit.
+			return math.Round(x) == math.Round(y)
+		}
+		return x == y
+	}
+	return false
+}
+
+func (p Parameter) unitSuffix() string {
+	if p.Unit == "" {
+		return ""
+	}
+	return " (in " + p.Unit + ")"
+}
+
+// parseNumber parses value, with an optional unit suffix, into p's unit.
+func (p Parameter) parseNumber(value string) (float64, error) {
+	s := strings.TrimSpace(value)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+' && r != 'e' && r != 'E'
+	})
+	num, suffix := s, ""
+	if i >= 0 {
+		num, suffix = s[:i], strings.TrimSpace(s[i:])
+	}
+	n, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	if suffix != "" {
+		if p.Unit == "" {
+			return 0, fmt.Errorf("%q has a unit but the parameter takes a plain number", value)
+		}
+		base, family, ok := unitFactor(p.Unit)
+		from, fromFamily, fromOK := unitFactor(suffix)
+		if !ok || !fromOK || family != fromFamily {
+			return 0, fmt.Errorf("%q has an invalid unit for a parameter measured in %s", value, p.Unit)
+		}
+		n = n * from / base
+	}
+	if p.Type == TypeInteger && suffix == "" && n != math.Trunc(n) {
+		return 0, fmt.Errorf("%q is not an integer", value)
+	}
+	return n, nil
+}
+
+// unitFactor returns the size of unit in bytes or microseconds, and which of
+// the two it measures. Units are case-sensitive, as in Postgres.
+func unitFactor(unit string) (float64, string, bool) {
+	switch unit {
+	case "B":
+		return 1, "memory", true
+	case "kB":
+		return 1 << 10, "memory", true
+	case "8kB":
+		return 8 << 10, "memory", true
+	case "MB":
+		return 1 << 20, "memory", true
+	case "GB":
+		return 1 << 30, "memory", true
+	case "TB":
+		return 1 << 40, "memory", true
+	case "us":
+		return 1, "time", true
+	case "ms":
+		return 1e3, "time", true
+	case "s":
+		return 1e6, "time", true
+	case "min":
+		return 60e6, "time", true
+	case "h":
+		return 3600e6, "time", true
+	case "d":
+		return 86400e6, "time", true
+	}
+	return 0, "", false
+}
+
+func parseBool(value string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "on", "true", "yes", "1":
+		return true, true
+	case "off", "false", "no", "0":
+		return false, true
+	}
+	return false, false
+}
+
+func formatBound(b *float64) string {
+	if b == nil {
+		return "unbounded"
+	}
+	return strconv.FormatFloat(*b, 'g', -1, 64)
+}
@@ -0,0 +1,107 @@
+package pgconfig
+
+import "testing"
+
+func TestCatalogEntries(t *testing.T) {
+	for section, params := range catalog {
+		for _, p := range params {
+			switch p.Type {
+			case TypeBool, TypeInteger, TypeReal, TypeString:
+			case TypeEnum:
+				if len(p.Values) == 0 {
+					t.Errorf("%s.%s: enum without values", section, p.Name)
+				}
+			default:
+				t.Errorf("%s.%s: unknown type %q", section, p.Name, p.Type)
+			}
+			if p.Unit != "" {
+				if _, _, ok := unitFactor(p.Unit); !ok {
+					t.Errorf("%s.%s: unknown unit %q", section, p.Name, p.Unit)
+				}
+			}
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if _, ok := Lookup(SectionPg, "18", "TimeZone"); !ok {
+		t.Error("names must be case-insensitive")
+	}
+	if _, ok := Lookup(SectionPg, "17", "io_method"); ok {
+		t.Error("io_method is Postgres 18 only")
+	}
+	if _, ok := Lookup(SectionPg, "", "io_method"); !ok {
+		t.Error("an empty version must match any version")
+	}
+	p17, _ := Lookup(SectionPg, "17", "log_connections")
+	p18, _ := Lookup(SectionPg, "18", "log_connections")
+	if p17.Type != TypeBool || p18.Type != TypeString {
+		t.Errorf("log_connections: got %s / %s; want bool / string", p17.Type, p18.Type)
+	}
+	if _, ok := Lookup(SectionPgBouncer, "18", "shared_buffers"); ok {
+		t.Error("sections must not leak into each other")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name, value string
+		ok          bool
+	}{
+		{"work_mem", "4MB", true},
+		{"work_mem", "4096", true},
+		{"work_mem", "1.5GB", true},
+		{"work_mem", "32kB", false},
+		{"work_mem", "4mb", false},
+		{"work_mem", "4s", false},
+		{"work_mem", "lots", false},
+		{"shared_buffers", "128kB", true},
+		{"shared_buffers", "64kB", false},
+		{"max_connections", "200", true},
+		{"max_connections", "200.5", false},
+		{"max_connections", "200MB", false},
+		{"statement_timeout", "30s", true},
+		{"statement_timeout", "-1", false},
+		{"checkpoint_completion_target", "0.9", true},
+		{"checkpoint_completion_target", "1.5", false},
+		{"jit", "off", true},
+		{"jit", "maybe", false},
+		{"wal_level", "LOGICAL", true},
+		{"wal_level", "minimal", false},
+		{"shared_preload_libraries", "pg_stat_statements,pg_cron", true},
+	}
+	for _, c := range cases {
+		p, ok := Lookup(SectionPg, "18", c.name)
+		if !ok {
+			t.Fatalf("%s not in catalog", c.name)
+		}
+		if err := p.Validate(c.value); (err == nil) != c.ok {
+			t.Errorf("Validate(%s=%q) = %v; want ok=%v", c.name, c.value, err, c.ok)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	cases := []struct {
+		name, a, b string
+		want       bool
+	}{
+		{"work_mem", "256MB", "262144kB", true},
+		{"work_mem", "256MB", "262144", true},
+		{"work_mem", "256MB", "128MB", false},
+		{"shared_buffers", "128MB", "16384", true},
+		{"statement_timeout", "1min", "60000", true},
+		{"statement_timeout", "1min", "60s", true},
+		{"jit", "on", "true", true},
+		{"jit", "on", "off", false},
+		{"wal_level", "Logical", "logical", true},
+		{"timezone", "UTC", "utc", false},
+		{"work_mem", "lots", "lots", true},
+	}
+	for _, c := range cases {
+		p, _ := Lookup(SectionPg, "18", c.name)
+		if got := p.Equivalent(c.a, c.b); got != c.want {
+			t.Errorf("Equivalent(%s: %q, %q) = %v; want %v", c.name, c.a, c.b, got, c.want)
+		}
+	}
+}
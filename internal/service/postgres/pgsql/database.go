@@ -0,0 +1,81 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Database is a row of pg_database. Encoding is create-time only.
+type Database struct {
+	Name            string
+	Owner           string
+	Encoding        string
+	ConnectionLimit int64
+}
+
+// GetDatabase returns ErrNotFound when no database has the given name.
+func (c *Conn) GetDatabase(ctx context.Context, name string) (*Database, error) {
+	var d Database
+	err := c.conn.QueryRow(ctx,
+		`SELECT datname, pg_get_userbyid(datdba), pg_encoding_to_char(encoding), datconnlimit
+		   FROM pg_database WHERE datname = $1`, name,
+	).Scan(&d.Name, &d.Owner, &d.Encoding, &d.ConnectionLimit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database %q: %w", name, err)
+	}
+	return &d, nil
+}
+
+// CreateDatabase creates d. Empty Owner / Encoding use the server defaults
+// (the connecting role / the template's encoding).
+func (c *Conn) CreateDatabase(ctx context.Context, d Database) error {
+	if err := c.exec(ctx, createDatabaseSQL(d)); err != nil {
+		return fmt.Errorf("failed to create database %q: %w", d.Name, err)
+	}
+	return nil
+}
+
+// UpdateDatabase applies the owner and connection limit of d to the database
+// currently named name.
+func (c *Conn) UpdateDatabase(ctx context.Context, name string, d Database) error {
+	if err := c.exec(ctx, alterDatabaseSQL(name, d)...); err != nil {
+		return fmt.Errorf("failed to update database %q: %w", name, err)
+	}
+	return nil
+}
+
+// DropDatabase drops the database; a missing database is not an error.
+func (c *Conn) DropDatabase(ctx context.Context, name string) error {
+	if err := c.exec(ctx, "DROP DATABASE IF EXISTS "+QuoteIdentifier(name)); err != nil {
+		return fmt.Errorf("failed to drop database %q: %w", name, err)
+	}
+	return nil
+}
+
+func createDatabaseSQL(d Database) string {
+	var b strings.Builder
+	b.WriteString("CREATE DATABASE " + QuoteIdentifier(d.Name))
+	if d.Owner != "" {
+		b.WriteString(" OWNER " + QuoteIdentifier(d.Owner))
+	}
+	if d.Encoding != "" {
+		b.WriteString(" ENCODING " + QuoteLiteral(d.Encoding))
+	}
+	fmt.Fprintf(&b, " CONNECTION LIMIT %d", d.ConnectionLimit)
+	return b.String()
+}
+
+func alterDatabaseSQL(name string, d Database) []string {
+	stmts := []string{fmt.Sprintf("ALTER DATABASE %s CONNECTION LIMIT %d", QuoteIdentifier(name), d.ConnectionLimit)}
+	if d.Owner != "" {
+		stmts = append(stmts, "ALTER DATABASE "+QuoteIdentifier(name)+" OWNER TO "+QuoteIdentifier(d.Owner))
+	}
+	return stmts
+}
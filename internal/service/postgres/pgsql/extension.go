@@ -0,0 +1,72 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Extension is a row of pg_extension in the Conn's database. Empty Schema /
+// Version on create use the server defaults (the first schema on the search
+// path / the extension's default version).
+type Extension struct {
+	Name    string
+	Schema  string
+	Version string
+}
+
+// GetExtension returns ErrNotFound when the extension is not installed in the
+// Conn's database.
+func (c *Conn) GetExtension(ctx context.Context, name string) (*Extension, error) {
+	var e Extension
+	err := c.conn.QueryRow(ctx,
+		`SELECT e.extname, n.nspname, e.extversion
+		   FROM pg_extension e JOIN pg_namespace n ON n.oid = e.extnamespace
+		  WHERE e.extname = $1`, name,
+	).Scan(&e.Name, &e.Schema, &e.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read extension %q: %w", name, err)
+	}
+	return &e, nil
+}
+
+// CreateExtension installs e.
+func (c *Conn) CreateExtension(ctx context.Context, e Extension) error {
+	if err := c.exec(ctx, createExtensionSQL(e)); err != nil {
+		return fmt.Errorf("failed to create extension %q: %w", e.Name, err)
+	}
+	return nil
+}
+
+// UpdateExtension moves the installed extension to version.
+func (c *Conn) UpdateExtension(ctx context.Context, name, version string) error {
+	if err := c.exec(ctx, "ALTER EXTENSION "+QuoteIdentifier(name)+" UPDATE TO "+QuoteLiteral(version)); err != nil {
+		return fmt.Errorf("failed to update extension %q to %s: %w", name, version, err)
+	}
+	return nil
+}
+
+// DropExtension removes the extension; a missing extension is not an error.
+// Objects that depend on it make the server refuse (no CASCADE).
+func (c *Conn) DropExtension(ctx context.Context, name string) error {
+	if err := c.exec(ctx, "DROP EXTENSION IF EXISTS "+QuoteIdentifier(name)); err != nil {
+		return fmt.Errorf("failed to drop extension %q: %w", name, err)
+	}
+	return nil
+}
+
+func createExtensionSQL(e Extension) string {
+	stmt := "CREATE EXTENSION " + QuoteIdentifier(e.Name)
+	if e.Schema != "" {
+		stmt += " SCHEMA " + QuoteIdentifier(e.Schema)
+	}
+	if e.Version != "" {
+		stmt += " VERSION " + QuoteLiteral(e.Version)
+	}
+	return stmt
+}
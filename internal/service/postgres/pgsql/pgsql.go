@@ -0,0 +1,113 @@
+// Package pgsql runs SQL against a Managed Postgres instance over the Postgres
+// wire protocol. Like the ClickStack client it is free of any
+// terraform-plugin-framework types: the resources in the postgres service
+// package resolve connection details through the ClickHouse Cloud API and
+// translate between Terraform models and the types defined here.
+package pgsql
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrNotFound is returned when the database object being read does not exist.
+// Callers use errors.Is to drop a deleted object from Terraform state.
+var ErrNotFound = errors.New("not found")
+
+// connectTimeout bounds the TCP + TLS + auth handshake. Statements run under
+// the caller's context.
+const connectTimeout = 30 * time.Second
+
+// Config describes how to reach an instance. RootCAs is the PEM bundle the
+// server certificate must chain to; the hostname is verified against Host
+// (the equivalent of sslmode=verify-full).
+type Config struct {
+	Host     string
+	Port     uint16
+	User     string
+	Password string
+	Database string
+	RootCAs  []byte
+}
+
+// Conn is a single session to one database of an instance. Postgres scopes a
+// session to a database, so per-database objects (extensions) need a Conn
+// opened on that database; cluster-wide objects (databases, roles) can use
+// any.
+type Conn struct {
+	conn *pgx.Conn
+}
+
+// Connect opens a verified-TLS session. Plaintext and unverified fallbacks are
+// never attempted.
+func Connect(ctx context.Context, cfg Config) (*Conn, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(cfg.RootCAs) {
+		return nil, errors.New("no valid CA certificate in the instance's CA bundle")
+	}
+
+	connCfg, err := pgx.ParseConfig("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build connection config: %w", err)
+	}
+	connCfg.Host = cfg.Host
+	connCfg.Port = cfg.Port
+	connCfg.User = cfg.User
+	connCfg.Password = cfg.Password
+	connCfg.Database = cfg.Database
+	connCfg.ConnectTimeout = connectTimeout
+	connCfg.Fallbacks = nil
+	connCfg.TLSConfig = &tls.Config{
+		RootCAs:    pool,
+		ServerName: cfg.Host,
+		MinVersion: tls.VersionTLS12,
+	}
+	connCfg.RuntimeParams["application_name"] = "terraform-provider-clickhouse"
+
+	conn, err := pgx.ConnectConfig(ctx, connCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s:%d/%s: %w", cfg.Host, cfg.Port, cfg.Database, err)
+	}
+	return &Conn{conn: conn}, nil
+}
+
+// Close ends the session.
+func (c *Conn) Close(ctx context.Context) error {
+	return c.conn.Close(ctx)
+}
+
+func (c *Conn) exec(ctx context.Context, statements ...string) error {
+	for _, stmt := range statements {
+		if _, err := c.conn.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsAuthenticationFailure reports whether err is the server rejecting the
+// password (SQLSTATE 28P01), e.g. after the superuser password was rotated.
+func IsAuthenticationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "28P01"
+}
+
+// QuoteIdentifier returns s as a double-quoted SQL identifier.
+func QuoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// QuoteLiteral returns s as a single-quoted SQL string literal. Assumes
+// standard_conforming_strings (the default since Postgres 9.1), under which
+// backslashes are not escapes.
+func QuoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
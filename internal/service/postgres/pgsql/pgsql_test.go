@@ -0,0 +1,136 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestQuoting(t *testing.T) {
+	if got, want := QuoteIdentifier(`app"db`), `"app""db"`; got != want {
+		t.Errorf("QuoteIdentifier() = %s; want %s", got, want)
+	}
+	if got, want := QuoteLiteral(`it's \n`), `'it''s \n'`; got != want {
+		t.Errorf("QuoteLiteral() = %s; want %s", got, want)
+	}
+}
+
+func TestCreateDatabaseSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Database
+		want string
+	}{
+		{name: "defaults", in: Database{Name: "app", ConnectionLimit: -1}, want: `CREATE DATABASE "app" CONNECTION LIMIT -1`},
+		{name: "all options", in: Database{Name: "app", Owner: "app_owner", Encoding: "UTF8", ConnectionLimit: 20},
+			want: `CREATE DATABASE "app" OWNER "app_owner" ENCODING 'UTF8' CONNECTION LIMIT 20`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createDatabaseSQL(tt.in); got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlterDatabaseSQL(t *testing.T) {
+	got := alterDatabaseSQL("app", Database{Owner: "o", ConnectionLimit: 5})
+	want := []string{`ALTER DATABASE "app" CONNECTION LIMIT 5`, `ALTER DATABASE "app" OWNER TO "o"`}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
+
+func TestRoleOptionsSQL(t *testing.T) {
+	pw := "s3cr'et"
+	got := roleOptionsSQL(Role{Login: true, Inherit: true, ConnectionLimit: -1, Password: &pw})
+	want := ` LOGIN NOCREATEDB NOCREATEROLE INHERIT CONNECTION LIMIT -1 PASSWORD 's3cr''et'`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if got := roleOptionsSQL(Role{CreateDatabase: true, CreateRole: true}); got != ` NOLOGIN CREATEDB CREATEROLE NOINHERIT CONNECTION LIMIT 0` {
+		t.Errorf("nil password must leave it out; got %s", got)
+	}
+}
+
+func TestCreateExtensionSQL(t *testing.T) {
+	if got, want := createExtensionSQL(Extension{Name: "pg_trgm"}), `CREATE EXTENSION "pg_trgm"`; got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+	if got, want := createExtensionSQL(Extension{Name: "vector", Schema: "ext", Version: "0.8.0"}), `CREATE EXTENSION "vector" SCHEMA "ext" VERSION '0.8.0'`; got != want {
+		t.Errorf("got %s; want %s", got, want)
+	}
+}
+
+// TestLive round-trips every object type against a real server. It runs only
+// when PGSQL_TEST_HOST is set, e.g. for a local TLS-enabled Postgres
+// container: PGSQL_TEST_HOST, PGSQL_TEST_PORT (default 5432), PGSQL_TEST_USER,
+// PGSQL_TEST_PASSWORD and PGSQL_TEST_CA_FILE (the PEM the server certificate
+// chains to; the certificate must be valid for PGSQL_TEST_HOST).
+func TestLive(t *testing.T) {
+	host := os.Getenv("PGSQL_TEST_HOST")
+	if host == "" {
+		t.Skip("PGSQL_TEST_HOST not set (skipping)")
+	}
+	port := uint16(5432)
+	if p := os.Getenv("PGSQL_TEST_PORT"); p != "" {
+		n, err := strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			t.Fatalf("PGSQL_TEST_PORT: %v", err)
+		}
+		port = uint16(n)
+	}
+	ca, err := os.ReadFile(os.Getenv("PGSQL_TEST_CA_FILE"))
+	if err != nil {
+		t.Fatalf("PGSQL_TEST_CA_FILE: %v", err)
+	}
+
+	ctx := context.Background()
+	conn, err := Connect(ctx, Config{
+		Host: host, Port: port, Database: "postgres", RootCAs: ca,
+		User: os.Getenv("PGSQL_TEST_USER"), Password: os.Getenv("PGSQL_TEST_PASSWORD"),
+	})
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	pw := "tf-test-password"
+	role := Role{Name: "tf_test_role", Login: true, Inherit: true, ConnectionLimit: 3, Password: &pw}
+	if err := conn.CreateRole(ctx, role); err != nil {
+		t.Fatalf("CreateRole: %v", err)
+	}
+	defer func() { _ = conn.DropRole(ctx, role.Name) }()
+	role.ConnectionLimit, role.Password = 4, nil
+	if err := conn.UpdateRole(ctx, role); err != nil {
+		t.Fatalf("UpdateRole: %v", err)
+	}
+	if got, err := conn.GetRole(ctx, role.Name); err != nil || got.ConnectionLimit != 4 || !got.Login {
+		t.Errorf("GetRole = %+v, %v", got, err)
+	}
+
+	db := Database{Name: "tf_test_db", Owner: role.Name, ConnectionLimit: -1}
+	if err := conn.CreateDatabase(ctx, db); err != nil {
+		t.Fatalf("CreateDatabase: %v", err)
+	}
+	defer func() { _ = conn.DropDatabase(ctx, db.Name) }()
+	if got, err := conn.GetDatabase(ctx, db.Name); err != nil || got.Owner != role.Name || got.Encoding == "" {
+		t.Errorf("GetDatabase = %+v, %v", got, err)
+	}
+
+	if err := conn.CreateExtension(ctx, Extension{Name: "pg_trgm"}); err != nil {
+		t.Fatalf("CreateExtension: %v", err)
+	}
+	if got, err := conn.GetExtension(ctx, "pg_trgm"); err != nil || got.Version == "" {
+		t.Errorf("GetExtension = %+v, %v", got, err)
+	}
+	if err := conn.DropExtension(ctx, "pg_trgm"); err != nil {
+		t.Fatalf("DropExtension: %v", err)
+	}
+	if _, err := conn.GetExtension(ctx, "pg_trgm"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetExtension after drop: want ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Role is a row of pg_roles. Password is write-only: it is never read back
+// (pg_roles masks it), and nil leaves the current password alone.
+type Role struct {
+	Name            string
+	Login           bool
+	CreateDatabase  bool
+	CreateRole      bool
+	Inherit         bool
+	ConnectionLimit int64
+	Password        *string
+}
+
+// GetRole returns ErrNotFound when no role has the given name.
+func (c *Conn) GetRole(ctx context.Context, name string) (*Role, error) {
+	var r Role
+	err := c.conn.QueryRow(ctx,
+		`SELECT rolname, rolcanlogin, rolcreatedb, rolcreaterole, rolinherit, rolconnlimit
+		   FROM pg_roles WHERE rolname = $1`, name,
+	).Scan(&r.Name, &r.Login, &r.CreateDatabase, &r.CreateRole, &r.Inherit, &r.ConnectionLimit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read role %q: %w", name, err)
+	}
+	return &r, nil
+}
+
+// CreateRole creates r with every option spelled out.
+func (c *Conn) CreateRole(ctx context.Context, r Role) error {
+	if err := c.exec(ctx, "CREATE ROLE "+QuoteIdentifier(r.Name)+" WITH"+roleOptionsSQL(r)); err != nil {
+		return fmt.Errorf("failed to create role %q: %w", r.Name, err)
+	}
+	return nil
+}
+
+// UpdateRole applies every option of r to the role named r.Name.
+func (c *Conn) UpdateRole(ctx context.Context, r Role) error {
+	if err := c.exec(ctx, "ALTER ROLE "+QuoteIdentifier(r.Name)+" WITH"+roleOptionsSQL(r)); err != nil {
+		return fmt.Errorf("failed to update role %q: %w", r.Name, err)
+	}
+	return nil
+}
+
+// DropRole drops the role; a missing role is not an error. Objects the role
+// still owns, or privileges granted to it, make the server refuse.
+func (c *Conn) DropRole(ctx context.Context, name string) error {
+	if err := c.exec(ctx, "DROP ROLE IF EXISTS "+QuoteIdentifier(name)); err != nil {
+		return fmt.Errorf("failed to drop role %q: %w", name, err)
+	}
+	return nil
+}
+
+func roleOptionsSQL(r Role) string {
+	flag := func(on bool, name string) string {
+		if on {
+			return " " + name
+		}
+		return " NO" + name
+	}
+	var b strings.Builder
+	b.WriteString(flag(r.Login, "LOGIN"))
+	b.WriteString(flag(r.CreateDatabase, "CREATEDB"))
+	b.WriteString(flag(r.CreateRole, "CREATEROLE"))
+	b.WriteString(flag(r.Inherit, "INHERIT"))
+	fmt.Fprintf(&b, " CONNECTION LIMIT %d", r.ConnectionLimit)
+	if r.Password != nil {
+		b.WriteString(" PASSWORD " + QuoteLiteral(*r.Password))
+	}
+	return b.String()
+}
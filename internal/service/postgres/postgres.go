@@ -2,10 +2,12 @@ package postgres
 
 import (
 	upstreamdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	upstreamephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	upstreamresource "github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/datasource"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/ephemeral"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource"
 )
 
@@ -23,7 +25,16 @@ func (servicePackage) Meta() service.Metadata {
 }
 
 func (servicePackage) Resources() []func() upstreamresource.Resource {
-	return []func() upstreamresource.Resource{resource.NewPostgresServiceResource}
+	return []func() upstreamresource.Resource{
+		resource.NewPostgresServiceResource,
+		resource.NewPostgresDatabaseResource,
+		resource.NewPostgresRoleResource,
+		resource.NewPostgresExtensionResource,
+		resource.NewPostgresMaintenanceWindowResource,
+		resource.NewPostgresCdcLinkResource,
+		resource.NewPostgresScheduledScalingResource,
+		resource.NewPostgresObservabilityResource,
+	}
 }
 
 func (servicePackage) DataSources() []func() upstreamdatasource.DataSource {
@@ -31,5 +42,12 @@ func (servicePackage) DataSources() []func() upstreamdatasource.DataSource {
 		datasource.NewPostgresServiceDataSource,
 		datasource.NewPostgresServicesDataSource,
 		datasource.NewPostgresServiceCaCertificatesDataSource,
+		datasource.NewPostgresBackupsDataSource,
+	}
+}
+
+func (servicePackage) EphemeralResources() []func() upstreamephemeral.EphemeralResource {
+	return []func() upstreamephemeral.EphemeralResource{
+		ephemeral.NewPostgresConnectionStringsEphemeralResource,
 	}
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PostgresCdcLinkResourceModel is the Terraform state model for the
+// clickhouse_postgres_cdc_link resource.
+type PostgresCdcLinkResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ServiceID           types.String `tfsdk:"service_id"`
+	PostgresServiceID   types.String `tfsdk:"postgres_service_id"`
+	Name                types.String `tfsdk:"name"`
+	Database            types.String `tfsdk:"database"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	DestinationDatabase types.String `tfsdk:"destination_database"`
+	PublicationName     types.String `tfsdk:"publication_name"`
+	ReplicationMode     types.String `tfsdk:"replication_mode"`
+	TableMappings       types.List   `tfsdk:"table_mappings"`
+	ConfigureWalLevel   types.Bool   `tfsdk:"configure_wal_level"`
+	State               types.String `tfsdk:"state"`
+}
+
+// PostgresCdcLinkTableMappingModel is one element of table_mappings.
+type PostgresCdcLinkTableMappingModel struct {
+	SourceSchemaName types.String `tfsdk:"source_schema_name"`
+	SourceTable      types.String `tfsdk:"source_table"`
+	TargetTable      types.String `tfsdk:"target_table"`
+}
+
+func (m PostgresCdcLinkTableMappingModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"source_schema_name": types.StringType,
+			"source_table":       types.StringType,
+			"target_table":       types.StringType,
+		},
+	}
+}
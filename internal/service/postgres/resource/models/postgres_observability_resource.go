@@ -0,0 +1,92 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// PostgresObservabilityLogSourceModel holds the expressions of a ClickStack
+// log source over the exported logs table, named after the matching
+// clickhouse_clickstack_source attributes.
+type PostgresObservabilityLogSourceModel struct {
+	TimestampValueExpression          types.String `tfsdk:"timestamp_value_expression"`
+	DisplayedTimestampValueExpression types.String `tfsdk:"displayed_timestamp_value_expression"`
+	DefaultTableSelectExpression      types.String `tfsdk:"default_table_select_expression"`
+	ServiceNameExpression             types.String `tfsdk:"service_name_expression"`
+	SeverityTextExpression            types.String `tfsdk:"severity_text_expression"`
+	BodyExpression                    types.String `tfsdk:"body_expression"`
+	EventAttributesExpression         types.String `tfsdk:"event_attributes_expression"`
+	ResourceAttributesExpression      types.String `tfsdk:"resource_attributes_expression"`
+}
+
+func (m PostgresObservabilityLogSourceModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"timestamp_value_expression":           types.StringType,
+			"displayed_timestamp_value_expression": types.StringType,
+			"default_table_select_expression":      types.StringType,
+			"service_name_expression":              types.StringType,
+			"severity_text_expression":             types.StringType,
+			"body_expression":                      types.StringType,
+			"event_attributes_expression":          types.StringType,
+			"resource_attributes_expression":       types.StringType,
+		},
+	}
+}
+
+func (m PostgresObservabilityLogSourceModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"timestamp_value_expression":           m.TimestampValueExpression,
+		"displayed_timestamp_value_expression": m.DisplayedTimestampValueExpression,
+		"default_table_select_expression":      m.DefaultTableSelectExpression,
+		"service_name_expression":              m.ServiceNameExpression,
+		"severity_text_expression":             m.SeverityTextExpression,
+		"body_expression":                      m.BodyExpression,
+		"event_attributes_expression":          m.EventAttributesExpression,
+		"resource_attributes_expression":       m.ResourceAttributesExpression,
+	})
+}
+
+// PostgresObservabilityMetricSourceModel holds the expressions of a
+// ClickStack metric source over the exported gauge and sum tables.
+type PostgresObservabilityMetricSourceModel struct {
+	TimestampValueExpression     types.String `tfsdk:"timestamp_value_expression"`
+	ResourceAttributesExpression types.String `tfsdk:"resource_attributes_expression"`
+}
+
+func (m PostgresObservabilityMetricSourceModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"timestamp_value_expression":     types.StringType,
+			"resource_attributes_expression": types.StringType,
+		},
+	}
+}
+
+func (m PostgresObservabilityMetricSourceModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"timestamp_value_expression":     m.TimestampValueExpression,
+		"resource_attributes_expression": m.ResourceAttributesExpression,
+	})
+}
+
+// PostgresObservabilityResourceModel is the Terraform state model for the
+// clickhouse_postgres_observability resource.
+type PostgresObservabilityResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ServiceID            types.String `tfsdk:"service_id"`
+	DestinationServiceID types.String `tfsdk:"destination_service_id"`
+	Database             types.String `tfsdk:"database"`
+	LogsEnabled          types.Bool   `tfsdk:"logs_enabled"`
+	MetricsEnabled       types.Bool   `tfsdk:"metrics_enabled"`
+
+	// Server-assigned; null while the signal is disabled.
+	LogsTable         types.String `tfsdk:"logs_table"`
+	MetricsGaugeTable types.String `tfsdk:"metrics_gauge_table"`
+	MetricsSumTable   types.String `tfsdk:"metrics_sum_table"`
+
+	// Fixed by the export layout; null while the signal is disabled.
+	ClickStackLogSource    types.Object `tfsdk:"clickstack_log_source"`
+	ClickStackMetricSource types.Object `tfsdk:"clickstack_metric_source"`
+}
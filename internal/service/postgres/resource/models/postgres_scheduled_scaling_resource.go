@@ -0,0 +1,50 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// PostgresScheduledScalingEntryModel mirrors a single window of a Postgres
+// scaling schedule: either a size to run at or a stop.
+type PostgresScheduledScalingEntryModel struct {
+	Name         types.String `tfsdk:"name"`
+	Weekdays     types.Set    `tfsdk:"weekdays"`
+	StartHourUtc types.Int64  `tfsdk:"start_hour_utc"`
+	EndHourUtc   types.Int64  `tfsdk:"end_hour_utc"`
+	Size         types.String `tfsdk:"size"`
+	Stopped      types.Bool   `tfsdk:"stopped"`
+}
+
+func (m PostgresScheduledScalingEntryModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":           types.StringType,
+			"weekdays":       types.SetType{ElemType: types.Int64Type},
+			"start_hour_utc": types.Int64Type,
+			"end_hour_utc":   types.Int64Type,
+			"size":           types.StringType,
+			"stopped":        types.BoolType,
+		},
+	}
+}
+
+func (m PostgresScheduledScalingEntryModel) ObjectValue() basetypes.ObjectValue {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"name":           m.Name,
+		"weekdays":       m.Weekdays,
+		"start_hour_utc": m.StartHourUtc,
+		"end_hour_utc":   m.EndHourUtc,
+		"size":           m.Size,
+		"stopped":        m.Stopped,
+	})
+}
+
+// PostgresScheduledScalingResourceModel is the Terraform state model for the
+// clickhouse_postgres_scheduled_scaling resource.
+type PostgresScheduledScalingResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Entries   types.Set    `tfsdk:"entries"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,6 +20,10 @@ type PostgresServiceResourceModel struct {
 	HaType types.String `tfsdk:"ha_type"`
 	Tags   types.Map    `tfsdk:"tags"`
 
+	// DesiredState is "running" or "stopped"; null leaves the instance's
+	// power state unmanaged (e.g. to a clickhouse_postgres_scheduled_scaling).
+	DesiredState types.String `tfsdk:"desired_state"`
+
 	// Runtime configuration. Terraform-owned, full-replacement: whatever is
 	// declared is the desired state; omitting a key removes it server-side;
 	// omitting the attribute clears all parameters. Modeled as string maps to
@@ -27,6 +32,15 @@ type PostgresServiceResourceModel struct {
 	PgConfig        types.Map `tfsdk:"pg_config"`
 	PgBouncerConfig types.Map `tfsdk:"pgbouncer_config"`
 
+	// Network access. Optional+Computed like tags: omitting either attribute
+	// keeps whatever the server holds; an empty set removes every entry.
+	IpAccess           types.Set `tfsdk:"ip_access"`
+	PrivateEndpointIDs types.Set `tfsdk:"private_endpoint_ids"`
+
+	// Backup settings. Optional+Computed; null for a read replica, which
+	// takes no backups of its own.
+	BackupConfiguration types.Object `tfsdk:"backup_configuration"`
+
 	// Computed.
 	State     types.String `tfsdk:"state"`
 	CreatedAt types.String `tfsdk:"created_at"`
@@ -35,6 +49,11 @@ type PostgresServiceResourceModel struct {
 	Port      types.Int64  `tfsdk:"port"`
 	Username  types.String `tfsdk:"username"`
 
+	// Connection strings built from the above, password and the CA. Sensitive
+	// because password is embedded; SSLRootCertPath only feeds them.
+	SSLRootCertPath   types.String `tfsdk:"ssl_root_cert_path"`
+	ConnectionStrings types.Object `tfsdk:"connection_strings"`
+
 	// Sensitive.
 	// Password is config-owned: Terraform manages exactly the declared value
 	// and never reads it back from the API (credential redaction means GET
@@ -61,3 +80,47 @@ type PostgresRestoreModel struct {
 	SourceID      types.String `tfsdk:"source_id"`
 	RestoreTarget types.String `tfsdk:"restore_target"`
 }
+
+// PostgresIPAccessModel is one element of the ip_access set.
+type PostgresIPAccessModel struct {
+	Source      types.String `tfsdk:"source"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m PostgresIPAccessModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"source":      types.StringType,
+			"description": types.StringType,
+		},
+	}
+}
+
+func (m PostgresIPAccessModel) ObjectValue() types.Object {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"source":      m.Source,
+		"description": m.Description,
+	})
+}
+
+// PostgresBackupConfigurationModel is the nested backup_configuration object.
+type PostgresBackupConfigurationModel struct {
+	RetentionDays     types.Int64  `tfsdk:"retention_days"`
+	BackupWindowStart types.String `tfsdk:"backup_window_start"`
+}
+
+func (m PostgresBackupConfigurationModel) ObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"retention_days":      types.Int64Type,
+			"backup_window_start": types.StringType,
+		},
+	}
+}
+
+func (m PostgresBackupConfigurationModel) ObjectValue() types.Object {
+	return types.ObjectValueMust(m.ObjectType().AttrTypes, map[string]attr.Value{
+		"retention_days":      m.RetentionDays,
+		"backup_window_start": m.BackupWindowStart,
+	})
+}
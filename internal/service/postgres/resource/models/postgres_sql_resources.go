@@ -0,0 +1,48 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// PostgresDatabaseResourceModel is the clickhouse_postgres_database state.
+type PostgresDatabaseResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ServiceID                  types.String `tfsdk:"service_id"`
+	SuperuserPassword          types.String `tfsdk:"superuser_password"`
+	SuperuserPasswordWO        types.String `tfsdk:"superuser_password_wo"`
+	SuperuserPasswordWOVersion types.Int64  `tfsdk:"superuser_password_wo_version"`
+	Name                       types.String `tfsdk:"name"`
+	Owner                      types.String `tfsdk:"owner"`
+	Encoding                   types.String `tfsdk:"encoding"`
+	ConnectionLimit            types.Int64  `tfsdk:"connection_limit"`
+}
+
+// PostgresRoleResourceModel is the clickhouse_postgres_role state. Password
+// and PasswordWO are config-owned and never read back.
+type PostgresRoleResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ServiceID                  types.String `tfsdk:"service_id"`
+	SuperuserPassword          types.String `tfsdk:"superuser_password"`
+	SuperuserPasswordWO        types.String `tfsdk:"superuser_password_wo"`
+	SuperuserPasswordWOVersion types.Int64  `tfsdk:"superuser_password_wo_version"`
+	Name                       types.String `tfsdk:"name"`
+	Login                      types.Bool   `tfsdk:"login"`
+	CreateDatabase             types.Bool   `tfsdk:"create_database"`
+	CreateRole                 types.Bool   `tfsdk:"create_role"`
+	Inherit                    types.Bool   `tfsdk:"inherit"`
+	ConnectionLimit            types.Int64  `tfsdk:"connection_limit"`
+	Password                   types.String `tfsdk:"password"`
+	PasswordWO                 types.String `tfsdk:"password_wo"`
+	PasswordWOVersion          types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// PostgresExtensionResourceModel is the clickhouse_postgres_extension state.
+type PostgresExtensionResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ServiceID                  types.String `tfsdk:"service_id"`
+	SuperuserPassword          types.String `tfsdk:"superuser_password"`
+	SuperuserPasswordWO        types.String `tfsdk:"superuser_password_wo"`
+	SuperuserPasswordWOVersion types.Int64  `tfsdk:"superuser_password_wo_version"`
+	Database                   types.String `tfsdk:"database"`
+	Name                       types.String `tfsdk:"name"`
+	Schema                     types.String `tfsdk:"schema"`
+	Version                    types.String `tfsdk:"version"`
+}
@@ -0,0 +1,616 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresCdcLinkResource{}
+	_ resource.ResourceWithConfigure      = &PostgresCdcLinkResource{}
+	_ resource.ResourceWithImportState    = &PostgresCdcLinkResource{}
+	_ resource.ResourceWithModifyPlan     = &PostgresCdcLinkResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresCdcLinkResource{}
+)
+
+//go:embed descriptions/postgres_cdc_link.md
+var postgresCdcLinkResourceDescription string
+
+// cdcLinkStateChangeMaxWait bounds each wait on the pipe's state, matching
+// clickhouse_clickpipe.
+const cdcLinkStateChangeMaxWait = 2 * time.Minute
+
+// postgresLogicalWalLevel is the wal_level logical replication needs.
+const postgresLogicalWalLevel = "logical"
+
+// NewPostgresCdcLinkResource constructs the clickhouse_postgres_cdc_link resource.
+func NewPostgresCdcLinkResource() resource.Resource {
+	return &PostgresCdcLinkResource{}
+}
+
+// PostgresCdcLinkResource replicates a Managed Postgres instance into a
+// ClickHouse service through a Postgres CDC ClickPipe whose connection
+// details come from the instance itself.
+type PostgresCdcLinkResource struct {
+	client api.Client
+}
+
+func (r *PostgresCdcLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_cdc_link"
+}
+
+func (r *PostgresCdcLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresCdcLinkResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the underlying ClickPipe.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of the ClickHouse service to replicate into.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"postgres_service_id": schema.StringAttribute{
+				Description: "ID of the `clickhouse_postgres_service` to replicate from. Its hostname, superuser and CA certificate are resolved from the instance.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the ClickPipe.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Postgres database to replicate. Defaults to `postgres`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(postgresMaintenanceDatabase),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Postgres user the pipe connects as. It needs the REPLICATION attribute. Defaults to the instance's superuser.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of `username`, typically `clickhouse_postgres_service.<name>.password`. Changing it pauses the pipe, updates its credentials and resumes it.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"destination_database": schema.StringAttribute{
+				Description: "ClickHouse database the replicated tables are created in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"publication_name": schema.StringAttribute{
+				Description: "Existing Postgres publication to replicate. When omitted, ClickPipes creates one for the mapped tables.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replication_mode": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Replication mode (%s). Defaults to `%s`.", wrapInBackticks(api.ClickPipePostgresReplicationModes), api.ClickPipeReplicationModeCDC),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(api.ClickPipeReplicationModeCDC),
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.ClickPipePostgresReplicationModes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_mappings": schema.ListNestedAttribute{
+				Description: "Tables to replicate. Adding or removing a mapping pauses the pipe, edits it and resumes it; only the added tables are snapshotted.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_schema_name": schema.StringAttribute{
+							Description: "Schema of the source table.",
+							Required:    true,
+						},
+						"source_table": schema.StringAttribute{
+							Description: "Name of the source table.",
+							Required:    true,
+						},
+						"target_table": schema.StringAttribute{
+							Description: "Name of the ClickHouse table to replicate into.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"configure_wal_level": schema.BoolAttribute{
+				Description: "Set `wal_level = logical` on the Postgres instance before creating the pipe, if it is not already. The change only takes effect after the instance restarts, which this resource does not do. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the ClickPipe, e.g. `Running` or `Snapshot`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PostgresCdcLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configurePostgresSQLResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *PostgresCdcLinkResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_cdc_link", &resp.Diagnostics)
+}
+
+// ModifyPlan warns on create when wal_level will be changed: the instance has
+// to be restarted before the pipe can replicate.
+func (r *PostgresCdcLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan models.PostgresCdcLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ConfigureWalLevel.ValueBool() || plan.PostgresServiceID.IsUnknown() {
+		return
+	}
+	cfg, err := r.client.GetPostgresConfig(ctx, plan.PostgresServiceID.ValueString())
+	if err != nil {
+		// A missing instance is reported by Create; anything else (auth,
+		// server errors) would silently skip the check.
+		if !api.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error reading Postgres configuration",
+				"Could not read the configuration of Postgres service "+plan.PostgresServiceID.ValueString()+" to check wal_level: "+err.Error())
+		}
+		return
+	}
+	if _, changed := withLogicalReplication(cfg.PgConfig); changed {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("configure_wal_level"),
+			"Postgres instance needs a restart for CDC",
+			"wal_level will be set to logical on Postgres service "+plan.PostgresServiceID.ValueString()+". It takes effect only after the instance restarts; restart it via the ClickHouse Cloud UI or API. Until then the pipe cannot be created and the apply fails; apply again after the restart.",
+		)
+	}
+}
+
+func (r *PostgresCdcLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PostgresCdcLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	pgID := plan.PostgresServiceID.ValueString()
+
+	pg, err := r.client.GetPostgres(ctx, pgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres service", "Could not read Postgres service "+pgID+": "+err.Error())
+		return
+	}
+	if pg.Hostname == "" || pg.Username == "" {
+		resp.Diagnostics.AddError("Postgres service is not ready", fmt.Sprintf("Postgres service %s has no hostname or superuser yet (state %q).", pgID, pg.State))
+		return
+	}
+	ca, err := r.client.GetPostgresCaCertificates(ctx, pgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres CA certificates", "Could not fetch the CA certificates of Postgres service "+pgID+": "+err.Error())
+		return
+	}
+
+	walLevelChanged := false
+	if plan.ConfigureWalLevel.ValueBool() {
+		walLevelChanged, err = r.enableLogicalReplication(ctx, pgID, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError("Error configuring Postgres for logical replication", "Could not set wal_level on Postgres service "+pgID+": "+err.Error())
+			return
+		}
+	}
+
+	if plan.Username.IsUnknown() || plan.Username.IsNull() {
+		plan.Username = types.StringValue(pg.Username)
+	}
+	mappings, d := cdcLinkMappingsFromList(ctx, plan.TableMappings)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+	created, err := r.client.CreateClickPipe(ctx, serviceID, buildCdcLinkClickPipe(plan, pg.Hostname, string(ca), mappings))
+	if err != nil {
+		detail := "Could not create the Postgres CDC ClickPipe: " + err.Error()
+		if walLevelChanged {
+			detail += "\n\nwal_level was just set to logical and only takes effect after Postgres service " + pgID + " restarts. Restart it and apply again."
+		}
+		resp.Diagnostics.AddError("Error creating Postgres CDC link", detail)
+		return
+	}
+
+	pipe, err := r.client.WaitForClickPipeState(ctx, serviceID, created.ID, isCdcLinkSettled, cdcLinkStateChangeMaxWait)
+	if err != nil && (pipe == nil || !isCdcLinkSettled(pipe.State)) {
+		resp.Diagnostics.AddWarning("ClickPipe didn't reach the desired state", err.Error())
+	}
+	if pipe == nil {
+		pipe = created
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.State = types.StringValue(pipe.State)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresCdcLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresCdcLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipe, err := r.client.GetClickPipe(ctx, state.ServiceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres CDC link", "Could not read ClickPipe "+state.ID.ValueString()+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(applyClickPipeToCdcLink(ctx, pipe, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update renames the pipe directly; table mapping and credential changes
+// need the pipe paused, so they are sent in one edit between a pause and a
+// resume. The first apply after import only records the password.
+func (r *PostgresCdcLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.PostgresCdcLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serviceID, pipeID := state.ServiceID.ValueString(), state.ID.ValueString()
+
+	planMappings, d := cdcLinkMappingsFromList(ctx, plan.TableMappings)
+	resp.Diagnostics.Append(d...)
+	stateMappings, d := cdcLinkMappingsFromList(ctx, state.TableMappings)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	add, remove := diffCdcLinkMappings(planMappings, stateMappings)
+	credentialsChanged := cdcLinkCredentialsChanged(plan, state)
+
+	update := api.ClickPipeUpdate{}
+	if !plan.Name.Equal(state.Name) {
+		update.Name = plan.Name.ValueStringPointer()
+	}
+	if len(add) > 0 || len(remove) > 0 || credentialsChanged {
+		source := &api.ClickPipePostgresSource{
+			TableMappingsToAdd:    add,
+			TableMappingsToRemove: remove,
+		}
+		if credentialsChanged {
+			source.Credentials = &api.ClickPipeSourceCredentials{
+				Username: plan.Username.ValueString(),
+				Password: plan.Password.ValueString(),
+			}
+		}
+		update.Source = &api.ClickPipeSource{Postgres: source}
+
+		if _, err := r.client.ChangeClickPipeState(ctx, serviceID, pipeID, api.ClickPipeStateStop); err != nil {
+			resp.Diagnostics.AddError("Error pausing Postgres CDC link", "Could not pause ClickPipe "+pipeID+" for the edit: "+err.Error())
+			return
+		}
+		if _, err := r.client.WaitForClickPipeState(ctx, serviceID, pipeID, isCdcLinkPaused, cdcLinkStateChangeMaxWait); err != nil {
+			resp.Diagnostics.AddError("Error pausing Postgres CDC link", "ClickPipe "+pipeID+" did not reach a paused state: "+err.Error())
+			return
+		}
+	}
+
+	if update.Name != nil || update.Source != nil {
+		if _, err := r.client.UpdateClickPipe(ctx, serviceID, pipeID, update); err != nil {
+			resp.Diagnostics.AddError("Error updating Postgres CDC link", "Could not update ClickPipe "+pipeID+": "+err.Error())
+			return
+		}
+	}
+	if update.Source != nil {
+		if _, err := r.client.ChangeClickPipeState(ctx, serviceID, pipeID, api.ClickPipeStateStart); err != nil && !api.IsBadRequestWith(err, "already running") {
+			resp.Diagnostics.AddError("Error resuming Postgres CDC link", "Could not resume ClickPipe "+pipeID+" after the edit: "+err.Error())
+			return
+		}
+	}
+
+	pipe, err := r.client.WaitForClickPipeState(ctx, serviceID, pipeID, isCdcLinkSettled, cdcLinkStateChangeMaxWait)
+	if err != nil && (pipe == nil || !isCdcLinkSettled(pipe.State)) {
+		resp.Diagnostics.AddWarning("ClickPipe didn't reach the desired state", err.Error())
+	}
+	if pipe == nil {
+		pipe, err = r.client.GetClickPipe(ctx, serviceID, pipeID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Postgres CDC link after update", err.Error())
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.State = types.StringValue(pipe.State)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the pipe. wal_level is left as is: other consumers may
+// rely on it, and lowering it would need another restart.
+func (r *PostgresCdcLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresCdcLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteClickPipe(ctx, state.ServiceID.ValueString(), state.ID.ValueString()); err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting Postgres CDC link", "Could not delete ClickPipe "+state.ID.ValueString()+": "+err.Error())
+	}
+}
+
+func (r *PostgresCdcLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Postgres CDC link import ID",
+			fmt.Sprintf("Expected service_id/clickpipe_id/postgres_service_id, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("postgres_service_id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("configure_wal_level"), true)...)
+}
+
+// cdcLinkCredentialsChanged reports whether the pipe's credentials need an
+// edit. A null password in state means the link was imported (the API does
+// not return it), so the configured one is taken as the pipe's current one.
+func cdcLinkCredentialsChanged(plan, state models.PostgresCdcLinkResourceModel) bool {
+	if !plan.Username.Equal(state.Username) {
+		return true
+	}
+	return !state.Password.IsNull() && !plan.Password.Equal(state.Password)
+}
+
+// enableLogicalReplication sets wal_level = logical on the instance, keeping
+// every other parameter (POST /config replaces the whole map). It reports
+// whether anything changed and surfaces the restart the change needs.
+func (r *PostgresCdcLinkResource) enableLogicalReplication(ctx context.Context, pgID string, diags *diag.Diagnostics) (bool, error) {
+	cfg, err := r.client.GetPostgresConfig(ctx, pgID)
+	if err != nil {
+		return false, err
+	}
+	pgConfig, changed := withLogicalReplication(cfg.PgConfig)
+	if !changed {
+		return false, nil
+	}
+	out, err := r.client.ReplacePostgresConfig(ctx, pgID, api.PostgresConfig{PgConfig: pgConfig, PgBouncerConfig: cfg.PgBouncerConfig})
+	if err != nil {
+		return false, err
+	}
+	msg := "wal_level was set to logical on Postgres service " + pgID + "."
+	if out.Message != "" {
+		msg += " " + out.Message
+	}
+	diags.AddWarning("Postgres configuration change requires a restart",
+		msg+" Restart out-of-band via the ClickHouse Cloud UI or API. If clickhouse_postgres_service declares pg_config for this instance, add wal_level = \"logical\" there too, or its next apply reverts it.")
+	return true, nil
+}
+
+// hasLogicalReplication reports whether cfg sets wal_level = logical. Postgres
+// takes enum values case-insensitively. It is the configured value: a recent
+// change is only in effect after a restart.
+func hasLogicalReplication(cfg api.PgConfigMap) bool {
+	return strings.EqualFold(cfg["wal_level"], postgresLogicalWalLevel)
+}
+
+// withLogicalReplication returns cfg with wal_level = logical, and whether
+// that differs from cfg.
+func withLogicalReplication(cfg api.PgConfigMap) (api.PgConfigMap, bool) {
+	if hasLogicalReplication(cfg) {
+		return cfg, false
+	}
+	out := make(api.PgConfigMap, len(cfg)+1)
+	for k, v := range cfg {
+		out[k] = v
+	}
+	out["wal_level"] = postgresLogicalWalLevel
+	return out, true
+}
+
+// buildCdcLinkClickPipe assembles the ClickPipe for a create, verifying the
+// server certificate against the instance's own CA.
+func buildCdcLinkClickPipe(plan models.PostgresCdcLinkResourceModel, host, caPEM string, mappings []api.ClickPipePostgresTableMapping) api.ClickPipe {
+	settings := &api.ClickPipePostgresSettings{
+		ReplicationMode: plan.ReplicationMode.ValueString(),
+	}
+	if !plan.PublicationName.IsNull() {
+		settings.PublicationName = plan.PublicationName.ValueStringPointer()
+	}
+	return api.ClickPipe{
+		Name: plan.Name.ValueString(),
+		Source: api.ClickPipeSource{
+			Postgres: &api.ClickPipePostgresSource{
+				Type:          api.ClickPipePostgresSourceType,
+				Host:          host,
+				Port:          int(postgresDefaultPort),
+				Database:      plan.Database.ValueString(),
+				CACertificate: &caPEM,
+				Credentials: &api.ClickPipeSourceCredentials{
+					Username: plan.Username.ValueString(),
+					Password: plan.Password.ValueString(),
+				},
+				Settings: settings,
+				Mappings: mappings,
+			},
+		},
+		Destination: api.ClickPipeDestination{
+			Database: plan.DestinationDatabase.ValueString(),
+		},
+	}
+}
+
+// applyClickPipeToCdcLink refreshes state from the pipe. table_mappings keeps
+// its declared order unless the pipe's mappings differ as a set.
+func applyClickPipeToCdcLink(ctx context.Context, pipe *api.ClickPipe, state *models.PostgresCdcLinkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.Name = types.StringValue(pipe.Name)
+	state.State = types.StringValue(pipe.State)
+	state.DestinationDatabase = types.StringValue(pipe.Destination.Database)
+
+	src := pipe.Source.Postgres
+	if src == nil {
+		return diags
+	}
+	if src.Database != "" {
+		state.Database = types.StringValue(src.Database)
+	}
+	if src.Credentials != nil && src.Credentials.Username != "" {
+		state.Username = types.StringValue(src.Credentials.Username)
+	}
+	if src.Settings != nil {
+		if src.Settings.ReplicationMode != "" {
+			state.ReplicationMode = types.StringValue(src.Settings.ReplicationMode)
+		}
+		if src.Settings.PublicationName != nil && !state.PublicationName.IsNull() {
+			state.PublicationName = types.StringValue(*src.Settings.PublicationName)
+		}
+	}
+
+	current, d := cdcLinkMappingsFromList(ctx, state.TableMappings)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if add, remove := diffCdcLinkMappings(src.Mappings, current); len(add) == 0 && len(remove) == 0 && !state.TableMappings.IsNull() {
+		return diags
+	}
+	list, d := cdcLinkMappingsToList(src.Mappings)
+	diags.Append(d...)
+	state.TableMappings = list
+	return diags
+}
+
+func cdcLinkMappingsFromList(ctx context.Context, list types.List) ([]api.ClickPipePostgresTableMapping, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+	var elems []models.PostgresCdcLinkTableMappingModel
+	diags.Append(list.ElementsAs(ctx, &elems, false)...)
+	out := make([]api.ClickPipePostgresTableMapping, 0, len(elems))
+	for _, m := range elems {
+		out = append(out, api.ClickPipePostgresTableMapping{
+			SourceSchemaName: m.SourceSchemaName.ValueString(),
+			SourceTable:      m.SourceTable.ValueString(),
+			TargetTable:      m.TargetTable.ValueString(),
+		})
+	}
+	return out, diags
+}
+
+func cdcLinkMappingsToList(mappings []api.ClickPipePostgresTableMapping) (types.List, diag.Diagnostics) {
+	objType := models.PostgresCdcLinkTableMappingModel{}.ObjectType()
+	elems := make([]attr.Value, 0, len(mappings))
+	for _, m := range mappings {
+		elems = append(elems, types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+			"source_schema_name": types.StringValue(m.SourceSchemaName),
+			"source_table":       types.StringValue(m.SourceTable),
+			"target_table":       types.StringValue(m.TargetTable),
+		}))
+	}
+	return types.ListValue(objType, elems)
+}
+
+// diffCdcLinkMappings returns the mappings in want but not in have (to add)
+// and in have but not in want (to remove). A changed target table is a remove
+// plus an add.
+func diffCdcLinkMappings(want, have []api.ClickPipePostgresTableMapping) (add, remove []api.ClickPipePostgresTableMapping) {
+	key := func(m api.ClickPipePostgresTableMapping) string {
+		return m.SourceSchemaName + "\x00" + m.SourceTable + "\x00" + m.TargetTable
+	}
+	haveKeys := make(map[string]bool, len(have))
+	for _, m := range have {
+		haveKeys[key(m)] = true
+	}
+	wantKeys := make(map[string]bool, len(want))
+	for _, m := range want {
+		wantKeys[key(m)] = true
+		if !haveKeys[key(m)] {
+			add = append(add, m)
+		}
+	}
+	for _, m := range have {
+		if !wantKeys[key(m)] {
+			remove = append(remove, m)
+		}
+	}
+	return add, remove
+}
+
+// isCdcLinkSettled accepts the states a CDC pipe settles in after a create or
+// edit: Snapshot (initial load), Running, Completed (snapshot-only mode), or
+// Failed (reported, not waited on).
+func isCdcLinkSettled(state string) bool {
+	return state == api.ClickPipeRunningState || state == api.ClickPipeSnapShotState ||
+		state == api.ClickPipeCompletedState || state == api.ClickPipeFailedState
+}
+
+func isCdcLinkPaused(state string) bool {
+	return state == api.ClickPipePausedState || state == api.ClickPipeStoppedState
+}
+
+func wrapInBackticks(values []string) string {
+	return "`" + strings.Join(values, "`, `") + "`"
+}
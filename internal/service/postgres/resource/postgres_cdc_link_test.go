@@ -0,0 +1,197 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+func TestWithLogicalReplication(t *testing.T) {
+	in := api.PgConfigMap{"max_connections": "200"}
+	out, changed := withLogicalReplication(in)
+	if !changed || out["wal_level"] != "logical" || out["max_connections"] != "200" {
+		t.Errorf("got %v, changed=%v", out, changed)
+	}
+	if _, ok := in["wal_level"]; ok {
+		t.Error("input map was modified")
+	}
+
+	if _, changed := withLogicalReplication(out); changed {
+		t.Error("already logical: want no change")
+	}
+	if _, changed := withLogicalReplication(nil); !changed {
+		t.Error("empty config: want a change")
+	}
+	if _, changed := withLogicalReplication(api.PgConfigMap{"wal_level": "Logical"}); changed {
+		t.Error("wal_level is case-insensitive: want no change")
+	}
+}
+
+func TestPostgresCdcLinkModifyPlan_walLevel(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&PostgresCdcLinkResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	plan := models.PostgresCdcLinkResourceModel{
+		ID:                  types.StringUnknown(),
+		ServiceID:           types.StringValue("svc-1"),
+		PostgresServiceID:   types.StringValue("pg-1"),
+		Name:                types.StringValue("pipe"),
+		Database:            types.StringValue("app"),
+		Username:            types.StringValue("postgres"),
+		Password:            types.StringValue("secret"),
+		DestinationDatabase: types.StringValue("default"),
+		PublicationName:     types.StringNull(),
+		ReplicationMode:     types.StringValue("cdc"),
+		TableMappings:       types.ListNull(models.PostgresCdcLinkTableMappingModel{}.ObjectType()),
+		ConfigureWalLevel:   types.BoolValue(true),
+		State:               types.StringUnknown(),
+	}
+
+	cases := []struct {
+		name     string
+		cfg      *api.PostgresConfig
+		err      error
+		errors   int
+		warnings int
+	}{
+		{name: "wal_level to change: restart warning", cfg: &api.PostgresConfig{PgConfig: api.PgConfigMap{"wal_level": "replica"}}, warnings: 1},
+		{name: "already logical: clean", cfg: &api.PostgresConfig{PgConfig: api.PgConfigMap{"wal_level": "LOGICAL"}}},
+		{name: "instance not found: clean", err: errors.New("status: 404, body: not found")},
+		{name: "other read error: surfaced", err: errors.New("status: 500, body: boom"), errors: 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			r := &PostgresCdcLinkResource{
+				client: api.NewClientMock(mc).GetPostgresConfigMock.Expect(minimock.AnyContext, "pg-1").Return(c.cfg, c.err),
+			}
+			p := tfsdk.Plan{Schema: sch}
+			if diags := p.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("encoding plan: %v", diags)
+			}
+			req := resource.ModifyPlanRequest{
+				Plan:  p,
+				State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: p}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.ErrorsCount() != c.errors || resp.Diagnostics.WarningsCount() != c.warnings {
+				t.Errorf("want %d errors and %d warnings, got %v", c.errors, c.warnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCdcLinkCredentialsChanged(t *testing.T) {
+	link := func(user string, password types.String) models.PostgresCdcLinkResourceModel {
+		return models.PostgresCdcLinkResourceModel{Username: types.StringValue(user), Password: password}
+	}
+	cases := []struct {
+		name        string
+		plan, state models.PostgresCdcLinkResourceModel
+		want        bool
+	}{
+		{"unchanged", link("postgres", types.StringValue("a")), link("postgres", types.StringValue("a")), false},
+		{"new password", link("postgres", types.StringValue("b")), link("postgres", types.StringValue("a")), true},
+		{"new username", link("replicator", types.StringValue("a")), link("postgres", types.StringValue("a")), true},
+		{"after import", link("postgres", types.StringValue("a")), link("postgres", types.StringNull()), false},
+	}
+	for _, c := range cases {
+		if got := cdcLinkCredentialsChanged(c.plan, c.state); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDiffCdcLinkMappings(t *testing.T) {
+	orders := api.ClickPipePostgresTableMapping{SourceSchemaName: "public", SourceTable: "orders", TargetTable: "orders"}
+	users := api.ClickPipePostgresTableMapping{SourceSchemaName: "public", SourceTable: "users", TargetTable: "users"}
+	renamed := api.ClickPipePostgresTableMapping{SourceSchemaName: "public", SourceTable: "users", TargetTable: "app_users"}
+
+	add, remove := diffCdcLinkMappings([]api.ClickPipePostgresTableMapping{users, orders}, []api.ClickPipePostgresTableMapping{orders, users})
+	if len(add) != 0 || len(remove) != 0 {
+		t.Errorf("reordered: add=%v remove=%v", add, remove)
+	}
+
+	add, remove = diffCdcLinkMappings([]api.ClickPipePostgresTableMapping{orders, renamed}, []api.ClickPipePostgresTableMapping{orders, users})
+	if len(add) != 1 || add[0].TargetTable != "app_users" || len(remove) != 1 || remove[0].TargetTable != "users" {
+		t.Errorf("retargeted: add=%v remove=%v", add, remove)
+	}
+}
+
+func TestBuildCdcLinkClickPipe(t *testing.T) {
+	plan := models.PostgresCdcLinkResourceModel{
+		Name:                types.StringValue("orders-cdc"),
+		Database:            types.StringValue("postgres"),
+		Username:            types.StringValue("postgres"),
+		Password:            types.StringValue("secret"),
+		DestinationDatabase: types.StringValue("default"),
+		PublicationName:     types.StringNull(),
+		ReplicationMode:     types.StringValue(api.ClickPipeReplicationModeCDC),
+	}
+	mappings := []api.ClickPipePostgresTableMapping{{SourceSchemaName: "public", SourceTable: "orders", TargetTable: "orders"}}
+
+	pipe := buildCdcLinkClickPipe(plan, "pg.example.com", "PEM", mappings)
+	src := pipe.Source.Postgres
+	if src == nil {
+		t.Fatal("no postgres source")
+	}
+	if src.Type != api.ClickPipePostgresSourceType || src.Host != "pg.example.com" || src.Port != 5432 || src.Database != "postgres" {
+		t.Errorf("unexpected connection: %+v", src)
+	}
+	if src.CACertificate == nil || *src.CACertificate != "PEM" {
+		t.Errorf("CA certificate = %v", src.CACertificate)
+	}
+	if src.Credentials == nil || src.Credentials.Username != "postgres" || src.Credentials.Password != "secret" {
+		t.Errorf("credentials = %+v", src.Credentials)
+	}
+	if src.Settings == nil || src.Settings.ReplicationMode != api.ClickPipeReplicationModeCDC || src.Settings.PublicationName != nil {
+		t.Errorf("settings = %+v", src.Settings)
+	}
+	if pipe.Destination.Database != "default" || len(src.Mappings) != 1 {
+		t.Errorf("unexpected pipe: %+v", pipe)
+	}
+}
+
+func TestApplyClickPipeToCdcLink_keepsMappingOrder(t *testing.T) {
+	ctx := context.Background()
+	a := api.ClickPipePostgresTableMapping{SourceSchemaName: "public", SourceTable: "a", TargetTable: "a"}
+	b := api.ClickPipePostgresTableMapping{SourceSchemaName: "public", SourceTable: "b", TargetTable: "b"}
+	declared, d := cdcLinkMappingsToList([]api.ClickPipePostgresTableMapping{a, b})
+	if d.HasError() {
+		t.Fatal(d)
+	}
+	state := models.PostgresCdcLinkResourceModel{TableMappings: declared, PublicationName: types.StringNull()}
+
+	pipe := &api.ClickPipe{Name: "p", State: api.ClickPipeRunningState, Source: api.ClickPipeSource{Postgres: &api.ClickPipePostgresSource{
+		Mappings: []api.ClickPipePostgresTableMapping{b, a},
+	}}}
+	if d := applyClickPipeToCdcLink(ctx, pipe, &state); d.HasError() {
+		t.Fatal(d)
+	}
+	if !state.TableMappings.Equal(declared) {
+		t.Errorf("reordered server mappings replaced state: %v", state.TableMappings)
+	}
+
+	pipe.Source.Postgres.Mappings = []api.ClickPipePostgresTableMapping{a}
+	if d := applyClickPipeToCdcLink(ctx, pipe, &state); d.HasError() {
+		t.Fatal(d)
+	}
+	if got := len(state.TableMappings.Elements()); got != 1 {
+		t.Errorf("drift not surfaced: %d mappings", got)
+	}
+	if state.State.ValueString() != api.ClickPipeRunningState {
+		t.Errorf("state = %q", state.State.ValueString())
+	}
+}
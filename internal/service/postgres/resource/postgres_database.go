@@ -0,0 +1,263 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgsql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresDatabaseResource{}
+	_ resource.ResourceWithConfigure      = &PostgresDatabaseResource{}
+	_ resource.ResourceWithImportState    = &PostgresDatabaseResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresDatabaseResource{}
+)
+
+//go:embed descriptions/postgres_database.md
+var postgresDatabaseResourceDescription string
+
+// NewPostgresDatabaseResource constructs the clickhouse_postgres_database resource.
+func NewPostgresDatabaseResource() resource.Resource {
+	return &PostgresDatabaseResource{}
+}
+
+// PostgresDatabaseResource manages a database inside a Managed Postgres
+// instance over SQL.
+type PostgresDatabaseResource struct {
+	client api.Client
+}
+
+func (r *PostgresDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_database"
+}
+
+func (r *PostgresDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the database.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"owner": schema.StringAttribute{
+			Description: "Role that owns the database. Defaults to the service's superuser.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"encoding": schema.StringAttribute{
+			Description: "Character set encoding, e.g. `UTF8`. Defaults to the template database's encoding. Changing it recreates the database.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"connection_limit": schema.Int64Attribute{
+			Description: "Maximum number of concurrent connections to the database; -1 (the default) means no limit.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(-1),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(-1),
+			},
+		},
+	}
+	maps.Copy(attributes, postgresSQLConnectionAttributes())
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresDatabaseResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *PostgresDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configurePostgresSQLResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *PostgresDatabaseResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_database", &resp.Diagnostics)
+}
+
+func (r *PostgresDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config models.PostgresDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), postgresMaintenanceDatabase)
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	if err := conn.CreateDatabase(ctx, planToPgsqlDatabase(plan)); err != nil {
+		resp.Diagnostics.AddError("Error creating Postgres database", err.Error())
+		return
+	}
+	db, err := conn.GetDatabase(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres database after create", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + plan.Name.ValueString())
+	applyPgsqlDatabaseToState(db, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresDatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForRead(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, postgresMaintenanceDatabase, resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	db, err := conn.GetDatabase(ctx, state.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, pgsql.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres database", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	applyPgsqlDatabaseToState(db, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies owner and connection_limit; name, encoding and service_id
+// replace. A superuser_password (or superuser_password_wo_version) only change
+// still reconnects, which confirms the new password works.
+func (r *PostgresDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config models.PostgresDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), postgresMaintenanceDatabase)
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	if err := conn.UpdateDatabase(ctx, plan.Name.ValueString(), planToPgsqlDatabase(plan)); err != nil {
+		resp.Diagnostics.AddError("Error updating Postgres database", err.Error())
+		return
+	}
+	db, err := conn.GetDatabase(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres database after update", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + plan.Name.ValueString())
+	applyPgsqlDatabaseToState(db, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresDatabaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForDelete(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, postgresMaintenanceDatabase, "Database "+state.Name.ValueString(), resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	if err := conn.DropDatabase(ctx, state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Postgres database", err.Error())
+	}
+}
+
+func (r *PostgresDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Postgres database import ID",
+			fmt.Sprintf("Expected service_id/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// planToPgsqlDatabase maps the plan to a pgsql.Database. Unknown owner /
+// encoding (omitted from config) become empty, i.e. the server default.
+func planToPgsqlDatabase(plan models.PostgresDatabaseResourceModel) pgsql.Database {
+	return pgsql.Database{
+		Name:            plan.Name.ValueString(),
+		Owner:           plan.Owner.ValueString(),
+		Encoding:        plan.Encoding.ValueString(),
+		ConnectionLimit: plan.ConnectionLimit.ValueInt64(),
+	}
+}
+
+// applyPgsqlDatabaseToState writes db into state. Encoding names are
+// case-insensitive and the server reports the canonical spelling, so a
+// declared `utf8` is kept rather than flipped to `UTF8`.
+func applyPgsqlDatabaseToState(db *pgsql.Database, state *models.PostgresDatabaseResourceModel) {
+	state.Name = types.StringValue(db.Name)
+	state.Owner = types.StringValue(db.Owner)
+	if !strings.EqualFold(state.Encoding.ValueString(), db.Encoding) {
+		state.Encoding = types.StringValue(db.Encoding)
+	}
+	state.ConnectionLimit = types.Int64Value(db.ConnectionLimit)
+}
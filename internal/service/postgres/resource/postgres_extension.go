@@ -0,0 +1,258 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgsql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresExtensionResource{}
+	_ resource.ResourceWithConfigure      = &PostgresExtensionResource{}
+	_ resource.ResourceWithImportState    = &PostgresExtensionResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresExtensionResource{}
+)
+
+//go:embed descriptions/postgres_extension.md
+var postgresExtensionResourceDescription string
+
+// NewPostgresExtensionResource constructs the clickhouse_postgres_extension resource.
+func NewPostgresExtensionResource() resource.Resource {
+	return &PostgresExtensionResource{}
+}
+
+// PostgresExtensionResource installs an extension into one database of a
+// Managed Postgres instance over SQL.
+type PostgresExtensionResource struct {
+	client api.Client
+}
+
+func (r *PostgresExtensionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_extension"
+}
+
+func (r *PostgresExtensionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/database/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database": schema.StringAttribute{
+			Description: "Database to install the extension into. Extensions are per database. Defaults to `postgres`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(postgresMaintenanceDatabase),
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the extension, e.g. `pg_trgm` or `vector`. It must be available on the instance.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"schema": schema.StringAttribute{
+			Description: "Schema to install the extension's objects into. Defaults to the first schema on the search path (usually `public`). Changing it reinstalls the extension.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+			},
+		},
+		"version": schema.StringAttribute{
+			Description: "Extension version. Defaults to the extension's default version on create; changing it updates the installed extension in place (`ALTER EXTENSION ... UPDATE TO`).",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	maps.Copy(attributes, postgresSQLConnectionAttributes())
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresExtensionResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *PostgresExtensionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configurePostgresSQLResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *PostgresExtensionResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_extension", &resp.Diagnostics)
+}
+
+func (r *PostgresExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config models.PostgresExtensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), plan.Database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	ext := pgsql.Extension{
+		Name:    plan.Name.ValueString(),
+		Schema:  plan.Schema.ValueString(),
+		Version: plan.Version.ValueString(),
+	}
+	if err := conn.CreateExtension(ctx, ext); err != nil {
+		resp.Diagnostics.AddError("Error creating Postgres extension", err.Error())
+		return
+	}
+	got, err := conn.GetExtension(ctx, ext.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres extension after create", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(postgresExtensionID(plan))
+	applyPgsqlExtensionToState(got, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresExtensionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForRead(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, state.Database.ValueString(), resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	got, err := conn.GetExtension(ctx, state.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, pgsql.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres extension", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(postgresExtensionID(state))
+	applyPgsqlExtensionToState(got, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the extension to a new version; everything else replaces.
+func (r *PostgresExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config models.PostgresExtensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), plan.Database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	if !plan.Version.IsUnknown() && !plan.Version.Equal(state.Version) {
+		if err := conn.UpdateExtension(ctx, plan.Name.ValueString(), plan.Version.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error updating Postgres extension", err.Error())
+			return
+		}
+	}
+	got, err := conn.GetExtension(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres extension after update", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(postgresExtensionID(plan))
+	applyPgsqlExtensionToState(got, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresExtensionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForDelete(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, state.Database.ValueString(), "Extension "+state.Name.ValueString(), resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	if err := conn.DropExtension(ctx, state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Postgres extension", err.Error())
+	}
+}
+
+func (r *PostgresExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Postgres extension import ID",
+			fmt.Sprintf("Expected service_id/database/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+func postgresExtensionID(m models.PostgresExtensionResourceModel) string {
+	return m.ServiceID.ValueString() + "/" + m.Database.ValueString() + "/" + m.Name.ValueString()
+}
+
+func applyPgsqlExtensionToState(ext *pgsql.Extension, state *models.PostgresExtensionResourceModel) {
+	state.Name = types.StringValue(ext.Name)
+	state.Schema = types.StringValue(ext.Schema)
+	state.Version = types.StringValue(ext.Version)
+}
@@ -0,0 +1,219 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresMaintenanceWindowResource{}
+	_ resource.ResourceWithConfigure      = &PostgresMaintenanceWindowResource{}
+	_ resource.ResourceWithImportState    = &PostgresMaintenanceWindowResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresMaintenanceWindowResource{}
+)
+
+//go:embed descriptions/postgres_maintenance_window.md
+var postgresMaintenanceWindowResourceDescription string
+
+// NewPostgresMaintenanceWindowResource constructs the
+// clickhouse_postgres_maintenance_window resource.
+func NewPostgresMaintenanceWindowResource() resource.Resource {
+	return &PostgresMaintenanceWindowResource{}
+}
+
+// PostgresMaintenanceWindowResource pins the weekly window in which a Managed
+// Postgres instance receives minor version patches.
+type PostgresMaintenanceWindowResource struct {
+	client api.Client
+}
+
+func (r *PostgresMaintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_maintenance_window"
+}
+
+func (r *PostgresMaintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresMaintenanceWindowResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier. Equal to service_id (one window per instance).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of the `clickhouse_postgres_service` this maintenance window applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"weekday": schema.Int64Attribute{
+				Description: "Day of the week the maintenance window starts. 0 = Sunday, 1 = Monday, …, 6 = Saturday.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 6),
+				},
+			},
+			"start_hour_utc": schema.Int64Attribute{
+				Description: "UTC hour (0-23) when the maintenance window starts.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(api.PostgresMaintenanceWindowAllowedStartHoursUtc...),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Description: "Length of the maintenance window in hours. Server-controlled.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PostgresMaintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	r.client = providerData.API
+}
+
+func (r *PostgresMaintenanceWindowResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_maintenance_window", &resp.Diagnostics)
+}
+
+func (r *PostgresMaintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PostgresMaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	// Refuse to clobber an existing window. The user should import it.
+	existing, err := r.client.GetPostgresMaintenanceWindow(ctx, serviceID)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error checking for existing maintenance window", err.Error())
+		return
+	}
+	if existing != nil {
+		resp.Diagnostics.AddError(
+			"Maintenance window already exists for this Postgres service",
+			fmt.Sprintf("Postgres service %s already has a maintenance window. Import it into Terraform with: terraform import clickhouse_postgres_maintenance_window.<name> %s", serviceID, serviceID),
+		)
+		return
+	}
+
+	window, err := r.client.UpdatePostgresMaintenanceWindow(ctx, serviceID, planToMaintenanceWindow(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Postgres maintenance window", primarySettingWriteError(serviceID, err, maintenanceWindowReplicaAdvice))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	applyMaintenanceWindowToState(window, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresMaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresMaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.client.GetPostgresMaintenanceWindow(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres maintenance window", err.Error())
+		return
+	}
+
+	state.ID = state.ServiceID
+	applyMaintenanceWindowToState(window, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PostgresMaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PostgresMaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.client.UpdatePostgresMaintenanceWindow(ctx, plan.ServiceID.ValueString(), planToMaintenanceWindow(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Postgres maintenance window", primarySettingWriteError(plan.ServiceID.ValueString(), err, maintenanceWindowReplicaAdvice))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	applyMaintenanceWindowToState(window, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresMaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresMaintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePostgresMaintenanceWindow(ctx, state.ServiceID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting Postgres maintenance window", err.Error())
+	}
+}
+
+func (r *PostgresMaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPrimarySetting(ctx, r.client, "maintenance window", maintenanceWindowReplicaAdvice, req, resp)
+}
+
+// maintenanceWindowReplicaAdvice completes the read-replica errors.
+const maintenanceWindowReplicaAdvice = "Replicas are patched with their primary; configure the maintenance window on the primary instead."
+
+func planToMaintenanceWindow(plan models.PostgresMaintenanceWindowResourceModel) api.PostgresMaintenanceWindow {
+	return api.PostgresMaintenanceWindow{
+		Weekday:      int(plan.Weekday.ValueInt64()),
+		StartHourUtc: int(plan.StartHourUtc.ValueInt64()),
+	}
+}
+
+func applyMaintenanceWindowToState(window *api.PostgresMaintenanceWindow, state *models.PostgresMaintenanceWindowResourceModel) {
+	state.Weekday = types.Int64Value(int64(window.Weekday))
+	state.StartHourUtc = types.Int64Value(int64(window.StartHourUtc))
+	state.Duration = types.Int64Value(int64(window.Duration))
+}
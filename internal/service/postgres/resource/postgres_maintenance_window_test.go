@@ -0,0 +1,69 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+func TestApplyMaintenanceWindowToState(t *testing.T) {
+	state := models.PostgresMaintenanceWindowResourceModel{ServiceID: types.StringValue("pg-1")}
+	applyMaintenanceWindowToState(&api.PostgresMaintenanceWindow{Weekday: 0, StartHourUtc: 3, Duration: 4}, &state)
+	if state.Weekday.ValueInt64() != 0 || state.StartHourUtc.ValueInt64() != 3 || state.Duration.ValueInt64() != 4 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestPostgresMaintenanceWindowResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	r := NewPostgresMaintenanceWindowResource().(*PostgresMaintenanceWindowResource)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	sch := schemaResp.Schema
+
+	cases := []struct {
+		name        string
+		pg          *api.Postgres
+		err         error
+		wantSummary string
+	}{
+		{"primary", &api.Postgres{Id: "pg-1", IsPrimary: true}, nil, ""},
+		{"replica", &api.Postgres{Id: "pg-1", IsPrimary: false}, nil, "Cannot import maintenance window on a read replica"},
+		{"missing", nil, errors.New("status: 404, body: not found"), "Postgres service not found"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			r.client = api.NewClientMock(mc).GetPostgresMock.Expect(ctx, "pg-1").Return(c.pg, c.err)
+
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(sch.Type().TerraformType(ctx), nil)},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: "pg-1"}, resp)
+
+			if c.wantSummary == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("ImportState: %v", resp.Diagnostics)
+				}
+				var state models.PostgresMaintenanceWindowResourceModel
+				resp.State.Get(ctx, &state)
+				if state.ID.ValueString() != "pg-1" || state.ServiceID.ValueString() != "pg-1" {
+					t.Errorf("id / service_id not set: %+v", state)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != c.wantSummary {
+				t.Errorf("diagnostics = %v; want %q", resp.Diagnostics, c.wantSummary)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresObservabilityResource{}
+	_ resource.ResourceWithConfigure      = &PostgresObservabilityResource{}
+	_ resource.ResourceWithImportState    = &PostgresObservabilityResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresObservabilityResource{}
+	_ resource.ResourceWithModifyPlan     = &PostgresObservabilityResource{}
+)
+
+//go:embed descriptions/postgres_observability.md
+var postgresObservabilityResourceDescription string
+
+// NewPostgresObservabilityResource constructs the
+// clickhouse_postgres_observability resource.
+func NewPostgresObservabilityResource() resource.Resource {
+	return &PostgresObservabilityResource{}
+}
+
+// PostgresObservabilityResource exports a Managed Postgres instance's logs
+// and metrics into a ClickHouse service.
+type PostgresObservabilityResource struct {
+	client api.Client
+}
+
+func (r *PostgresObservabilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_observability"
+}
+
+func (r *PostgresObservabilityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresObservabilityResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier. Equal to service_id (one export per instance).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of the `clickhouse_postgres_service` whose logs and metrics are exported.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_service_id": schema.StringAttribute{
+				Description: "ID of the ClickHouse service the logs and metrics are written to. Changing it re-points the export in place; data already exported stays in the previous service.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database in the destination service that holds the exported tables. The server picks one when omitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"logs_enabled": schema.BoolAttribute{
+				Description: "Export the Postgres server log. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"metrics_enabled": schema.BoolAttribute{
+				Description: "Export instance and Postgres metrics. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"logs_table": schema.StringAttribute{
+				Description: "Table the logs are written to, in the OpenTelemetry logs layout. Null while logs_enabled is false.",
+				Computed:    true,
+			},
+			"metrics_gauge_table": schema.StringAttribute{
+				Description: "Table gauge metrics are written to, in the OpenTelemetry metrics layout. Null while metrics_enabled is false.",
+				Computed:    true,
+			},
+			"metrics_sum_table": schema.StringAttribute{
+				Description: "Table sum (counter) metrics are written to, in the OpenTelemetry metrics layout. Null while metrics_enabled is false.",
+				Computed:    true,
+			},
+			"clickstack_log_source": schema.SingleNestedAttribute{
+				Description: "Expressions for a `clickhouse_clickstack_source` of kind `log` over logs_table, named after that resource's attributes: second-precision timestamp, Postgres levels mapped onto ClickStack severities, and the OpenTelemetry body and attribute columns. Null while logs_enabled is false.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"timestamp_value_expression":           schema.StringAttribute{Computed: true, Description: "Timestamp column the table is ordered by."},
+					"displayed_timestamp_value_expression": schema.StringAttribute{Computed: true, Description: "Full-precision timestamp column."},
+					"default_table_select_expression":      schema.StringAttribute{Computed: true, Description: "Columns shown in search results."},
+					"service_name_expression":              schema.StringAttribute{Computed: true, Description: "Service name column."},
+					"severity_text_expression":             schema.StringAttribute{Computed: true, Description: "Expression mapping Postgres levels onto ClickStack severities."},
+					"body_expression":                      schema.StringAttribute{Computed: true, Description: "Log message column."},
+					"event_attributes_expression":          schema.StringAttribute{Computed: true, Description: "Log attributes column."},
+					"resource_attributes_expression":       schema.StringAttribute{Computed: true, Description: "Resource attributes column."},
+				},
+			},
+			"clickstack_metric_source": schema.SingleNestedAttribute{
+				Description: "Expressions for a `clickhouse_clickstack_source` of kind `metric` over metrics_gauge_table and metrics_sum_table. Null while metrics_enabled is false.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"timestamp_value_expression":     schema.StringAttribute{Computed: true, Description: "Timestamp column of the metric tables."},
+					"resource_attributes_expression": schema.StringAttribute{Computed: true, Description: "Resource attributes column."},
+				},
+			},
+		},
+	}
+}
+
+func (r *PostgresObservabilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	r.client = providerData.API
+}
+
+func (r *PostgresObservabilityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_observability", &resp.Diagnostics)
+	var config models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Both default to true, so only two explicit falses disable everything.
+	if isFalse(config.LogsEnabled) && isFalse(config.MetricsEnabled) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("logs_enabled"),
+			"Nothing to export",
+			"logs_enabled and metrics_enabled are both false. Remove the resource to stop exporting instead.",
+		)
+	}
+}
+
+func isFalse(v types.Bool) bool {
+	return !v.IsNull() && !v.IsUnknown() && !v.ValueBool()
+}
+
+// ModifyPlan plans the computed table names, which keep their prior values
+// unless the export is about to change, and the source expressions.
+func (r *PostgresObservabilityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var prior *models.PostgresObservabilityResourceModel
+	if !req.State.Raw.IsNull() {
+		var state models.PostgresObservabilityResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = &state
+
+		// An unset database is the server's pick for the destination, so it
+		// can change when the destination does.
+		var database types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database"), &database)...)
+		if database.IsNull() && !plan.DestinationServiceID.Equal(state.DestinationServiceID) {
+			plan.Database = types.StringUnknown()
+		}
+	}
+
+	planned := planObservabilityComputed(ctx, plan, prior)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &planned)...)
+}
+
+// planObservabilityComputed fills the computed attributes of plan. A table
+// name is kept while its signal stays enabled and the export does not move
+// to another service or database. prior is nil on create.
+func planObservabilityComputed(ctx context.Context, plan models.PostgresObservabilityResourceModel, prior *models.PostgresObservabilityResourceModel) models.PostgresObservabilityResourceModel {
+	relocated := prior == nil || !plan.DestinationServiceID.Equal(prior.DestinationServiceID) || !plan.Database.Equal(prior.Database)
+	keep := func(enabled, wasEnabled types.Bool, cur types.String) types.String {
+		switch {
+		case !enabled.IsUnknown() && !enabled.ValueBool():
+			return types.StringNull()
+		case relocated || !wasEnabled.ValueBool() || enabled.IsUnknown() || cur.IsNull():
+			return types.StringUnknown()
+		}
+		return cur
+	}
+	var was models.PostgresObservabilityResourceModel
+	if prior != nil {
+		was = *prior
+	}
+	plan.LogsTable = keep(plan.LogsEnabled, was.LogsEnabled, was.LogsTable)
+	plan.MetricsGaugeTable = keep(plan.MetricsEnabled, was.MetricsEnabled, was.MetricsGaugeTable)
+	plan.MetricsSumTable = keep(plan.MetricsEnabled, was.MetricsEnabled, was.MetricsSumTable)
+
+	plan.ClickStackLogSource = sourceFor(ctx, plan.LogsEnabled, postgresLogSource())
+	plan.ClickStackMetricSource = sourceFor(ctx, plan.MetricsEnabled, postgresMetricSource())
+	return plan
+}
+
+func (r *PostgresObservabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	// Refuse to clobber an export configured out-of-band. The user should import it.
+	existing, err := r.client.GetPostgresObservability(ctx, serviceID)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error checking for existing Postgres observability export", err.Error())
+		return
+	}
+	if existing != nil && (existing.LogsEnabled || existing.MetricsEnabled) {
+		resp.Diagnostics.AddError(
+			"Observability export already exists for this Postgres service",
+			fmt.Sprintf("Postgres service %s already exports to service %s. Import it into Terraform with: terraform import clickhouse_postgres_observability.<name> %s", serviceID, existing.DestinationServiceID, serviceID),
+		)
+		return
+	}
+
+	obs, err := r.client.UpdatePostgresObservability(ctx, serviceID, planToObservability(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error enabling Postgres observability export", observabilityWriteError(serviceID, err))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	applyObservabilityToState(ctx, obs, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresObservabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	obs, err := r.client.GetPostgresObservability(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres observability export", err.Error())
+		return
+	}
+	if !obs.LogsEnabled && !obs.MetricsEnabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = state.ServiceID
+	applyObservabilityToState(ctx, obs, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PostgresObservabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	obs, err := r.client.UpdatePostgresObservability(ctx, plan.ServiceID.ValueString(), planToObservability(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Postgres observability export", observabilityWriteError(plan.ServiceID.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	applyObservabilityToState(ctx, obs, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete stops the export. Exported tables are left in place.
+func (r *PostgresObservabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresObservabilityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePostgresObservability(ctx, state.ServiceID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error disabling Postgres observability export", err.Error())
+	}
+}
+
+func (r *PostgresObservabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPrimarySetting(ctx, r.client, "observability export", observabilityReplicaAdvice, req, resp)
+}
+
+// observabilityReplicaAdvice completes the read-replica errors.
+const observabilityReplicaAdvice = "Its logs and metrics are exported with its primary's; configure the export on the primary instead."
+
+// observabilityWriteError adds the destination service to the not-found
+// case of primarySettingWriteError: the write 404s when either is missing.
+func observabilityWriteError(serviceID string, err error) string {
+	if api.IsNotFound(err) {
+		return fmt.Sprintf("Postgres service %s or the destination service does not exist or is not visible to the caller. Confirm both IDs are correct and the API key has access.", serviceID)
+	}
+	return primarySettingWriteError(serviceID, err, observabilityReplicaAdvice)
+}
+
+func planToObservability(plan models.PostgresObservabilityResourceModel) api.PostgresObservability {
+	body := api.PostgresObservability{
+		LogsEnabled:          plan.LogsEnabled.ValueBool(),
+		MetricsEnabled:       plan.MetricsEnabled.ValueBool(),
+		DestinationServiceID: plan.DestinationServiceID.ValueString(),
+	}
+	if !plan.Database.IsUnknown() {
+		body.Database = plan.Database.ValueString()
+	}
+	return body
+}
+
+func applyObservabilityToState(ctx context.Context, obs *api.PostgresObservability, state *models.PostgresObservabilityResourceModel) {
+	nullIfEmpty := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	state.DestinationServiceID = types.StringValue(obs.DestinationServiceID)
+	state.Database = types.StringValue(obs.Database)
+	state.LogsEnabled = types.BoolValue(obs.LogsEnabled)
+	state.MetricsEnabled = types.BoolValue(obs.MetricsEnabled)
+	state.LogsTable = nullIfEmpty(obs.LogsTable)
+	state.MetricsGaugeTable = nullIfEmpty(obs.MetricsGaugeTable)
+	state.MetricsSumTable = nullIfEmpty(obs.MetricsSumTable)
+	state.ClickStackLogSource = sourceFor(ctx, state.LogsEnabled, postgresLogSource())
+	state.ClickStackMetricSource = sourceFor(ctx, state.MetricsEnabled, postgresMetricSource())
+}
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+// The exported logs table uses the OpenTelemetry logs layout, ordered by
+// TimestampTime (second precision) with the full-precision Timestamp
+// alongside, as the ClickStack schema does. SeverityText carries the
+// Postgres level (LOG, ERROR, FATAL, ...) verbatim.
+const (
+	postgresLogTimestampExpression          = "TimestampTime"
+	postgresLogDisplayedTimestampExpression = "Timestamp"
+	postgresLogDefaultSelectExpression      = "Timestamp, ServiceName, SeverityText, Body"
+
+	// postgresLogSeverityExpression maps Postgres levels onto the severities
+	// ClickStack colours and filters on. LOG is what Postgres uses for
+	// routine messages (checkpoints, connections), so it is info, not debug.
+	postgresLogSeverityExpression = "multiIf(" +
+		"SeverityText IN ('PANIC', 'FATAL'), 'fatal', " +
+		"SeverityText = 'ERROR', 'error', " +
+		"SeverityText = 'WARNING', 'warn', " +
+		"SeverityText IN ('LOG', 'INFO', 'NOTICE'), 'info', " +
+		"'debug')"
+
+	postgresMetricTimestampExpression = "TimeUnix"
+)
+
+// postgresLogSource is what clickstack_log_source holds while logs are
+// exported: the expressions a clickhouse_clickstack_source needs over
+// logs_table.
+func postgresLogSource() types.Object {
+	return models.PostgresObservabilityLogSourceModel{
+		TimestampValueExpression:          types.StringValue(postgresLogTimestampExpression),
+		DisplayedTimestampValueExpression: types.StringValue(postgresLogDisplayedTimestampExpression),
+		DefaultTableSelectExpression:      types.StringValue(postgresLogDefaultSelectExpression),
+		ServiceNameExpression:             types.StringValue("ServiceName"),
+		SeverityTextExpression:            types.StringValue(postgresLogSeverityExpression),
+		BodyExpression:                    types.StringValue("Body"),
+		EventAttributesExpression:         types.StringValue("LogAttributes"),
+		ResourceAttributesExpression:      types.StringValue("ResourceAttributes"),
+	}.ObjectValue()
+}
+
+// postgresMetricSource is what clickstack_metric_source holds while metrics
+// are exported.
+func postgresMetricSource() types.Object {
+	return models.PostgresObservabilityMetricSourceModel{
+		TimestampValueExpression:     types.StringValue(postgresMetricTimestampExpression),
+		ResourceAttributesExpression: types.StringValue("ResourceAttributes"),
+	}.ObjectValue()
+}
+
+// sourceFor returns source while the signal is enabled, null once it is
+// disabled and unknown while enabled is.
+func sourceFor(ctx context.Context, enabled types.Bool, source types.Object) types.Object {
+	switch {
+	case enabled.IsUnknown():
+		return types.ObjectUnknown(source.AttributeTypes(ctx))
+	case !enabled.ValueBool():
+		return types.ObjectNull(source.AttributeTypes(ctx))
+	}
+	return source
+}
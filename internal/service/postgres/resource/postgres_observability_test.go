@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+func observabilityState(logs, metrics bool) models.PostgresObservabilityResourceModel {
+	m := models.PostgresObservabilityResourceModel{
+		ID:                   types.StringValue("pg-1"),
+		ServiceID:            types.StringValue("pg-1"),
+		DestinationServiceID: types.StringValue("ch-1"),
+		Database:             types.StringValue("otel"),
+		LogsEnabled:          types.BoolValue(logs),
+		MetricsEnabled:       types.BoolValue(metrics),
+		LogsTable:            types.StringNull(),
+		MetricsGaugeTable:    types.StringNull(),
+		MetricsSumTable:      types.StringNull(),
+	}
+	if logs {
+		m.LogsTable = types.StringValue("otel_logs")
+	}
+	if metrics {
+		m.MetricsGaugeTable = types.StringValue("otel_metrics_gauge")
+		m.MetricsSumTable = types.StringValue("otel_metrics_sum")
+	}
+	m.ClickStackLogSource = sourceFor(context.Background(), m.LogsEnabled, postgresLogSource())
+	m.ClickStackMetricSource = sourceFor(context.Background(), m.MetricsEnabled, postgresMetricSource())
+	return m
+}
+
+func TestPlanObservabilityComputed_Create(t *testing.T) {
+	plan := observabilityState(true, false)
+
+	got := planObservabilityComputed(context.Background(), plan, nil)
+	if !got.LogsTable.IsUnknown() || !got.MetricsGaugeTable.IsNull() || !got.MetricsSumTable.IsNull() {
+		t.Errorf("tables = %v/%v/%v, want unknown/null/null", got.LogsTable, got.MetricsGaugeTable, got.MetricsSumTable)
+	}
+	if got.ClickStackLogSource.IsNull() || got.ClickStackLogSource.IsUnknown() || !got.ClickStackMetricSource.IsNull() {
+		t.Errorf("sources = %v/%v, want known/null", got.ClickStackLogSource, got.ClickStackMetricSource)
+	}
+}
+
+func TestPlanObservabilityComputed_Update(t *testing.T) {
+	cases := []struct {
+		name             string
+		mutate           func(*models.PostgresObservabilityResourceModel)
+		wantLogsTable    string // "" for unknown
+		wantMetricsTable string // "null", "" for unknown, or the name
+		wantMetricSource bool
+	}{
+		{
+			name:             "no change keeps everything",
+			mutate:           func(*models.PostgresObservabilityResourceModel) {},
+			wantLogsTable:    "otel_logs",
+			wantMetricsTable: "otel_metrics_gauge",
+			wantMetricSource: true,
+		},
+		{
+			name: "new destination replans tables",
+			mutate: func(m *models.PostgresObservabilityResourceModel) {
+				m.DestinationServiceID = types.StringValue("ch-2")
+			},
+			wantMetricSource: true,
+		},
+		{
+			name: "disabling metrics nulls its table and source",
+			mutate: func(m *models.PostgresObservabilityResourceModel) {
+				m.MetricsEnabled = types.BoolValue(false)
+			},
+			wantLogsTable:    "otel_logs",
+			wantMetricsTable: "null",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := observabilityState(true, true)
+			plan := observabilityState(true, true)
+			c.mutate(&plan)
+
+			got := planObservabilityComputed(context.Background(), plan, &state)
+			check := func(what string, v types.String, want string) {
+				t.Helper()
+				switch want {
+				case "":
+					if !v.IsUnknown() {
+						t.Errorf("%s = %v, want unknown", what, v)
+					}
+				case "null":
+					if !v.IsNull() {
+						t.Errorf("%s = %v, want null", what, v)
+					}
+				default:
+					if v.ValueString() != want {
+						t.Errorf("%s = %v, want %q", what, v, want)
+					}
+				}
+			}
+			check("logs_table", got.LogsTable, c.wantLogsTable)
+			check("metrics_gauge_table", got.MetricsGaugeTable, c.wantMetricsTable)
+			if !got.ClickStackLogSource.Equal(postgresLogSource()) {
+				t.Errorf("clickstack_log_source = %v, want the Postgres log expressions", got.ClickStackLogSource)
+			}
+			if got.ClickStackMetricSource.IsNull() == c.wantMetricSource {
+				t.Errorf("clickstack_metric_source = %v, want present=%v", got.ClickStackMetricSource, c.wantMetricSource)
+			}
+		})
+	}
+}
+
+func TestPostgresLogSource(t *testing.T) {
+	var src models.PostgresObservabilityLogSourceModel
+	if d := postgresLogSource().As(context.Background(), &src, basetypes.ObjectAsOptions{}); d.HasError() {
+		t.Fatal(d)
+	}
+	if src.TimestampValueExpression.ValueString() != "TimestampTime" || src.BodyExpression.ValueString() != "Body" {
+		t.Errorf("source = %+v", src)
+	}
+	if !strings.Contains(src.SeverityTextExpression.ValueString(), "'FATAL'") {
+		t.Errorf("severity expression does not map Postgres levels: %v", src.SeverityTextExpression)
+	}
+}
+
+func TestSourceFor_UnknownEnabled(t *testing.T) {
+	if got := sourceFor(context.Background(), types.BoolUnknown(), postgresMetricSource()); !got.IsUnknown() {
+		t.Errorf("sourceFor(unknown) = %v, want unknown", got)
+	}
+}
@@ -0,0 +1,59 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+)
+
+// The maintenance window, scaling schedule and observability export are
+// per-instance settings of a primary: each resource is keyed by the Postgres
+// service ID, and the server rejects writes for a read replica. The helpers
+// below are their shared import and error handling.
+
+// importPrimarySetting is the ImportState body of those resources. The ID is
+// the Postgres service ID; a read replica is refused up front, since every
+// write for it would fail. setting names what is imported, e.g.
+// "maintenance window", and replicaAdvice says where it lives instead.
+func importPrimarySetting(ctx context.Context, client api.Client, setting, replicaAdvice string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pg, err := client.GetPostgres(ctx, req.ID)
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Postgres service not found",
+				fmt.Sprintf("Postgres service %s does not exist or is not visible to the caller. Confirm the service ID is correct and the API key has access.", req.ID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error verifying Postgres service for import", err.Error())
+		return
+	}
+	if !pg.IsPrimary {
+		resp.Diagnostics.AddError(
+			"Cannot import "+setting+" on a read replica",
+			fmt.Sprintf("Postgres service %s is a read replica. %s", req.ID, replicaAdvice),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// primarySettingWriteError explains the documented write failures of those
+// resources (404 instance missing, 400 read replica) and passes anything else
+// through. replicaAdvice is appended to the read-replica message.
+func primarySettingWriteError(serviceID string, err error, replicaAdvice string) string {
+	switch {
+	case api.IsNotFound(err):
+		return fmt.Sprintf("Postgres service %s does not exist or is not visible to the caller. Confirm clickhouse_postgres_service.<name>.id is correct and the API key has access.", serviceID)
+	case api.IsBadRequestWith(err, "replica"):
+		return fmt.Sprintf("Postgres service %s is a read replica. %s", serviceID, replicaAdvice)
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,19 @@
+package resource
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPrimarySettingWriteError(t *testing.T) {
+	if got := primarySettingWriteError("pg-1", errors.New("status: 404, body: not found"), "advice"); !strings.Contains(got, "does not exist") {
+		t.Errorf("404: %s", got)
+	}
+	if got := primarySettingWriteError("pg-1", errors.New("status: 400, body: cannot configure a read replica"), "Configure the primary."); !strings.HasSuffix(got, "is a read replica. Configure the primary.") {
+		t.Errorf("replica: %s", got)
+	}
+	if got := primarySettingWriteError("pg-1", errors.New("status: 500, body: boom"), "advice"); got != "status: 500, body: boom" {
+		t.Errorf("other: %s", got)
+	}
+}
@@ -0,0 +1,311 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgsql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresRoleResource{}
+	_ resource.ResourceWithConfigure      = &PostgresRoleResource{}
+	_ resource.ResourceWithImportState    = &PostgresRoleResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresRoleResource{}
+)
+
+//go:embed descriptions/postgres_role.md
+var postgresRoleResourceDescription string
+
+// NewPostgresRoleResource constructs the clickhouse_postgres_role resource.
+func NewPostgresRoleResource() resource.Resource {
+	return &PostgresRoleResource{}
+}
+
+// PostgresRoleResource manages a role (user) inside a Managed Postgres
+// instance over SQL.
+type PostgresRoleResource struct {
+	client api.Client
+}
+
+func (r *PostgresRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_role"
+}
+
+func (r *PostgresRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flag := func(description string, def bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(def),
+		}
+	}
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Resource identifier in the form `service_id/name`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the role.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"login":           flag("Whether the role can log in, i.e. is a user. Defaults to false.", false),
+		"create_database": flag("Whether the role can create databases. Defaults to false.", false),
+		"create_role":     flag("Whether the role can create, alter and drop other roles. Defaults to false.", false),
+		"inherit":         flag("Whether the role inherits the privileges of roles it is a member of. Defaults to true.", true),
+		"connection_limit": schema.Int64Attribute{
+			Description: "Maximum number of concurrent connections for a login role; -1 (the default) means no limit.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(-1),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(-1),
+			},
+		},
+		"password": schema.StringAttribute{
+			Description: "Password of the role. Config-owned: never read back, so Terraform manages exactly the value declared here; changing it sets the new password. Stored in (sensitive) state — prefer `password_wo`. Removing it leaves the current password in place.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+			},
+		},
+		"password_wo": schema.StringAttribute{
+			Description: "Password of the role, write-only: applied but never persisted to Terraform state (requires Terraform >= 1.11). Requires `password_wo_version`; increment the version to set the current `password_wo` value.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			Description: "Version number for `password_wo`. Increment to set the role's password to the current `password_wo` value.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+			},
+		},
+	}
+	maps.Copy(attributes, postgresSQLConnectionAttributes())
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresRoleResourceDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *PostgresRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configurePostgresSQLResource(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *PostgresRoleResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_role", &resp.Diagnostics)
+}
+
+func (r *PostgresRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config models.PostgresRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), postgresMaintenanceDatabase)
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	role := planToPgsqlRole(plan)
+	role.Password = rolePasswordOnCreate(plan, config)
+	if err := conn.CreateRole(ctx, role); err != nil {
+		resp.Diagnostics.AddError("Error creating Postgres role", err.Error())
+		return
+	}
+	got, err := conn.GetRole(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres role after create", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + plan.Name.ValueString())
+	applyPgsqlRoleToState(got, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForRead(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, postgresMaintenanceDatabase, resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	got, err := conn.GetRole(ctx, state.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, pgsql.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres role", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	applyPgsqlRoleToState(got, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PostgresRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config models.PostgresRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := openPostgresSQL(ctx, r.client, plan.ServiceID.ValueString(), superuserPassword(config.SuperuserPasswordWO, plan.SuperuserPassword), postgresMaintenanceDatabase)
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+plan.ServiceID.ValueString()+": "+err.Error())
+		return
+	}
+	defer conn.Close(ctx)
+
+	role := planToPgsqlRole(plan)
+	role.Password = rolePasswordOnUpdate(plan, state, config)
+	if err := conn.UpdateRole(ctx, role); err != nil {
+		resp.Diagnostics.AddError("Error updating Postgres role", err.Error())
+		return
+	}
+	got, err := conn.GetRole(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Postgres role after update", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ServiceID.ValueString() + "/" + plan.Name.ValueString())
+	applyPgsqlRoleToState(got, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := openPostgresSQLForDelete(ctx, r.client, state.ServiceID.ValueString(), state.SuperuserPassword, postgresMaintenanceDatabase, "Role "+state.Name.ValueString(), resp)
+	if conn == nil {
+		return
+	}
+	defer conn.Close(ctx)
+
+	if err := conn.DropRole(ctx, state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Postgres role", err.Error()+". Reassign or drop the objects it owns, and revoke its privileges, first.")
+	}
+}
+
+func (r *PostgresRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, ok := utils.SplitServiceScopedImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Postgres role import ID",
+			fmt.Sprintf("Expected service_id/name, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func planToPgsqlRole(plan models.PostgresRoleResourceModel) pgsql.Role {
+	return pgsql.Role{
+		Name:            plan.Name.ValueString(),
+		Login:           plan.Login.ValueBool(),
+		CreateDatabase:  plan.CreateDatabase.ValueBool(),
+		CreateRole:      plan.CreateRole.ValueBool(),
+		Inherit:         plan.Inherit.ValueBool(),
+		ConnectionLimit: plan.ConnectionLimit.ValueInt64(),
+	}
+}
+
+// rolePasswordOnCreate returns the declared password, from password_wo
+// (config only) or password; nil creates the role without one.
+func rolePasswordOnCreate(plan, config models.PostgresRoleResourceModel) *string {
+	if !config.PasswordWO.IsNull() && !config.PasswordWO.IsUnknown() {
+		v := config.PasswordWO.ValueString()
+		return &v
+	}
+	if !plan.Password.IsNull() && !plan.Password.IsUnknown() {
+		v := plan.Password.ValueString()
+		return &v
+	}
+	return nil
+}
+
+// rolePasswordOnUpdate returns the password to set: the current password_wo
+// when password_wo_version changed, or a changed password. nil leaves the
+// role's password alone, including when the attribute is removed.
+func rolePasswordOnUpdate(plan, state, config models.PostgresRoleResourceModel) *string {
+	if !config.PasswordWO.IsNull() && !config.PasswordWO.IsUnknown() && !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		v := config.PasswordWO.ValueString()
+		return &v
+	}
+	if !plan.Password.IsNull() && !plan.Password.IsUnknown() && !plan.Password.Equal(state.Password) {
+		v := plan.Password.ValueString()
+		return &v
+	}
+	return nil
+}
+
+// applyPgsqlRoleToState writes the server-side options; the password
+// attributes are config-owned and left as they are.
+func applyPgsqlRoleToState(role *pgsql.Role, state *models.PostgresRoleResourceModel) {
+	state.Name = types.StringValue(role.Name)
+	state.Login = types.BoolValue(role.Login)
+	state.CreateDatabase = types.BoolValue(role.CreateDatabase)
+	state.CreateRole = types.BoolValue(role.CreateRole)
+	state.Inherit = types.BoolValue(role.Inherit)
+	state.ConnectionLimit = types.Int64Value(role.ConnectionLimit)
+}
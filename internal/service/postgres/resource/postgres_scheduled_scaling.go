@@ -0,0 +1,408 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &PostgresScheduledScalingResource{}
+	_ resource.ResourceWithConfigure      = &PostgresScheduledScalingResource{}
+	_ resource.ResourceWithImportState    = &PostgresScheduledScalingResource{}
+	_ resource.ResourceWithValidateConfig = &PostgresScheduledScalingResource{}
+)
+
+//go:embed descriptions/postgres_scheduled_scaling.md
+var postgresScheduledScalingResourceDescription string
+
+// NewPostgresScheduledScalingResource constructs the
+// clickhouse_postgres_scheduled_scaling resource.
+func NewPostgresScheduledScalingResource() resource.Resource {
+	return &PostgresScheduledScalingResource{}
+}
+
+// PostgresScheduledScalingResource manages the weekly schedule on which a
+// Managed Postgres instance is resized or stopped.
+type PostgresScheduledScalingResource struct {
+	client api.Client
+}
+
+func (r *PostgresScheduledScalingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_scheduled_scaling"
+}
+
+func (r *PostgresScheduledScalingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: postgresScheduledScalingResourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier. Equal to service_id (one schedule per instance).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of the `clickhouse_postgres_service` this schedule applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				Description: "Recurring windows. The server rejects any pair of entries that overlap in time, so at most one window is active at any moment; outside every window the instance runs at the `size` of `clickhouse_postgres_service`.",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.SizeAtMost(api.MaxAutoScalingScheduleEntries),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Human-readable name for the entry (e.g. \"Nights\").",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"weekdays": schema.SetAttribute{
+							Description: "Weekdays this entry covers. 0 = Sunday … 6 = Saturday.",
+							Required:    true,
+							ElementType: types.Int64Type,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueInt64sAre(int64validator.Between(0, 6)),
+							},
+						},
+						"start_hour_utc": schema.Int64Attribute{
+							Description: "Start hour in UTC (0-23). If end_hour_utc < start_hour_utc the window wraps overnight. Set start_hour_utc=0 and end_hour_utc=24 for a 24-hour window.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 23),
+							},
+						},
+						"end_hour_utc": schema.Int64Attribute{
+							Description: "End hour in UTC (1-24). Must differ from start_hour_utc; use end_hour_utc=24 to mean midnight at end of day.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 24),
+							},
+						},
+						"size": schema.StringAttribute{
+							Description: "Instance size to run at while the window is active. Exactly one of size or stopped = true is required.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"stopped": schema.BoolAttribute{
+							Description: "Stop the instance while the window is active and start it again when the window ends. Exactly one of size or stopped = true is required.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PostgresScheduledScalingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return
+	}
+	r.client = providerData.API
+}
+
+func (r *PostgresScheduledScalingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	utils.BetaWarning("clickhouse_postgres_scheduled_scaling", &resp.Diagnostics)
+	var config models.PostgresScheduledScalingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Entries.IsNull() || config.Entries.IsUnknown() {
+		return
+	}
+
+	var entries []models.PostgresScheduledScalingEntryModel
+	resp.Diagnostics.Append(config.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePostgresScalingEntries(entries)...)
+}
+
+func (r *PostgresScheduledScalingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PostgresScheduledScalingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	// Refuse to clobber an existing schedule. The user should import it.
+	existing, err := r.client.GetPostgresScalingSchedule(ctx, serviceID)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error checking for existing Postgres scaling schedule", err.Error())
+		return
+	}
+	if existing != nil && len(existing.Entries) > 0 {
+		resp.Diagnostics.AddError(
+			"Scaling schedule already exists for this Postgres service",
+			fmt.Sprintf("Postgres service %s already has a scaling schedule with %d entries. Import it into Terraform with: terraform import clickhouse_postgres_scheduled_scaling.<name> %s", serviceID, len(existing.Entries), serviceID),
+		)
+		return
+	}
+
+	entries, d := planPostgresScalingEntriesToAPI(ctx, plan.Entries)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.UpdatePostgresScalingSchedule(ctx, serviceID, api.PostgresScalingScheduleUpdate{Entries: entries})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Postgres scaling schedule", primarySettingWriteError(serviceID, err, scalingScheduleReplicaAdvice))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(applyPostgresScalingScheduleToState(schedule, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresScheduledScalingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PostgresScheduledScalingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetPostgresScalingSchedule(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Postgres scaling schedule", err.Error())
+		return
+	}
+
+	state.ID = state.ServiceID
+	resp.Diagnostics.Append(applyPostgresScalingScheduleToState(schedule, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PostgresScheduledScalingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PostgresScheduledScalingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, d := planPostgresScalingEntriesToAPI(ctx, plan.Entries)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.UpdatePostgresScalingSchedule(ctx, plan.ServiceID.ValueString(), api.PostgresScalingScheduleUpdate{Entries: entries})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Postgres scaling schedule", primarySettingWriteError(plan.ServiceID.ValueString(), err, scalingScheduleReplicaAdvice))
+		return
+	}
+
+	plan.ID = plan.ServiceID
+	resp.Diagnostics.Append(applyPostgresScalingScheduleToState(schedule, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PostgresScheduledScalingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PostgresScheduledScalingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePostgresScalingSchedule(ctx, state.ServiceID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting Postgres scaling schedule", err.Error())
+	}
+}
+
+func (r *PostgresScheduledScalingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPrimarySetting(ctx, r.client, "scaling schedule", scalingScheduleReplicaAdvice, req, resp)
+}
+
+// scalingScheduleReplicaAdvice completes the read-replica errors.
+const scalingScheduleReplicaAdvice = "A replica runs while its primary does; configure the schedule on the primary instead."
+
+// validatePostgresScalingEntries enforces the per-entry rules no single
+// attribute validator can: a non-zero window, and exactly one action — a
+// size or stopped = true. An unknown size or stopped is deferred to apply.
+func validatePostgresScalingEntries(entries []models.PostgresScheduledScalingEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	entriesPath := path.Root("entries")
+	for _, e := range entries {
+		entryRef := fmt.Sprintf("Entry %q", e.Name.ValueString())
+
+		if !e.StartHourUtc.IsNull() && !e.StartHourUtc.IsUnknown() && !e.EndHourUtc.IsNull() && !e.EndHourUtc.IsUnknown() {
+			if e.StartHourUtc.ValueInt64() == e.EndHourUtc.ValueInt64() {
+				diags.AddAttributeError(
+					entriesPath,
+					"start_hour_utc and end_hour_utc must differ",
+					fmt.Sprintf("%s has a zero-duration window.", entryRef),
+				)
+			}
+		}
+
+		if e.Size.IsUnknown() || e.Stopped.IsUnknown() {
+			continue
+		}
+		hasSize, stopped := !e.Size.IsNull(), e.Stopped.ValueBool()
+		switch {
+		case hasSize && stopped:
+			diags.AddAttributeError(
+				entriesPath,
+				"size and stopped are mutually exclusive",
+				fmt.Sprintf("%s sets both size and stopped = true. A stopped instance has no size; drop one of them.", entryRef),
+			)
+		case !hasSize && !stopped:
+			diags.AddAttributeError(
+				entriesPath,
+				"Entry has no action",
+				fmt.Sprintf("%s sets neither size nor stopped = true.", entryRef),
+			)
+		}
+	}
+	return diags
+}
+
+// planPostgresScalingEntriesToAPI converts the planned set of entries into
+// API entries.
+func planPostgresScalingEntriesToAPI(ctx context.Context, entriesSet types.Set) ([]api.PostgresScalingScheduleEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if entriesSet.IsNull() || entriesSet.IsUnknown() {
+		return []api.PostgresScalingScheduleEntry{}, diags
+	}
+
+	var entryModels []models.PostgresScheduledScalingEntryModel
+	diags.Append(entriesSet.ElementsAs(ctx, &entryModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]api.PostgresScalingScheduleEntry, len(entryModels))
+	for i, em := range entryModels {
+		var weekdays []int64
+		diags.Append(em.Weekdays.ElementsAs(ctx, &weekdays, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		intWeekdays := make([]int, len(weekdays))
+		for j, w := range weekdays {
+			intWeekdays[j] = int(w)
+		}
+		// Sorted so the same config always sends the same body.
+		sort.Ints(intWeekdays)
+
+		result[i] = api.PostgresScalingScheduleEntry{
+			Name:         em.Name.ValueString(),
+			Weekdays:     intWeekdays,
+			StartHourUtc: int(em.StartHourUtc.ValueInt64()),
+			EndHourUtc:   int(em.EndHourUtc.ValueInt64()),
+			Size:         em.Size.ValueString(),
+			Stopped:      em.Stopped.ValueBool(),
+		}
+	}
+
+	return result, diags
+}
+
+// applyPostgresScalingScheduleToState maps an API schedule into the state
+// model. An omitted size reads back as null so it matches an entry that only
+// declares stopped = true.
+func applyPostgresScalingScheduleToState(schedule *api.PostgresScalingSchedule, state *models.PostgresScheduledScalingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	entryValues := make([]attr.Value, len(schedule.Entries))
+	for i, e := range schedule.Entries {
+		weekdayValues := make([]attr.Value, len(e.Weekdays))
+		for j, w := range e.Weekdays {
+			weekdayValues[j] = types.Int64Value(int64(w))
+		}
+		weekdaySet, d := types.SetValue(types.Int64Type, weekdayValues)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		size := types.StringNull()
+		if e.Size != "" {
+			size = types.StringValue(e.Size)
+		}
+		entryValues[i] = models.PostgresScheduledScalingEntryModel{
+			Name:         types.StringValue(e.Name),
+			Weekdays:     weekdaySet,
+			StartHourUtc: types.Int64Value(int64(e.StartHourUtc)),
+			EndHourUtc:   types.Int64Value(int64(e.EndHourUtc)),
+			Size:         size,
+			Stopped:      types.BoolValue(e.Stopped),
+		}.ObjectValue()
+	}
+	entriesSet, d := types.SetValue(models.PostgresScheduledScalingEntryModel{}.ObjectType(), entryValues)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	state.Entries = entriesSet
+
+	return diags
+}
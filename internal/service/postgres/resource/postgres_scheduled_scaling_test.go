@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+func scalingEntry(name string, size types.String, stopped bool, start, end int64) models.PostgresScheduledScalingEntryModel {
+	return models.PostgresScheduledScalingEntryModel{
+		Name:         types.StringValue(name),
+		Weekdays:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(5), types.Int64Value(1)}),
+		StartHourUtc: types.Int64Value(start),
+		EndHourUtc:   types.Int64Value(end),
+		Size:         size,
+		Stopped:      types.BoolValue(stopped),
+	}
+}
+
+func TestValidatePostgresScalingEntries(t *testing.T) {
+	cases := []struct {
+		name    string
+		entry   models.PostgresScheduledScalingEntryModel
+		wantErr bool
+	}{
+		{"resize", scalingEntry("a", types.StringValue("m6gd.medium"), false, 20, 7), false},
+		{"stop", scalingEntry("a", types.StringNull(), true, 0, 24), false},
+		{"both", scalingEntry("a", types.StringValue("m6gd.medium"), true, 0, 24), true},
+		{"neither", scalingEntry("a", types.StringNull(), false, 0, 24), true},
+		{"zero-length window", scalingEntry("a", types.StringNull(), true, 8, 8), true},
+		{"unknown size", scalingEntry("a", types.StringUnknown(), false, 0, 24), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := validatePostgresScalingEntries([]models.PostgresScheduledScalingEntryModel{c.entry})
+			if d.HasError() != c.wantErr {
+				t.Errorf("HasError = %v, want %v: %v", d.HasError(), c.wantErr, d)
+			}
+		})
+	}
+}
+
+func TestPostgresScalingScheduleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	planned := types.SetValueMust(models.PostgresScheduledScalingEntryModel{}.ObjectType(), []attr.Value{
+		scalingEntry("nights", types.StringValue("m6gd.medium"), false, 20, 7).ObjectValue(),
+		scalingEntry("weekend", types.StringNull(), true, 0, 24).ObjectValue(),
+	})
+
+	entries, d := planPostgresScalingEntriesToAPI(ctx, planned)
+	if d.HasError() {
+		t.Fatal(d)
+	}
+	for _, e := range entries {
+		if e.Weekdays[0] != 1 || e.Weekdays[1] != 5 {
+			t.Errorf("weekdays not sorted: %v", e.Weekdays)
+		}
+	}
+
+	// The server echoes the entries back with ids; state must equal the plan.
+	for i := range entries {
+		entries[i].ID = "e"
+	}
+	state := models.PostgresScheduledScalingResourceModel{}
+	if d := applyPostgresScalingScheduleToState(&api.PostgresScalingSchedule{Entries: entries}, &state); d.HasError() {
+		t.Fatal(d)
+	}
+	if !state.Entries.Equal(planned) {
+		t.Errorf("state entries differ from plan:\n got %s\nwant %s", state.Entries, planned)
+	}
+}
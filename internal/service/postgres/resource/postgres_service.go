@@ -5,9 +5,14 @@ import (
 	_ "embed"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,13 +24,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgconfig"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/utils"
 )
@@ -59,7 +69,8 @@ func (r *PostgresServiceResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // ValidateConfig surfaces the beta warning at plan time, matching the other
-// beta resources (clickhouse_service_upgrade_window, …).
+// beta resources (clickhouse_service_upgrade_window, …), and checks
+// pg_config / pgbouncer_config against the parameter catalog.
 //
 // State-dependent rules are NOT enforced here: ValidateConfig is stateless, so
 // it can't tell a create from an update or read prior state. That covers the
@@ -68,8 +79,15 @@ func (r *PostgresServiceResource) Metadata(_ context.Context, req resource.Metad
 // existing instance drops its origin block) and the live-replica modification
 // block (which needs is_primary from prior state). All of these live in
 // ModifyPlan, which has prior state.
-func (r *PostgresServiceResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+func (r *PostgresServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	utils.BetaWarning("clickhouse_postgres_service", &resp.Diagnostics)
+
+	var config models.PostgresServiceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateConfigCatalog(ctx, config)...)
 }
 
 // configIsOrigin reports whether the config declares a read replica or restore.
@@ -99,6 +117,15 @@ func originSourceChanged(config, state models.PostgresServiceResourceModel) bool
 	return false
 }
 
+// isReplicaPromotion reports whether an update promotes a live read replica in
+// place: read_replica_of was set and is now removed while the instance is
+// still a replica (known is_primary=false). An imported replica has no
+// read_replica_of in state, so it is never promoted this way.
+func isReplicaPromotion(plan, state models.PostgresServiceResourceModel) bool {
+	return !state.ReadReplicaOf.IsNull() && plan.ReadReplicaOf.IsNull() &&
+		!state.IsPrimary.IsNull() && !state.IsPrimary.ValueBool()
+}
+
 // forbidEmptyConfigOnCreate rejects an explicit empty pg_config / pgbouncer_config
 // on a create (or a source-change replace). The server's create endpoints
 // validate these as undefinedOr(isPopulatedObject), so an empty {} is a 400 —
@@ -276,12 +303,78 @@ func replicaUpdateForbidden(plan, state models.PostgresServiceResourceModel) dia
 		diags.AddAttributeError(
 			path.Root(name),
 			"Read replica cannot be modified directly",
-			"`"+name+"` cannot be changed on a live read replica — the server rejects direct modifications. Change it on the parent (primary) instead. Removing read_replica_of turns this into a standalone primary, but destroys and recreates the instance (it is not an in-place detach).",
+			"`"+name+"` cannot be changed on a live read replica — the server rejects direct modifications. Change it on the parent (primary) instead, or remove read_replica_of first to promote this replica to a standalone primary in place.",
 		)
 	}
 	forbid("size", plan.Size, state.Size)
 	forbid("ha_type", plan.HaType, state.HaType)
 	forbid("tags", plan.Tags, state.Tags)
+	forbid("ip_access", plan.IpAccess, state.IpAccess)
+	forbid("private_endpoint_ids", plan.PrivateEndpointIDs, state.PrivateEndpointIDs)
+	forbid("backup_configuration", plan.BackupConfiguration, state.BackupConfiguration)
+	forbid("postgres_version", plan.PostgresVersion, state.PostgresVersion)
+	forbid("desired_state", plan.DesiredState, state.DesiredState)
+	return diags
+}
+
+// forbidPrimaryOnlyOnReplica rejects attributes a read replica create cannot
+// take. ip_access / private_endpoint_ids travel on the PATCH endpoint the
+// server refuses for a live replica, so there is no way to apply them after
+// the replica is provisioned; the replica's values are read back into state
+// instead. backup_configuration has nothing to apply to: a replica takes no
+// backups of its own.
+func forbidPrimaryOnlyOnReplica(config models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.ReadReplicaOf.IsNull() || config.ReadReplicaOf.IsUnknown() {
+		return diags
+	}
+	check := func(name string, v attr.Value, reason string) {
+		if v.IsNull() {
+			return
+		}
+		diags.AddAttributeError(
+			path.Root(name),
+			"Attribute not allowed for a read replica",
+			"`"+name+"` cannot be set on a read replica — "+reason+"; omit it.",
+		)
+	}
+	check("ip_access", config.IpAccess, "the server rejects direct modifications to a replica")
+	check("private_endpoint_ids", config.PrivateEndpointIDs, "the server rejects direct modifications to a replica")
+	check("backup_configuration", config.BackupConfiguration, "a replica takes no backups of its own")
+	check("desired_state", config.DesiredState, "a replica runs while its primary does")
+	return diags
+}
+
+// validateRestoreTarget checks a point-in-time restore target against the
+// source's restorable window: it must be RFC3339, no earlier than the
+// source's earliest restore point, and not in the future. Only run when
+// planning a create (or a source-change replace), so an existing restored
+// instance isn't flagged once its target ages out of the source's retention.
+func validateRestoreTarget(target string, window *api.PostgresBackups, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attrPath := path.Root("restore_to_point_in_time").AtName("restore_target")
+
+	t, err := time.Parse(time.RFC3339, target)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid restore target",
+			"`restore_target` must be an RFC3339 timestamp (e.g. 2026-06-01T12:00:00Z): "+err.Error())
+		return diags
+	}
+	if window.EarliestRestoreTarget == "" {
+		diags.AddAttributeError(attrPath, "Source has no restorable backup",
+			"The source instance has no completed backup yet, so there is nothing to restore. The first automatic backup is taken about 10 minutes after an instance is created.")
+		return diags
+	}
+	earliest, err := time.Parse(time.RFC3339, window.EarliestRestoreTarget)
+	if err != nil {
+		diags.AddError("Unexpected restore window",
+			"Could not parse the source's earliest restore target "+window.EarliestRestoreTarget+": "+err.Error())
+		return diags
+	}
+	if t.Before(earliest) || t.After(now) {
+		diags.AddAttributeError(attrPath, "Restore target outside the restorable window",
+			"`restore_target` "+target+" is outside the source's restorable window. Choose a time between "+window.EarliestRestoreTarget+" and now (see the clickhouse_postgres_backups data source).")
+	}
 	return diags
 }
 
@@ -332,7 +425,7 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"postgres_version": schema.StringAttribute{
-				Description: "Major Postgres version (e.g. '18'). The server picks the patch release within that major. Changing the major triggers destroy-and-recreate. Omit for a read replica or point-in-time restore (inherited from the source).",
+				Description: "Major Postgres version (e.g. '18'). The server picks the patch release within that major; patches are applied in the instance's maintenance window (see clickhouse_postgres_maintenance_window). Raising the major upgrades the instance in place after a server-side compatibility check; the instance is unavailable while it upgrades. Lowering it is a plan-time error (downgrades are not supported; use `terraform apply -replace` to recreate). Omit for a read replica or point-in-time restore (inherited from the source); a replica follows its primary's upgrade.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
@@ -340,7 +433,6 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
 				},
 			},
 
@@ -381,10 +473,17 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				Description: "Power state to hold the instance in: 'running' or 'stopped'. A stopped instance keeps its storage and backups but does not accept connections. Omit to leave the power state unmanaged. While the instance stays stopped, size / ha_type / postgres_version / pg_config / pgbouncer_config / password cannot change; set 'running' to apply them. Do not set it on an instance that a `clickhouse_postgres_scheduled_scaling` schedule stops, or each apply undoes the schedule. Must be omitted for a read replica.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(postgresDesiredStates...),
+				},
+			},
 
 			// --- Runtime configuration ---------------------------------------
 			"pg_config": schema.MapAttribute{
-				Description: "Postgres server parameters (pgConfig) as a key-value map. Declared parameters are the desired state — every apply sends the full map via POST /config (full replacement), so removing a key from the map removes it server-side. Set `pg_config = {}` to clear all parameters; omit the attribute to preserve the prior state (read replicas inherit the primary's parameters, and the server may surface values the configuration never declared — so it is Optional+Computed like tags). Out-of-band changes are reverted on the next apply. Some parameters require a database restart; the provider surfaces the server's restart-required hint as a warning (restart out-of-band).",
+				Description: "Postgres server parameters (pgConfig) as a key-value map. Declared parameters are the desired state — every apply sends the full map via POST /config (full replacement), so removing a key from the map removes it server-side. Set `pg_config = {}` to clear all parameters; omit the attribute to preserve the prior state (read replicas inherit the primary's parameters, and the server may surface values the configuration never declared — so it is Optional+Computed like tags). Out-of-band changes are reverted on the next apply. Keys and values are checked at plan time against the provider's catalog of supported parameters for postgres_version; a value the server reports in an equivalent form (e.g. `262144kB` for `256MB`) keeps its declared spelling. Some parameters require a database restart; the plan warns when a change touches one, and the server's restart-required hint is surfaced as a warning on apply (restart out-of-band).",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -402,7 +501,7 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"pgbouncer_config": schema.MapAttribute{
-				Description: "PgBouncer connection-pooler parameters (pgBouncerConfig) as a key-value map. Same Optional+Computed semantics as pg_config; set `pgbouncer_config = {}` to clear.",
+				Description: "PgBouncer connection-pooler parameters (pgBouncerConfig) as a key-value map. Same Optional+Computed semantics and plan-time catalog checks as pg_config; set `pgbouncer_config = {}` to clear.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -415,6 +514,77 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 
+			// --- Network access ----------------------------------------------
+			"ip_access": schema.SetNestedAttribute{
+				Description: "IP addresses allowed to connect to the instance. Omit the attribute to preserve the current list (the server default applies on create); set `ip_access = []` to remove every entry. Changes are applied in place as add/remove diffs. Must be omitted for a read replica.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "IP address or CIDR range allowed to connect. Use 0.0.0.0/0 to allow access from anywhere.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the entry.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"private_endpoint_ids": schema.SetAttribute{
+				Description: "IDs of private endpoints attached to the instance. The endpoints must already be registered in the organization's private endpoint allow list (see `clickhouse_private_endpoint_registration`). Omit the attribute to preserve the current attachments; set `private_endpoint_ids = []` to detach all. Must be omitted for a read replica.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			// --- Backups -----------------------------------------------------
+			"backup_configuration": schema.SingleNestedAttribute{
+				Description: "Backup settings for the instance. Omit the block, or either attribute, to keep the current value (the server default applies on create). Must be omitted for a read replica, which takes no backups of its own; it is null in state for a replica.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"retention_days": schema.Int64Attribute{
+						Description: fmt.Sprintf("Number of days backups are kept; this is also how far back a point-in-time restore can reach. Between %d and %d.", postgresBackupRetentionDaysMin, postgresBackupRetentionDaysMax),
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.Between(postgresBackupRetentionDaysMin, postgresBackupRetentionDaysMax),
+						},
+					},
+					"backup_window_start": schema.StringAttribute{
+						Description: "Start of the daily backup window in UTC, as HH:MM (e.g. '02:00').",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a UTC time in HH:MM format"),
+						},
+					},
+				},
+			},
+
 			// --- Computed ----------------------------------------------------
 			"state": schema.StringAttribute{
 				Description: "Server-reported state. Examples: 'creating', 'running', 'restarting', 'unavailable', 'deleting'. Forward-compatible: unknown values from the server are surfaced verbatim.",
@@ -458,6 +628,19 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"ssl_root_cert_path": schema.StringAttribute{
+				Description: "Path the client reads the CA certificate from, used as `sslrootcert` in `connection_strings`. When unset, `sslrootcert` is omitted and libpq and pgJDBC fall back to `~/.postgresql/root.crt`. Not sent to the API.",
+				Optional:    true,
+			},
+			"connection_strings": schema.SingleNestedAttribute{
+				Description: "Connection strings for the superuser, with `sslmode=verify-full`: direct, PgBouncer-pooled and, for a read replica, read-only, each as a libpq URI, a JDBC URL and a keyword/value string. `password` is embedded when declared; `password_wo` never is. Use the `clickhouse_postgres_connection_strings` ephemeral resource to get them without writing them to state.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Object{
+					connectionStringsUseStateForUnknown{},
+				},
+				Attributes: connstr.ResourceAttributes(),
+			},
 			// --- Sensitive ---------------------------------------------------
 			"password": schema.StringAttribute{
 				Description: "Superuser password. Config-owned: the API does not return the password, so Terraform manages exactly the value declared here and never reads it back. One of `password` or `password_wo` is required for a standard service; forbidden for a read replica (it inherits the primary's superuser); optional for a point-in-time restore (omit to keep the source's password, which Terraform then does not track). Changing this value rotates the password (PATCH /password). Must be ≥12 chars with at least one lowercase, one uppercase, and one digit. Stored in (sensitive) state — prefer `password_wo` to keep it out of state. `terraform import` cannot recover the live password — the configured value is rotated in on the first apply after import.",
@@ -495,13 +678,13 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 
 			// --- Provenance / immutable --------------------------------------
 			"read_replica_of": schema.StringAttribute{
-				Description: "ID of the primary instance to replicate. When set, this instance is created as a read replica (streaming replication) of that primary. Immutable for a live replica: changing or removing it destroys and recreates the instance as a standalone primary. The one exception is an out-of-band promotion — if you promote the replica via the API/UI (is_primary becomes true), changing or removing read_replica_of then reconciles state in place without destroying the promoted primary. Mutually exclusive with restore_to_point_in_time and with password/password_wo (a replica inherits the primary's superuser). After an out-of-band promotion, removing read_replica_of requires declaring password or password_wo, which rotates the promoted primary's superuser password.",
+				Description: "ID of the primary instance to replicate. When set, this instance is created as a read replica (streaming replication) of that primary. Removing it promotes the replica in place to a standalone primary: the instance keeps its ID and hostname, and the apply waits until is_primary is true. Pointing it at a different primary destroys and recreates the instance (unless the replica was already promoted out-of-band, is_primary true, where the change is reconciled in place). Mutually exclusive with restore_to_point_in_time and with password/password_wo (a replica inherits the primary's superuser). Removing read_replica_of requires declaring password or password_wo, which is rotated in as the promoted primary's superuser password.",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIf(
 						readReplicaOfRequiresReplace,
-						"changing or removing read_replica_of replaces the instance unless it was promoted out-of-band",
-						"changing or removing `read_replica_of` replaces the instance unless it was promoted out-of-band (`is_primary` is true)",
+						"pointing read_replica_of at a different primary replaces the instance unless it was promoted out-of-band; removing it promotes in place",
+						"pointing `read_replica_of` at a different primary replaces the instance unless it was promoted out-of-band (`is_primary` is true); removing it promotes in place",
 					),
 				},
 				Validators: []validator.String{
@@ -532,7 +715,7 @@ func (r *PostgresServiceResource) Schema(_ context.Context, _ resource.SchemaReq
 						},
 					},
 					"restore_target": schema.StringAttribute{
-						Description: "RFC3339 timestamp to restore to (e.g. '2026-06-01T12:00:00Z'). The server restores to the closest available recovery point at or before this time.",
+						Description: "RFC3339 timestamp to restore to (e.g. '2026-06-01T12:00:00Z'). The server restores to the closest available recovery point at or before this time. Checked at plan time against the source's restorable window (see the clickhouse_postgres_backups data source).",
 						Required:    true,
 					},
 				},
@@ -614,6 +797,26 @@ func (r *PostgresServiceResource) Create(ctx context.Context, req resource.Creat
 		pg = p
 	}
 
+	// Track the instance before anything else can fail, so an error below
+	// leaves it in state (tainted) instead of orphaned. The password is not
+	// recorded until it has been rotated in, backup_configuration until it has
+	// been applied, and desired_state until the stop.
+	pwIntent := decidePasswordOnCreate(plan, config)
+	created := plan
+	created.ID = types.StringValue(pg.Id)
+	created.BackupConfiguration = types.ObjectNull(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes)
+	created.DesiredState = types.StringNull()
+	if pwIntent.Set {
+		created.Password = types.StringNull()
+		created.PasswordWOVersion = types.Int64Null()
+	}
+	resp.Diagnostics.Append(syncPostgresState(ctx, pg, &created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+	resp.Diagnostics.Append(nullUnknownState(&resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Restore and replica creates also transition through non-running states;
 	// the running-state checker treats every non-running value as "still
 	// transitioning", so the same wait covers all three paths.
@@ -625,8 +828,29 @@ func (r *PostgresServiceResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// A restore request carries no network settings, so declared ip_access /
+	// private_endpoint_ids are diffed against the restored instance and PATCHed
+	// once it is running. (A standard create sends them in the POST body; a
+	// read replica cannot declare them.)
+	if !plan.RestoreToPointInTime.IsNull() && !plan.RestoreToPointInTime.IsUnknown() {
+		resp.Diagnostics.Append(r.applyNetworkAccessAfterRestore(ctx, pg.Id, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Backup settings have their own endpoint; no create request carries them.
+	if body := backupConfigurationToAPI(plan.BackupConfiguration, types.ObjectNull(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes)); body != nil {
+		if _, err := r.client.UpdatePostgresBackupConfiguration(ctx, pg.Id, *body); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting Postgres backup configuration",
+				"Provisioned Postgres service "+pg.Id+" but could not apply backup_configuration: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Rotate to the declared credential (server always generates an initial one).
-	pwIntent := decidePasswordOnCreate(plan, config)
 	if pwIntent.Set {
 		value := pwIntent.Value
 		if _, err := r.client.SetPostgresPassword(ctx, pg.Id, api.PostgresPassword{Password: value}); err != nil {
@@ -638,6 +862,14 @@ func (r *PostgresServiceResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	// Stop last: the password rotation above needs a running server.
+	if plan.DesiredState.ValueString() == postgresDesiredStateStopped {
+		resp.Diagnostics.Append(r.changeDesiredState(ctx, pg.Id, api.PostgresStateCommandStop)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Re-read to pick up hostname / created_at / final state.
 	final, err := r.client.GetPostgres(ctx, pg.Id)
 	if err != nil {
@@ -674,6 +906,19 @@ func (r *PostgresServiceResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.readBackupConfiguration(ctx, &model); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Postgres backup configuration after create",
+			"Could not read backup_configuration for Postgres service "+final.Id+": "+err.Error(),
+		)
+		return
+	}
+	if err := r.readConnectionStrings(ctx, &model); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read Postgres CA certificates after create",
+			"Could not fetch the CA certificates for connection_strings of Postgres service "+final.Id+"; they are filled in on the next refresh: "+err.Error(),
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
@@ -700,10 +945,17 @@ func (r *PostgresServiceResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	priorSize := state.Size
 	resp.Diagnostics.Append(syncPostgresState(ctx, pg, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.keepScheduledSize(ctx, priorSize, &state); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read Postgres scaling schedule",
+			"Could not check whether a scaling schedule resized Postgres service "+state.ID.ValueString()+"; size is read as reported by the server: "+err.Error(),
+		)
+	}
 	// Note on out-of-band promotion: the API exposes no parent id, so a promoted
 	// replica is detected only by is_primary flipping true (synced above). We do
 	// NOT rewrite read_replica_of here — config still declares it, so clearing it
@@ -735,13 +987,38 @@ func (r *PostgresServiceResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.readBackupConfiguration(ctx, &state); err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Postgres backup configuration",
+			"Could not read backup_configuration for Postgres service "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if err := r.readConnectionStrings(ctx, &state); err != nil {
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Could not read Postgres CA certificates",
+			"Could not fetch the CA certificates for connection_strings of Postgres service "+state.ID.ValueString()+"; keeping the prior connection_strings: "+err.Error(),
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update applies in-place mutations: size / ha_type / tags (PATCH /postgres),
-// pg_config / pgbouncer_config (POST /config), and password rotation
-// (PATCH /password). name / cloud_provider / region / postgres_version and
+// Update applies in-place mutations: replica promotion (POST /promote, when
+// read_replica_of is removed), a major upgrade (POST /upgrade, when
+// postgres_version is raised), size / ha_type / tags / ip_access /
+// private_endpoint_ids (PATCH /postgres),
+// pg_config / pgbouncer_config (POST /config), backup_configuration
+// (PATCH /backupConfiguration), password rotation (PATCH /password), and
+// desired_state (PATCH /state; a start runs first, a stop last). name / cloud_provider / region and
 // restore_to_point_in_time are RequiresReplace; read_replica_of is
 // RequiresReplaceIf (replace for a live replica, adopted in place once promoted
 // out-of-band) so Update also handles that in-place adoption.
@@ -762,13 +1039,81 @@ func (r *PostgresServiceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	rotateValue, rotate := decidePasswordRotationOnUpdate(plan, state, config)
+	promote := isReplicaPromotion(plan, state)
+	backupUpdate := backupConfigurationToAPI(plan.BackupConfiguration, state.BackupConfiguration)
+	upgradeTo, upgrade := majorUpgradeTarget(plan, state)
+	stateCommand, changeState := desiredStateCommand(plan, state.State.ValueString())
 
-	if updatePlan.Body == nil && !configUpdate.Changed && !rotate {
+	if !promote && !upgrade && updatePlan.Body == nil && !configUpdate.Changed && backupUpdate == nil && !rotate && !changeState {
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 		return
 	}
 
-	// Instance-level PATCH (size / ha_type / tags).
+	// A start runs before everything else, which needs a running server.
+	if changeState && stateCommand == api.PostgresStateCommandStart {
+		resp.Diagnostics.Append(r.changeDesiredState(ctx, state.ID.ValueString(), stateCommand)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Promotion runs first: until is_primary flips the instance is still a
+	// replica, and the server rejects the PATCH and password rotation below.
+	if promote {
+		if _, err := r.client.PromotePostgres(ctx, state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error promoting Postgres read replica",
+				"Could not promote read replica "+state.ID.ValueString()+" to a primary: "+err.Error(),
+			)
+			return
+		}
+		if err := r.client.WaitForPostgresMatch(ctx, state.ID.ValueString(), isPromotedPrimary, postgresDefaultUpdateTimeoutSeconds); err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for Postgres read replica promotion",
+				"Could not confirm read replica "+state.ID.ValueString()+" was promoted to a running primary: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Major upgrade runs before the PATCH so a resize lands on the upgraded
+	// instance. The compatibility check changes nothing, so a failed check
+	// leaves the instance untouched. The wait is field-aware (target version
+	// AND running): the instance is still "running" right after the POST.
+	if upgrade {
+		check, err := r.client.CheckPostgresUpgrade(ctx, state.ID.ValueString(), api.PostgresUpgradeRequest{PostgresVersion: upgradeTo})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error checking Postgres major version upgrade",
+				"Could not check whether Postgres service "+state.ID.ValueString()+" can be upgraded to "+upgradeTo+": "+err.Error(),
+			)
+			return
+		}
+		if !check.Compatible {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("postgres_version"),
+				"Postgres service is not ready for a major version upgrade",
+				"The server's pre-upgrade check for Postgres "+upgradeTo+" failed; nothing was changed. Resolve the following and apply again:\n- "+strings.Join(check.Issues, "\n- "),
+			)
+			return
+		}
+		if _, err := r.client.UpgradePostgres(ctx, state.ID.ValueString(), api.PostgresUpgradeRequest{PostgresVersion: upgradeTo}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error upgrading Postgres service",
+				"Could not start the upgrade of Postgres service "+state.ID.ValueString()+" to "+upgradeTo+": "+err.Error(),
+			)
+			return
+		}
+		if err := r.client.WaitForPostgresMatch(ctx, state.ID.ValueString(), isUpgradedTo(upgradeTo), postgresDefaultUpgradeTimeoutSeconds); err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for Postgres major version upgrade",
+				"Could not confirm Postgres service "+state.ID.ValueString()+" is running Postgres "+upgradeTo+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Instance-level PATCH (size / ha_type / tags / network access).
 	if updatePlan.Body != nil {
 		if _, err := r.client.UpdatePostgres(ctx, state.ID.ValueString(), *updatePlan.Body); err != nil {
 			resp.Diagnostics.AddError(
@@ -813,6 +1158,16 @@ func (r *PostgresServiceResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
+	if backupUpdate != nil {
+		if _, err := r.client.UpdatePostgresBackupConfiguration(ctx, state.ID.ValueString(), *backupUpdate); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Postgres backup configuration",
+				"Could not update backup_configuration for Postgres service "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Password rotation (PATCH /password): a password_wo_version bump (rotating
 	// to the config-read, write-only password_wo) or, otherwise, a change to the
 	// `password` value. Never part of the instance PATCH body.
@@ -827,6 +1182,14 @@ func (r *PostgresServiceResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
+	// A stop runs after everything else, for the same reason.
+	if changeState && stateCommand == api.PostgresStateCommandStop {
+		resp.Diagnostics.Append(r.changeDesiredState(ctx, state.ID.ValueString(), stateCommand)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	pg, err := r.client.GetPostgres(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -835,10 +1198,18 @@ func (r *PostgresServiceResource) Update(ctx context.Context, req resource.Updat
 		)
 		return
 	}
+	plannedSize := plan.Size
 	resp.Diagnostics.Append(syncPostgresState(ctx, pg, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.keepScheduledSize(ctx, plannedSize, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Postgres scaling schedule after update",
+			"Could not check whether a scaling schedule resized Postgres service "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
 	cfg, err := r.client.GetPostgresConfig(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -851,6 +1222,19 @@ func (r *PostgresServiceResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.readBackupConfiguration(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Postgres backup configuration after update",
+			"Could not refresh backup_configuration for Postgres service "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if err := r.readConnectionStrings(ctx, &plan); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not read Postgres CA certificates after update",
+			"Could not fetch the CA certificates for connection_strings of Postgres service "+state.ID.ValueString()+"; they are filled in on the next refresh: "+err.Error(),
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -892,8 +1276,16 @@ func (r *PostgresServiceResource) ImportState(ctx context.Context, req resource.
 //   - On update: require a declared credential for primaries (a live replica
 //     adopted by import is exempt — it can take no credential — but declaring
 //     one on it draws a plan-time warning, since the apply would fail).
+//   - On update: removing read_replica_of from a live replica plans an
+//     in-place promotion (credential required, is_primary planned true).
 //   - On update: surface an out-of-band promotion (is_primary flipped while
 //     read_replica_of is still declared) as an error.
+//   - On update: plan a postgres_version increase as an in-place major
+//     upgrade (with a warning) and reject a decrease.
+//   - On update: reject compute changes while the instance stays stopped, and
+//     plan state as the target of a desired_state start or stop.
+//   - On update: warn when a pg_config change touches a parameter that only
+//     takes effect after a restart.
 //
 // read_replica_of and restore_to_point_in_time are plain (non-Computed)
 // attributes whose RequiresReplaceIf / RequiresReplace modifiers own their
@@ -918,6 +1310,7 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 		resp.Diagnostics.Append(requireStandardCreateAttributes(config)...)
 		resp.Diagnostics.Append(requireDeclaredCredential(config)...)
 		resp.Diagnostics.Append(forbidEmptyConfigOnCreate(config)...)
+		resp.Diagnostics.Append(forbidPrimaryOnlyOnReplica(config)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -942,7 +1335,14 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 	// (detach, rename, ForceNew) are covered separately: Terraform Core
 	// re-plans the create side of a replace with a null prior state,
 	// re-running the create branch's unconditional requirement.
-	if state.IsPrimary.ValueBool() {
+	//
+	// Removing read_replica_of from a live replica promotes it in place, so
+	// the instance is held to the primary rule: the declared credential is
+	// rotated in once promotion completes. is_primary is planned true so the
+	// post-apply read matches. The checks below still run: Update applies the
+	// promotion together with any upgrade, PATCH or state change in the plan.
+	promote := isReplicaPromotion(config, state)
+	if state.IsPrimary.ValueBool() || promote {
 		resp.Diagnostics.Append(requireDeclaredCredential(config)...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -950,6 +1350,9 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 	} else if !state.IsPrimary.IsNull() {
 		resp.Diagnostics.Append(warnCredentialOnReplica(config)...)
 	}
+	if promote {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_primary"), types.BoolValue(true))...)
+	}
 
 	// A replace that recreates the instance from a (different) source — changing
 	// read_replica_of on a live replica, or changing restore_to_point_in_time —
@@ -959,6 +1362,7 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 	// Terraform reports as an inconsistent result.
 	if originSourceChanged(config, state) {
 		resp.Diagnostics.Append(forbidEmptyConfigOnCreate(config)...)
+		resp.Diagnostics.Append(forbidPrimaryOnlyOnReplica(config)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -980,18 +1384,87 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
-	// A live read replica (is_primary false — the promotion case returned above —
-	// with read_replica_of still declared) cannot be modified directly: the server
+	// A live read replica (is_primary false, with read_replica_of still
+	// declared, so not being promoted) cannot be modified directly: the server
 	// 400s any size / ha_type / tags PATCH. Surface that at plan time instead of an
 	// apply-time error and a plan that never converges. (read_replica_of changes
 	// are handled earlier: a re-point re-derives via originSourceChanged, and a
-	// removal replaces via readReplicaOfRequiresReplace.)
+	// removal is a promotion.)
 	if !config.ReadReplicaOf.IsNull() {
 		resp.Diagnostics.Append(replicaUpdateForbidden(plan, state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
+
+	// A replacement (e.g. a renamed instance) creates at the planned version,
+	// so there is nothing to upgrade or refuse.
+	if len(resp.RequiresReplace) == 0 {
+		resp.Diagnostics.Append(checkMajorVersionChange(plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(forbidChangesWhileStopped(plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planned, ok := planStateForDesiredState(plan, state); ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), planned)...)
+	}
+
+	resp.Diagnostics.Append(warnConfigRestart(ctx, plan, state)...)
+}
+
+// checkMajorVersionChange validates a postgres_version change planned as an
+// update: an increase is an in-place major upgrade (warned about, since the
+// instance is unavailable while it runs) and a decrease is an error.
+func checkMajorVersionChange(plan, state models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	target, ok := majorUpgradeTarget(plan, state)
+	if !ok {
+		if plan.PostgresVersion.IsUnknown() || state.PostgresVersion.IsNull() || plan.PostgresVersion.Equal(state.PostgresVersion) {
+			return diags
+		}
+		diags.AddAttributeError(
+			path.Root("postgres_version"),
+			"Postgres major version downgrade is not supported",
+			"postgres_version can only be raised in place (from "+state.PostgresVersion.ValueString()+" to "+plan.PostgresVersion.ValueString()+" is a downgrade). To start over at an older version, recreate the instance with `terraform apply -replace`, which destroys its data.",
+		)
+		return diags
+	}
+	diags.AddAttributeWarning(
+		path.Root("postgres_version"),
+		"Postgres major version upgrade",
+		"The instance will be upgraded in place from Postgres "+state.PostgresVersion.ValueString()+" to "+target+". The server checks compatibility first and the apply fails without changes if it finds problems; during the upgrade itself the instance is unavailable. Read replicas follow the primary.",
+	)
+	return diags
+}
+
+// majorUpgradeTarget returns the version to upgrade to when the plan raises a
+// known postgres_version.
+func majorUpgradeTarget(plan, state models.PostgresServiceResourceModel) (string, bool) {
+	if plan.PostgresVersion.IsUnknown() || plan.PostgresVersion.IsNull() || state.PostgresVersion.IsNull() {
+		return "", false
+	}
+	from, err := strconv.Atoi(state.PostgresVersion.ValueString())
+	if err != nil {
+		return "", false
+	}
+	to, err := strconv.Atoi(plan.PostgresVersion.ValueString())
+	if err != nil || to <= from {
+		return "", false
+	}
+	return plan.PostgresVersion.ValueString(), true
+}
+
+// isUpgradedTo returns the WaitForPostgresMatch predicate for a major upgrade:
+// the instance reports the target version and is running again.
+func isUpgradedTo(version string) func(*api.Postgres) bool {
+	return func(pg *api.Postgres) bool {
+		return pg.PostgresVersion == version && pg.State == api.PostgresStateRunning
+	}
 }
 
 // planInheritedAttributes handles a read-replica / point-in-time-restore create:
@@ -1013,6 +1486,7 @@ func (r *PostgresServiceResource) ModifyPlan(ctx context.Context, req resource.M
 func (r *PostgresServiceResource) planInheritedAttributes(ctx context.Context, config models.PostgresServiceResourceModel, resp *resource.ModifyPlanResponse) {
 	var sourceID string
 	var isReplica bool
+	restoreTarget := types.StringNull()
 	switch {
 	case !config.ReadReplicaOf.IsNull() && !config.ReadReplicaOf.IsUnknown():
 		sourceID = config.ReadReplicaOf.ValueString()
@@ -1027,6 +1501,7 @@ func (r *PostgresServiceResource) planInheritedAttributes(ctx context.Context, c
 			return
 		}
 		sourceID = rm.SourceID.ValueString()
+		restoreTarget = rm.RestoreTarget
 	default:
 		return // standard create — attributes come from config
 	}
@@ -1049,6 +1524,23 @@ func (r *PostgresServiceResource) planInheritedAttributes(ctx context.Context, c
 		return
 	}
 
+	// A restore target outside the source's retention would otherwise fail
+	// only once the apply reaches the restore call.
+	if !restoreTarget.IsNull() && !restoreTarget.IsUnknown() {
+		window, err := r.client.ListPostgresBackups(ctx, sourceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot read the source Postgres instance's backups",
+				"Could not list backups of source instance "+sourceID+" to validate restore_target: "+err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(validateRestoreTarget(restoreTarget.ValueString(), window, time.Now())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Pin or reset EVERY inherited attribute so none can survive as a stale
 	// prior-state value (UseStateForUnknown) when this runs on a source-change
 	// replace: pin the ones reproduced verbatim from the source; mark the
@@ -1064,6 +1556,22 @@ func (r *PostgresServiceResource) planInheritedAttributes(ctx context.Context, c
 	}
 	// ha_type is server-assigned for a new replica/restore.
 	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ha_type"), types.StringUnknown())...)
+	// Network access the config omits is whatever the new instance comes up
+	// with, not the prior instance's value kept by UseStateForUnknown.
+	if config.IpAccess.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ip_access"), types.SetUnknown(models.PostgresIPAccessModel{}.ObjectType()))...)
+	}
+	if config.PrivateEndpointIDs.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_endpoint_ids"), types.SetUnknown(types.StringType))...)
+	}
+	// A replica has no backup settings; a restored instance comes up with
+	// the server default unless the config declares them.
+	backupAttrTypes := models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes
+	if isReplica {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("backup_configuration"), types.ObjectNull(backupAttrTypes))...)
+	} else if config.BackupConfiguration.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("backup_configuration"), types.ObjectUnknown(backupAttrTypes))...)
+	}
 }
 
 // stringOrUnknown returns a known value, or Unknown for an empty string, so an
@@ -1076,9 +1584,10 @@ func stringOrUnknown(s string) types.String {
 }
 
 // readReplicaOfRequiresReplace replaces the instance when read_replica_of is
-// changed or removed — EXCEPT once it has been promoted out-of-band
-// (is_primary=true), where the instance is already a standalone primary and the
-// change is reconciled in place. is_primary comes from prior state (a refresh
+// pointed at a different primary — EXCEPT once it has been promoted
+// out-of-band (is_primary=true), where the instance is already a standalone
+// primary and the change is reconciled in place. Removing read_replica_of
+// never replaces: Update promotes the replica in place. is_primary comes from prior state (a refresh
 // before the plan surfaces an out-of-band promotion); when it can't be read it
 // defaults to false, so the safe "replace a live replica" path wins.
 func readReplicaOfRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
@@ -1091,15 +1600,18 @@ func readReplicaOfRequiresReplace(ctx context.Context, req planmodifier.StringRe
 }
 
 // readReplicaOfShouldReplace is the pure decision behind readReplicaOfRequiresReplace:
-// changing or removing read_replica_of replaces a live replica, but once the
-// instance has been promoted out-of-band (is_primary=true) it's already a
-// standalone primary, so the change is reconciled in place. Unchanged → no
-// replace. (is_primary defaults to false when unreadable, so the safe
-// "replace a live replica" path wins.)
+// re-pointing read_replica_of replaces a live replica, but once the instance
+// has been promoted out-of-band (is_primary=true) it's already a standalone
+// primary, so the change is reconciled in place. Removal → promote in place.
+// Unchanged → no replace. (is_primary defaults to false when unreadable, so
+// the safe "replace a live replica" path wins.)
 func readReplicaOfShouldReplace(stateVal, planVal types.String, isPrimary bool) bool {
 	if stateVal.Equal(planVal) {
 		return false // unchanged
 	}
+	if planVal.IsNull() {
+		return false // removed: promoted in place
+	}
 	return !isPrimary
 }
 
@@ -1112,6 +1624,12 @@ func readReplicaOfShouldReplace(stateVal, planVal types.String, isPrimary bool)
 // transitioning, including server states the provider hasn't learned yet.
 func isPostgresStateRunning(s string) bool { return s == api.PostgresStateRunning }
 
+// isPromotedPrimary is the WaitForPostgresMatch predicate for a promotion: the
+// instance reports itself as a primary and is running again.
+func isPromotedPrimary(pg *api.Postgres) bool {
+	return pg.IsPrimary && pg.State == api.PostgresStateRunning
+}
+
 // planToPostgresCreate maps a fully-resolved plan into the POST /postgres body.
 func planToPostgresCreate(ctx context.Context, plan models.PostgresServiceResourceModel) (api.PostgresCreate, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -1145,6 +1663,16 @@ func planToPostgresCreate(ctx context.Context, plan models.PostgresServiceResour
 	body.PgConfig = pgConfig
 	body.PgBouncerConfig = pbConfig
 
+	ipAccess, d := planIPAccessToAPI(ctx, plan.IpAccess)
+	diags.Append(d...)
+	endpointIDs, d := planStringSetToSlice(ctx, plan.PrivateEndpointIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return api.PostgresCreate{}, diags
+	}
+	body.IpAccessList = ipAccess
+	body.PrivateEndpointIds = endpointIDs
+
 	return body, diags
 }
 
@@ -1206,7 +1734,8 @@ func planToRestoreRequest(ctx context.Context, plan models.PostgresServiceResour
 // postgresUpdatePlan bundles the two artifacts buildPostgresUpdate produces.
 //
 //   - Body == nil           → no diff; caller skips the PATCH entirely.
-//   - Body != nil           → sparse PATCH body (size, ha_type, tags).
+//   - Body != nil           → sparse PATCH body (size, ha_type, tags,
+//     ip_access, private_endpoint_ids).
 //   - TransitionExpected    → server processes the mutation as a state
 //     transition (size, ha_type); caller follows up with WaitForPostgresMatch
 //     using buildPostgresMatchPredicate(Body).
@@ -1258,6 +1787,22 @@ func buildPostgresUpdate(ctx context.Context, plan, state models.PostgresService
 		}
 	}
 
+	// Network access changes are applied hot; they don't affect the tag
+	// re-assertion above, which only defends against a size/ha_type PATCH.
+	ipAccess, endpointIDs, d := diffNetworkAccess(ctx, plan, state)
+	diags.Append(d...)
+	if diags.HasError() {
+		return postgresUpdatePlan{}, diags
+	}
+	if ipAccess != nil {
+		update.IpAccessList = ipAccess
+		changed = true
+	}
+	if endpointIDs != nil {
+		update.PrivateEndpointIds = endpointIDs
+		changed = true
+	}
+
 	if !changed {
 		return postgresUpdatePlan{}, diags
 	}
@@ -1351,7 +1896,7 @@ func planTagsToAPI(ctx context.Context, tagsMap types.Map) (*[]api.Tag, diag.Dia
 // (apiTagsToMapValue) can return diagnostics without leaving *state half-mutated.
 // Does not touch pg_config / pgbouncer_config; those are synced separately by
 // syncPostgresConfig.
-func syncPostgresState(_ context.Context, pg *api.Postgres, state *models.PostgresServiceResourceModel) diag.Diagnostics {
+func syncPostgresState(ctx context.Context, pg *api.Postgres, state *models.PostgresServiceResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 	out := *state
 
@@ -1362,6 +1907,7 @@ func syncPostgresState(_ context.Context, pg *api.Postgres, state *models.Postgr
 
 	out.Size = types.StringValue(pg.Size)
 	out.State = types.StringValue(pg.State)
+	out.DesiredState = desiredStateFromAPI(pg.State, state.DesiredState)
 	out.CreatedAt = types.StringValue(pg.CreatedAt)
 	// postgresVersion / haType / hostname / username are
 	// schema-optional on PostgresInstanceV1 — preserve prior values when the
@@ -1395,6 +1941,16 @@ func syncPostgresState(_ context.Context, pg *api.Postgres, state *models.Postgr
 	}
 	out.Tags = tagsValue
 
+	ipAccess, d := apiIPAccessToSetValue(pg.IpAccessList)
+	diags.Append(d...)
+	endpointIDs, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(pg.PrivateEndpointIds))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	out.IpAccess = ipAccess
+	out.PrivateEndpointIDs = endpointIDs
+
 	*state = out
 	return diags
 }
@@ -1416,6 +1972,242 @@ func apiTagsToMapValue(apiTags []api.Tag) (types.Map, diag.Diagnostics) {
 	return m, diags
 }
 
+// ---------------------------------------------------------------------------
+// Network access helpers (ip_access / private_endpoint_ids)
+// ---------------------------------------------------------------------------
+
+// planIPAccessToAPI extracts the ip_access entries from the plan. Returns nil
+// for null/unknown so a create omits the field and the server default applies.
+func planIPAccessToAPI(ctx context.Context, set types.Set) ([]api.IpAccess, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+	var entries []models.PostgresIPAccessModel
+	diags.Append(set.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	out := make([]api.IpAccess, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, api.IpAccess{Source: e.Source.ValueString(), Description: e.Description.ValueString()})
+	}
+	return out, diags
+}
+
+// planStringSetToSlice extracts a set of strings. Returns nil for null/unknown.
+func planStringSetToSlice(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+	out := make([]string, 0, len(set.Elements()))
+	diags.Append(set.ElementsAs(ctx, &out, false)...)
+	return out, diags
+}
+
+// apiIPAccessToSetValue maps the server's ipAccessList into the ip_access set.
+// Empty input maps to an empty set (not null), mirroring apiTagsToMapValue, so
+// `ip_access = []` round-trips; an empty description is read back as null.
+func apiIPAccessToSetValue(entries []api.IpAccess) (types.Set, diag.Diagnostics) {
+	values := make([]attr.Value, 0, len(entries))
+	for _, e := range entries {
+		description := types.StringNull()
+		if e.Description != "" {
+			description = types.StringValue(e.Description)
+		}
+		values = append(values, models.PostgresIPAccessModel{
+			Source:      types.StringValue(e.Source),
+			Description: description,
+		}.ObjectValue())
+	}
+	return types.SetValue(models.PostgresIPAccessModel{}.ObjectType(), values)
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// diffNetworkAccess compares plan vs state ip_access / private_endpoint_ids
+// and returns the add/remove diffs for the PATCH body. Each is nil when the
+// plan value is unknown (omitted on a create-side plan) or unchanged. Entries
+// are compared whole, so editing an ip_access description removes the old
+// entry and adds the new one.
+func diffNetworkAccess(ctx context.Context, plan, state models.PostgresServiceResourceModel) (*api.IpAccessUpdate, *api.PrivateEndpointIdsUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ipUpdate *api.IpAccessUpdate
+	var endpointUpdate *api.PrivateEndpointIdsUpdate
+
+	if !plan.IpAccess.IsUnknown() && !plan.IpAccess.Equal(state.IpAccess) {
+		desired, d := planIPAccessToAPI(ctx, plan.IpAccess)
+		diags.Append(d...)
+		current, d := planIPAccessToAPI(ctx, state.IpAccess)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		add, remove := diffSlices(current, desired)
+		if len(add) > 0 || len(remove) > 0 {
+			ipUpdate = &api.IpAccessUpdate{Add: add, Remove: remove}
+		}
+	}
+
+	if !plan.PrivateEndpointIDs.IsUnknown() && !plan.PrivateEndpointIDs.Equal(state.PrivateEndpointIDs) {
+		desired, d := planStringSetToSlice(ctx, plan.PrivateEndpointIDs)
+		diags.Append(d...)
+		current, d := planStringSetToSlice(ctx, state.PrivateEndpointIDs)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		add, remove := diffSlices(current, desired)
+		if len(add) > 0 || len(remove) > 0 {
+			endpointUpdate = &api.PrivateEndpointIdsUpdate{Add: add, Remove: remove}
+		}
+	}
+
+	return ipUpdate, endpointUpdate, diags
+}
+
+// diffSlices returns the elements of desired missing from current (add) and
+// the elements of current missing from desired (remove), in input order.
+func diffSlices[T comparable](current, desired []T) (add, remove []T) {
+	inCurrent := make(map[T]bool, len(current))
+	for _, v := range current {
+		inCurrent[v] = true
+	}
+	inDesired := make(map[T]bool, len(desired))
+	for _, v := range desired {
+		inDesired[v] = true
+		if !inCurrent[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range current {
+		if !inDesired[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
+
+// applyNetworkAccessAfterRestore PATCHes the declared ip_access /
+// private_endpoint_ids onto a freshly restored instance, diffed against what
+// the restore came up with. A no-op when neither is declared.
+func (r *PostgresServiceResource) applyNetworkAccessAfterRestore(ctx context.Context, id string, plan models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if (plan.IpAccess.IsNull() || plan.IpAccess.IsUnknown()) &&
+		(plan.PrivateEndpointIDs.IsNull() || plan.PrivateEndpointIDs.IsUnknown()) {
+		return diags
+	}
+
+	pg, err := r.client.GetPostgres(ctx, id)
+	if err != nil {
+		diags.AddError("Error reading restored Postgres service", "Could not read Postgres service "+id+" after restore: "+err.Error())
+		return diags
+	}
+	var current models.PostgresServiceResourceModel
+	diags.Append(syncPostgresState(ctx, pg, &current)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ipAccess, endpointIDs, d := diffNetworkAccess(ctx, plan, current)
+	diags.Append(d...)
+	if diags.HasError() || (ipAccess == nil && endpointIDs == nil) {
+		return diags
+	}
+	body := api.PostgresUpdate{IpAccessList: ipAccess, PrivateEndpointIds: endpointIDs}
+	if _, err := r.client.UpdatePostgres(ctx, id, body); err != nil {
+		diags.AddError(
+			"Error updating Postgres network access",
+			"Restored Postgres service "+id+" but could not apply ip_access / private_endpoint_ids: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// ---------------------------------------------------------------------------
+// Backup configuration helpers
+// ---------------------------------------------------------------------------
+
+// backupConfigurationToAPI builds the PATCH /backupConfiguration body from the
+// planned backup_configuration, carrying only the attributes that are known
+// and differ from prior (pass a null prior on create). Returns nil when there
+// is nothing to send.
+func backupConfigurationToAPI(plan, prior types.Object) *api.PostgresBackupConfiguration {
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil
+	}
+	planAttrs := plan.Attributes()
+	var priorAttrs map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorAttrs = prior.Attributes()
+	}
+	changed := func(name string) bool {
+		v := planAttrs[name]
+		if v == nil || v.IsNull() || v.IsUnknown() {
+			return false
+		}
+		return priorAttrs == nil || !v.Equal(priorAttrs[name])
+	}
+
+	var body api.PostgresBackupConfiguration
+	send := false
+	if changed("retention_days") {
+		days := planAttrs["retention_days"].(types.Int64).ValueInt64()
+		body.RetentionDays = &days
+		send = true
+	}
+	if changed("backup_window_start") {
+		start := planAttrs["backup_window_start"].(types.String).ValueString()
+		body.BackupWindowStart = &start
+		send = true
+	}
+	if !send {
+		return nil
+	}
+	return &body
+}
+
+// apiBackupConfigurationToObject maps the server's backup settings into the
+// backup_configuration object; a nil response (replica) maps to null.
+func apiBackupConfigurationToObject(cfg *api.PostgresBackupConfiguration) types.Object {
+	if cfg == nil {
+		return types.ObjectNull(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes)
+	}
+	m := models.PostgresBackupConfigurationModel{
+		RetentionDays:     types.Int64Null(),
+		BackupWindowStart: types.StringNull(),
+	}
+	if cfg.RetentionDays != nil {
+		m.RetentionDays = types.Int64Value(*cfg.RetentionDays)
+	}
+	if cfg.BackupWindowStart != nil {
+		m.BackupWindowStart = types.StringValue(*cfg.BackupWindowStart)
+	}
+	return m.ObjectValue()
+}
+
+// readBackupConfiguration refreshes model.BackupConfiguration. Must run after
+// syncPostgresState: a replica (is_primary false) has no backup settings, so
+// it is set null without a request.
+func (r *PostgresServiceResource) readBackupConfiguration(ctx context.Context, model *models.PostgresServiceResourceModel) error {
+	if !model.IsPrimary.ValueBool() {
+		model.BackupConfiguration = apiBackupConfigurationToObject(nil)
+		return nil
+	}
+	cfg, err := r.client.GetPostgresBackupConfiguration(ctx, model.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	model.BackupConfiguration = apiBackupConfigurationToObject(cfg)
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // Config helpers (pg_config / pgbouncer_config)
 // ---------------------------------------------------------------------------
@@ -1455,15 +2247,22 @@ func apiConfigToMapValue(config api.PgConfigMap) (types.Map, diag.Diagnostics) {
 }
 
 // syncPostgresConfig writes a GET/POST /config response into the model's
-// pg_config / pgbouncer_config attributes.
-func syncPostgresConfig(_ context.Context, config *api.PostgresConfig, model *models.PostgresServiceResourceModel) diag.Diagnostics {
+// pg_config / pgbouncer_config attributes. A value the server reports in a
+// different but equivalent spelling (`262144kB` for a declared `256MB`) keeps
+// the model's spelling, so it does not show up as a diff on every plan.
+func syncPostgresConfig(ctx context.Context, config *api.PostgresConfig, model *models.PostgresServiceResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	pgMap, d := apiConfigToMapValue(config.PgConfig)
+	version := model.PostgresVersion.ValueString()
+	prior, d := planConfigToMap(ctx, model.PgConfig)
+	diags.Append(d...)
+	pgMap, d := apiConfigToMapValue(keepEquivalentSpelling(pgconfig.SectionPg, version, config.PgConfig, prior))
 	diags.Append(d...)
 	if diags.HasError() {
 		return diags
 	}
-	pbMap, d := apiConfigToMapValue(config.PgBouncerConfig)
+	prior, d = planConfigToMap(ctx, model.PgBouncerConfig)
+	diags.Append(d...)
+	pbMap, d := apiConfigToMapValue(keepEquivalentSpelling(pgconfig.SectionPgBouncer, version, config.PgBouncerConfig, prior))
 	diags.Append(d...)
 	if diags.HasError() {
 		return diags
@@ -1473,6 +2272,118 @@ func syncPostgresConfig(_ context.Context, config *api.PostgresConfig, model *mo
 	return diags
 }
 
+// keepEquivalentSpelling returns server with each value replaced by prior's
+// when the catalog says the two are equivalent.
+func keepEquivalentSpelling(section, version string, server, prior api.PgConfigMap) api.PgConfigMap {
+	if len(server) == 0 || len(prior) == 0 {
+		return server
+	}
+	out := make(api.PgConfigMap, len(server))
+	for k, v := range server {
+		out[k] = v
+		declared, ok := prior[k]
+		if !ok || declared == v {
+			continue
+		}
+		if p, known := pgconfig.Lookup(section, version, k); known && p.Equivalent(declared, v) {
+			out[k] = declared
+		}
+	}
+	return out
+}
+
+// validateConfigCatalog rejects pg_config / pgbouncer_config keys the catalog
+// does not know and values outside their type or range. When postgres_version
+// is not set (a read replica or restore inherits it), a key is accepted if any
+// supported version has it.
+func validateConfigCatalog(ctx context.Context, config models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	version := ""
+	if !config.PostgresVersion.IsUnknown() {
+		version = config.PostgresVersion.ValueString()
+	}
+	check := func(section string, m types.Map) {
+		if m.IsNull() || m.IsUnknown() {
+			return
+		}
+		for k, v := range m.Elements() {
+			s, ok := v.(types.String)
+			if !ok || s.IsNull() || s.IsUnknown() {
+				continue
+			}
+			at := path.Root(section).AtMapKey(k)
+			p, known := pgconfig.Lookup(section, version, k)
+			if !known {
+				detail := "The parameter is not in the provider's catalog of supported parameters"
+				if version != "" {
+					detail += " for Postgres " + version
+				}
+				diags.AddAttributeError(at, "Unsupported "+section+" parameter", detail+". Check the spelling; if the server has added it, upgrade the provider.")
+				continue
+			}
+			if err := p.Validate(s.ValueString()); err != nil {
+				diags.AddAttributeError(at, "Invalid "+section+" value", k+": "+err.Error())
+			}
+		}
+	}
+	check(pgconfig.SectionPg, config.PgConfig)
+	check(pgconfig.SectionPgBouncer, config.PgBouncerConfig)
+	return diags
+}
+
+// warnConfigRestart warns, at plan time, about pg_config changes that only
+// take effect after a restart: a restart-requiring parameter added, removed or
+// set to a non-equivalent value. The apply-time message from POST /config is
+// still surfaced; this lets the user see it before approving.
+func warnConfigRestart(ctx context.Context, plan, state models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.PgConfig.IsUnknown() || plan.PgConfig.Equal(state.PgConfig) {
+		return diags
+	}
+	planned, d := planConfigToMap(ctx, plan.PgConfig)
+	diags.Append(d...)
+	current, d := planConfigToMap(ctx, state.PgConfig)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	version := state.PostgresVersion.ValueString()
+	var restart []string
+	for _, k := range unionKeys(planned, current) {
+		p, known := pgconfig.Lookup(pgconfig.SectionPg, version, k)
+		if !known || !p.Restart {
+			continue
+		}
+		v, inPlan := planned[k]
+		w, inState := current[k]
+		if inPlan != inState || !p.Equivalent(v, w) {
+			restart = append(restart, k)
+		}
+	}
+	if len(restart) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("pg_config"),
+			"Postgres configuration change requires a restart",
+			"Changing "+strings.Join(restart, ", ")+" only takes effect after the instance restarts. Restart out-of-band via the ClickHouse Cloud UI or API; this resource does not expose restart.",
+		)
+	}
+	return diags
+}
+
+func unionKeys(a, b api.PgConfigMap) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // postgresConfigUpdate bundles what buildConfigUpdate produces.
 type postgresConfigUpdate struct {
 	Changed bool
@@ -1558,3 +2469,21 @@ func decidePasswordRotationOnUpdate(plan, state, config models.PostgresServiceRe
 	}
 	return "", false
 }
+
+// nullUnknownState nulls the computed values Create has not resolved yet in
+// a state saved part way through, which Terraform does not accept as unknown.
+func nullUnknownState(state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Error saving Postgres service state", "Could not null unknown values: "+err.Error())
+		return diags
+	}
+	state.Raw = raw
+	return diags
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -107,6 +108,94 @@ func TestBuildConfigUpdate(t *testing.T) {
 	})
 }
 
+func TestSyncPostgresConfig_keepsEquivalentSpelling(t *testing.T) {
+	model := models.PostgresServiceResourceModel{
+		PostgresVersion: types.StringValue("18"),
+		PgConfig:        mapTags("shared_buffers", "256MB", "jit", "on", "work_mem", "4MB"),
+		PgBouncerConfig: mapTags("pool_mode", "Transaction"),
+	}
+	server := &api.PostgresConfig{
+		PgConfig:        api.PgConfigMap{"shared_buffers": "32768", "jit": "true", "work_mem": "8MB", "max_connections": "100"},
+		PgBouncerConfig: api.PgConfigMap{"pool_mode": "transaction"},
+	}
+	if d := syncPostgresConfig(context.Background(), server, &model); d.HasError() {
+		t.Fatalf("diags: %v", d)
+	}
+	want := mapTags("shared_buffers", "256MB", "jit", "on", "work_mem", "8MB", "max_connections", "100")
+	if !model.PgConfig.Equal(want) {
+		t.Errorf("pg_config = %v; want %v", model.PgConfig, want)
+	}
+	if !model.PgBouncerConfig.Equal(mapTags("pool_mode", "Transaction")) {
+		t.Errorf("pgbouncer_config = %v", model.PgBouncerConfig)
+	}
+}
+
+func TestValidateConfigCatalog(t *testing.T) {
+	ctx := context.Background()
+	cfg := func(version types.String, pg, pgb types.Map) models.PostgresServiceResourceModel {
+		return models.PostgresServiceResourceModel{PostgresVersion: version, PgConfig: pg, PgBouncerConfig: pgb}
+	}
+	null := types.MapNull(types.StringType)
+
+	cases := []struct {
+		name    string
+		config  models.PostgresServiceResourceModel
+		wantErr []path.Path
+	}{
+		{"valid", cfg(types.StringValue("18"), mapTags("work_mem", "64MB", "TimeZone", "UTC"), mapTags("pool_mode", "transaction")), nil},
+		{"unknown key", cfg(types.StringValue("18"), mapTags("work_meme", "64MB"), null), []path.Path{path.Root("pg_config").AtMapKey("work_meme")}},
+		{"out of range", cfg(types.StringValue("18"), mapTags("max_connections", "0"), null), []path.Path{path.Root("pg_config").AtMapKey("max_connections")}},
+		{"bad pgbouncer enum", cfg(types.StringValue("18"), null, mapTags("pool_mode", "always")), []path.Path{path.Root("pgbouncer_config").AtMapKey("pool_mode")}},
+		{"version-specific key", cfg(types.StringValue("17"), mapTags("io_method", "worker"), null), []path.Path{path.Root("pg_config").AtMapKey("io_method")}},
+		{"version-specific key, version inherited", cfg(types.StringNull(), mapTags("io_method", "worker"), null), nil},
+		{"unknown values deferred", cfg(types.StringUnknown(), types.MapUnknown(types.StringType), null), nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := validateConfigCatalog(ctx, c.config)
+			if d.ErrorsCount() != len(c.wantErr) {
+				t.Fatalf("got %d errors, want %d: %v", d.ErrorsCount(), len(c.wantErr), d)
+			}
+			for i, e := range d.Errors() {
+				if p := e.(diag.DiagnosticWithPath).Path(); !p.Equal(c.wantErr[i]) {
+					t.Errorf("error path = %s; want %s", p, c.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWarnConfigRestart(t *testing.T) {
+	ctx := context.Background()
+	with := func(pg types.Map) models.PostgresServiceResourceModel {
+		return models.PostgresServiceResourceModel{PostgresVersion: types.StringValue("18"), PgConfig: pg}
+	}
+	cases := []struct {
+		name        string
+		plan, state types.Map
+		want        bool
+	}{
+		{"no change", mapTags("max_connections", "200"), mapTags("max_connections", "200"), false},
+		{"reload-only change", mapTags("work_mem", "8MB"), mapTags("work_mem", "4MB"), false},
+		{"restart change", mapTags("max_connections", "300"), mapTags("max_connections", "200"), true},
+		{"restart key added", mapTags("shared_buffers", "1GB"), mapTags(), true},
+		{"restart key removed", mapTags(), mapTags("shared_buffers", "1GB"), true},
+		{"equivalent spelling", mapTags("shared_buffers", "1GB"), mapTags("shared_buffers", "1048576kB"), false},
+		{"plan unknown", types.MapUnknown(types.StringType), mapTags("max_connections", "200"), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := warnConfigRestart(ctx, with(c.plan), with(c.state))
+			if d.HasError() {
+				t.Fatalf("diags: %v", d)
+			}
+			if got := d.WarningsCount() > 0; got != c.want {
+				t.Errorf("warned = %v; want %v (%v)", got, c.want, d)
+			}
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // password — validators + create/update rotation decisions
 // ---------------------------------------------------------------------------
@@ -520,7 +609,7 @@ func TestReadReplicaOfShouldReplace(t *testing.T) {
 		{"unchanged live replica", primary, primary, false, false},
 		{"unchanged promoted", primary, primary, true, false},
 		{"repoint live replica → replace", primary, other, false, true},
-		{"remove from live replica → replace", primary, none, false, true},
+		{"remove from live replica → promote in place", primary, none, false, false},
 		{"repoint promoted → in place", primary, other, true, false},
 		{"remove from promoted → in place", primary, none, true, false},
 	}
@@ -533,7 +622,8 @@ func TestReadReplicaOfShouldReplace(t *testing.T) {
 	}
 }
 
-// A live read replica rejects direct size / ha_type / tags edits (the server
+// A live read replica rejects direct size / ha_type / tags / network access
+// edits (the server
 // 400s the PATCH); pg_config changes are allowed (separate endpoint).
 func TestReplicaUpdateForbidden(t *testing.T) {
 	large, xlarge := types.StringValue("r6gd.large"), types.StringValue("r6gd.xlarge")
@@ -541,7 +631,20 @@ func TestReplicaUpdateForbidden(t *testing.T) {
 	tagsA, tagsB := mapTags("env", "prod"), mapTags("env", "dev")
 	cfgA, cfgB := mapTags("max_connections", "200"), mapTags("max_connections", "250")
 	m := func(size, ha types.String, tags, pg types.Map) models.PostgresServiceResourceModel {
-		return models.PostgresServiceResourceModel{Size: size, HaType: ha, Tags: tags, PgConfig: pg}
+		return models.PostgresServiceResourceModel{
+			Size: size, HaType: ha, Tags: tags, PgConfig: pg,
+			IpAccess:            types.SetValueMust(models.PostgresIPAccessModel{}.ObjectType(), nil),
+			PrivateEndpointIDs:  types.SetValueMust(types.StringType, nil),
+			BackupConfiguration: apiBackupConfigurationToObject(nil),
+		}
+	}
+	withEndpoints := func(mm models.PostgresServiceResourceModel, ids ...string) models.PostgresServiceResourceModel {
+		mm.PrivateEndpointIDs = stringSet(ids...)
+		return mm
+	}
+	withBackups := func(mm models.PostgresServiceResourceModel, days int64, start string) models.PostgresServiceResourceModel {
+		mm.BackupConfiguration = backupObj(days, start)
+		return mm
 	}
 	cases := []struct {
 		name        string
@@ -555,6 +658,8 @@ func TestReplicaUpdateForbidden(t *testing.T) {
 		{"size+ha_type changed", m(xlarge, async, tagsA, cfgA), m(large, none, tagsA, cfgA), 2},
 		{"all three changed", m(xlarge, async, tagsB, cfgA), m(large, none, tagsA, cfgA), 3},
 		{"pg_config only changed → allowed", m(large, none, tagsA, cfgB), m(large, none, tagsA, cfgA), 0},
+		{"private_endpoint_ids changed", withEndpoints(m(large, none, tagsA, cfgA), "vpce-1"), m(large, none, tagsA, cfgA), 1},
+		{"backup_configuration declared", withBackups(m(large, none, tagsA, cfgA), 7, "02:00"), m(large, none, tagsA, cfgA), 1},
 		// Unknown (interpolated) plan values can't be proven changed → defer to
 		// apply, don't false-positive at plan.
 		{"unknown size → deferred", m(types.StringUnknown(), none, tagsA, cfgA), m(large, none, tagsA, cfgA), 0},
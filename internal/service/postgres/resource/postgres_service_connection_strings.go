@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+// readConnectionStrings refreshes model.ConnectionStrings. Must run after
+// syncPostgresState, which sets the hostname, username and is_primary the
+// strings are built from. Only password is embedded; password_wo never is,
+// since that would write it to state. When the CA fetch fails the prior value
+// is kept (null if there is none), so callers can report it as a warning:
+// only connection_strings is stale.
+func (r *PostgresServiceResource) readConnectionStrings(ctx context.Context, model *models.PostgresServiceResourceModel) error {
+	if model.Hostname.IsNull() || model.Hostname.ValueString() == "" {
+		model.ConnectionStrings = connstr.Null()
+		return nil
+	}
+	ca, err := r.client.GetPostgresCaCertificates(ctx, model.ID.ValueString())
+	if err != nil {
+		if model.ConnectionStrings.IsUnknown() {
+			model.ConnectionStrings = connstr.Null()
+		}
+		return err
+	}
+	strs := connstr.Build(connstr.Params{
+		Host:        model.Hostname.ValueString(),
+		Username:    model.Username.ValueString(),
+		Password:    model.Password.ValueString(),
+		SSLRootCert: model.SSLRootCertPath.ValueString(),
+	}, !model.IsPrimary.ValueBool())
+	model.ConnectionStrings = connstr.ObjectValue(strs, string(ca))
+	return nil
+}
+
+// connectionStringsInputs are the attributes connection_strings is derived
+// from besides the server-assigned hostname and username; read_replica_of
+// covers a promotion, which drops the read-only variant.
+var connectionStringsInputs = []string{"password", "ssl_root_cert_path", "read_replica_of"}
+
+// connectionStringsUseStateForUnknown keeps connection_strings from showing
+// as "known after apply" on every update, unless one of its inputs changes.
+type connectionStringsUseStateForUnknown struct{}
+
+func (m connectionStringsUseStateForUnknown) Description(_ context.Context) string {
+	return "Uses the prior connection_strings unless password, ssl_root_cert_path or read_replica_of changes."
+}
+
+func (m connectionStringsUseStateForUnknown) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m connectionStringsUseStateForUnknown) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.Plan.Raw.IsNull() {
+		return
+	}
+	for _, name := range connectionStringsInputs {
+		var planned, prior types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &prior)...)
+		if resp.Diagnostics.HasError() || !planned.Equal(prior) {
+			return
+		}
+	}
+	resp.PlanValue = req.StateValue
+}
@@ -18,16 +18,34 @@ var postgresHaTypes = []string{
 	"sync",
 }
 
+// postgresDesiredStates are the values of desired_state; the server's
+// transitional states (stopping, starting) are never declared.
+var postgresDesiredStates = []string{
+	postgresDesiredStateRunning,
+	postgresDesiredStateStopped,
+}
+
+const (
+	postgresDesiredStateRunning = "running"
+	postgresDesiredStateStopped = "stopped"
+)
+
 const (
 	postgresInstanceNameMin = 1
 	postgresInstanceNameMax = 50
 )
 
+const (
+	postgresBackupRetentionDaysMin = 1
+	postgresBackupRetentionDaysMax = 35
+)
+
 // postgresDefaultPort: server doesn't expose a per-instance port today.
 const postgresDefaultPort int64 = 5432
 
 // Lifecycle timeout budgets (seconds).
 const (
-	postgresDefaultCreateTimeoutSeconds = 30 * 60 // 30m
-	postgresDefaultUpdateTimeoutSeconds = 30 * 60 // 30m
+	postgresDefaultCreateTimeoutSeconds  = 30 * 60     // 30m
+	postgresDefaultUpdateTimeoutSeconds  = 30 * 60     // 30m
+	postgresDefaultUpgradeTimeoutSeconds = 2 * 60 * 60 // 2h: pg_upgrade scales with data size
 )
@@ -0,0 +1,131 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+// isPostgresStateStopped is the state-checker for a stop command.
+func isPostgresStateStopped(s string) bool { return s == api.PostgresStateStopped }
+
+// isStoppedOrStopping reports whether the server has the instance down or
+// on its way down.
+func isStoppedOrStopping(s string) bool {
+	return s == api.PostgresStateStopped || s == api.PostgresStateStopping
+}
+
+// desiredStateFromAPI maps the server state onto desired_state. A null prior
+// means the attribute is unmanaged and stays null; a transitional state other
+// than stopping (creating, starting, restarting, ...) keeps the prior value so
+// a refresh mid-transition doesn't plan a spurious start or stop.
+func desiredStateFromAPI(serverState string, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+	switch {
+	case isStoppedOrStopping(serverState):
+		return types.StringValue(postgresDesiredStateStopped)
+	case serverState == api.PostgresStateRunning:
+		return types.StringValue(postgresDesiredStateRunning)
+	}
+	return prior
+}
+
+// desiredStateCommand returns the command that moves the instance from its
+// last-read server state to the planned desired_state, if any. It compares
+// against the server state rather than the prior desired_state so an
+// instance stopped by a schedule while desired_state was unmanaged is still
+// started when desired_state = "running" is declared.
+func desiredStateCommand(plan models.PostgresServiceResourceModel, serverState string) (string, bool) {
+	if plan.DesiredState.IsNull() || plan.DesiredState.IsUnknown() {
+		return "", false
+	}
+	stopped := isStoppedOrStopping(serverState)
+	switch plan.DesiredState.ValueString() {
+	case postgresDesiredStateStopped:
+		if !stopped {
+			return api.PostgresStateCommandStop, true
+		}
+	case postgresDesiredStateRunning:
+		if stopped {
+			return api.PostgresStateCommandStart, true
+		}
+	}
+	return "", false
+}
+
+// changeDesiredState sends a start or stop command and waits for the instance
+// to settle in the matching state.
+func (r *PostgresServiceResource) changeDesiredState(ctx context.Context, id, command string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if _, err := r.client.ChangePostgresState(ctx, id, api.PostgresStateUpdate{Command: command}); err != nil {
+		diags.AddError(
+			"Error changing Postgres service state",
+			"Could not "+command+" Postgres service "+id+": "+err.Error(),
+		)
+		return diags
+	}
+	checker := isPostgresStateRunning
+	if command == api.PostgresStateCommandStop {
+		checker = isPostgresStateStopped
+	}
+	if err := r.client.WaitForPostgresState(ctx, id, checker, postgresDefaultUpdateTimeoutSeconds); err != nil {
+		diags.AddError(
+			"Error waiting for Postgres service state change",
+			"Could not confirm Postgres service "+id+" finished the "+command+" command: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// forbidChangesWhileStopped rejects changes an instance that stays stopped
+// cannot take: they need a running server, and starting it behind the
+// user's back would defeat the stop. It goes by the instance's last-read
+// state, so it covers an instance stopped by desired_state = "stopped" and one
+// stopped by a clickhouse_postgres_scheduled_scaling window alike. Set
+// desired_state to "running" in the same apply to make them.
+func forbidChangesWhileStopped(plan, state models.PostgresServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !isStoppedOrStopping(state.State.ValueString()) || plan.DesiredState.ValueString() == postgresDesiredStateRunning {
+		return diags
+	}
+	forbid := func(name string, planVal, stateVal attr.Value) {
+		if planVal.IsUnknown() || planVal.Equal(stateVal) {
+			return
+		}
+		diags.AddAttributeError(
+			path.Root(name),
+			"Postgres service is stopped",
+			"`"+name+"` cannot be changed while the instance stays stopped (by desired_state or a scaling schedule window). Set desired_state = \"running\" to apply the change, then stop the instance again in a later apply, or wait for the window to end.",
+		)
+	}
+	forbid("size", plan.Size, state.Size)
+	forbid("ha_type", plan.HaType, state.HaType)
+	forbid("postgres_version", plan.PostgresVersion, state.PostgresVersion)
+	forbid("pg_config", plan.PgConfig, state.PgConfig)
+	forbid("pgbouncer_config", plan.PgBouncerConfig, state.PgBouncerConfig)
+	forbid("password", plan.Password, state.Password)
+	forbid("password_wo_version", plan.PasswordWOVersion, state.PasswordWOVersion)
+	return diags
+}
+
+// planStateForDesiredState plans the computed state attribute as the target
+// of a start or stop, which UseStateForUnknown would otherwise pin to the
+// prior value and fail the post-apply consistency check.
+func planStateForDesiredState(plan, state models.PostgresServiceResourceModel) (types.String, bool) {
+	command, ok := desiredStateCommand(plan, state.State.ValueString())
+	if !ok {
+		return types.String{}, false
+	}
+	if command == api.PostgresStateCommandStop {
+		return types.StringValue(api.PostgresStateStopped), true
+	}
+	return types.StringValue(api.PostgresStateRunning), true
+}
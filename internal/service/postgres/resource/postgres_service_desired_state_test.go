@@ -0,0 +1,109 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+)
+
+func TestDesiredStateFromAPI(t *testing.T) {
+	running := types.StringValue(postgresDesiredStateRunning)
+	stopped := types.StringValue(postgresDesiredStateStopped)
+	cases := []struct {
+		name        string
+		serverState string
+		prior       types.String
+		want        types.String
+	}{
+		{"unmanaged stays null", api.PostgresStateStopped, types.StringNull(), types.StringNull()},
+		{"stopped", api.PostgresStateStopped, running, stopped},
+		{"stopping counts as stopped", api.PostgresStateStopping, running, stopped},
+		{"running", api.PostgresStateRunning, stopped, running},
+		{"starting keeps prior", api.PostgresStateStarting, stopped, stopped},
+		{"restarting keeps prior", "restarting", running, running},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := desiredStateFromAPI(c.serverState, c.prior); !got.Equal(c.want) {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDesiredStateCommand(t *testing.T) {
+	cases := []struct {
+		name        string
+		desired     types.String
+		serverState string
+		want        string
+	}{
+		{"unmanaged", types.StringNull(), api.PostgresStateStopped, ""},
+		{"stop a running instance", types.StringValue(postgresDesiredStateStopped), api.PostgresStateRunning, api.PostgresStateCommandStop},
+		{"already stopping", types.StringValue(postgresDesiredStateStopped), api.PostgresStateStopping, ""},
+		{"start a stopped instance", types.StringValue(postgresDesiredStateRunning), api.PostgresStateStopped, api.PostgresStateCommandStart},
+		{"already running", types.StringValue(postgresDesiredStateRunning), api.PostgresStateRunning, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			plan := gateModel(true)
+			plan.DesiredState = c.desired
+			got, ok := desiredStateCommand(plan, c.serverState)
+			if got != c.want || ok != (c.want != "") {
+				t.Errorf("got (%q, %v), want %q", got, ok, c.want)
+			}
+		})
+	}
+}
+
+func TestForbidChangesWhileStopped(t *testing.T) {
+	state := gateModel(true)
+	state.DesiredState = types.StringValue(postgresDesiredStateStopped)
+	state.State = types.StringValue(api.PostgresStateStopped)
+
+	resize := state
+	resize.Size = types.StringValue("m6gd.xlarge")
+	if d := forbidChangesWhileStopped(resize, state); !d.HasError() {
+		t.Error("resize of an instance that stays stopped: want an error")
+	}
+
+	// Starting in the same apply lifts the restriction.
+	start := resize
+	start.DesiredState = types.StringValue(postgresDesiredStateRunning)
+	if d := forbidChangesWhileStopped(start, state); d.HasError() {
+		t.Errorf("resize with a start: %v", d)
+	}
+
+	// An instance stopped by a schedule window, with desired_state unmanaged.
+	scheduled := state
+	scheduled.DesiredState = types.StringNull()
+	scheduledResize := scheduled
+	scheduledResize.Size = types.StringValue("m6gd.xlarge")
+	if d := forbidChangesWhileStopped(scheduledResize, scheduled); !d.HasError() {
+		t.Error("resize of an instance stopped by a schedule: want an error")
+	}
+
+	// Tags travel on a PATCH the server accepts while stopped.
+	retag := state
+	retag.Tags = types.MapNull(types.StringType)
+	if d := forbidChangesWhileStopped(retag, state); d.HasError() {
+		t.Errorf("tag change while stopped: %v", d)
+	}
+}
+
+func TestPlanStateForDesiredState(t *testing.T) {
+	state := gateModel(true)
+	state.State = types.StringValue(api.PostgresStateRunning)
+	plan := state
+	plan.DesiredState = types.StringValue(postgresDesiredStateStopped)
+	got, ok := planStateForDesiredState(plan, state)
+	if !ok || got.ValueString() != api.PostgresStateStopped {
+		t.Errorf("stop: got (%s, %v)", got, ok)
+	}
+	plan.DesiredState = types.StringValue(postgresDesiredStateRunning)
+	if _, ok := planStateForDesiredState(plan, state); ok {
+		t.Error("no transition: want no planned state")
+	}
+}
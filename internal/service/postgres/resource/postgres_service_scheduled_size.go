@@ -0,0 +1,43 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+// keepScheduledSize puts prior back into model.Size while a resize window of
+// the instance's clickhouse_postgres_scheduled_scaling is active and the
+// server reports that window's size. The schedule owns the size until the
+// window ends, so planning the declared size back would resize the instance
+// mid-window. Must run after syncPostgresState. The schedule is only fetched
+// when the read size differs from prior; no schedule is not an error.
+func (r *PostgresServiceResource) keepScheduledSize(ctx context.Context, prior types.String, model *models.PostgresServiceResourceModel) error {
+	if prior.IsNull() || prior.IsUnknown() || prior.Equal(model.Size) {
+		return nil
+	}
+	schedule, err := r.client.GetPostgresScalingSchedule(ctx, model.ID.ValueString())
+	if err != nil {
+		if api.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if entry, ok := activeScalingEntry(schedule); ok && !entry.Stopped && entry.Size == model.Size.ValueString() {
+		model.Size = prior
+	}
+	return nil
+}
+
+// activeScalingEntry returns the schedule's currently active window, if any.
+func activeScalingEntry(schedule *api.PostgresScalingSchedule) (api.PostgresScalingScheduleEntry, bool) {
+	for _, e := range schedule.Entries {
+		if e.IsActiveNow || (schedule.ActiveEntryID != "" && e.ID == schedule.ActiveEntryID) {
+			return e, true
+		}
+	}
+	return api.PostgresScalingScheduleEntry{}, false
+}
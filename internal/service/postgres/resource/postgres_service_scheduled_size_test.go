@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+)
+
+func TestKeepScheduledSize(t *testing.T) {
+	ctx := context.Background()
+	schedule := &api.PostgresScalingSchedule{
+		Entries: []api.PostgresScalingScheduleEntry{
+			{ID: "e1", Name: "nights", Size: "m6gd.large"},
+			{ID: "e2", Name: "weekend", Stopped: true},
+		},
+		ActiveEntryID: "e1",
+	}
+	declared := types.StringValue("m6gd.xlarge")
+
+	cases := []struct {
+		name     string
+		schedule *api.PostgresScalingSchedule
+		err      error
+		read     string
+		want     string
+	}{
+		{"resize window active", schedule, nil, "m6gd.large", "m6gd.xlarge"},
+		{"size from elsewhere", schedule, nil, "m6gd.2xlarge", "m6gd.2xlarge"},
+		{"no active window", &api.PostgresScalingSchedule{Entries: schedule.Entries}, nil, "m6gd.large", "m6gd.large"},
+		{"no schedule", nil, errors.New("status: 404, body: not found"), "m6gd.large", "m6gd.large"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			client := api.NewClientMock(mc).GetPostgresScalingScheduleMock.Expect(minimock.AnyContext, "pg-1").Return(c.schedule, c.err)
+			r := &PostgresServiceResource{client: client}
+
+			model := gateModel(true)
+			model.Size = types.StringValue(c.read)
+			if err := r.keepScheduledSize(ctx, declared, &model); err != nil {
+				t.Fatal(err)
+			}
+			if got := model.Size.ValueString(); got != c.want {
+				t.Errorf("size = %s, want %s", got, c.want)
+			}
+		})
+	}
+
+	// An unchanged size does not fetch the schedule.
+	r := &PostgresServiceResource{client: api.NewClientMock(minimock.NewController(t))}
+	model := gateModel(true)
+	model.Size = declared
+	if err := r.keepScheduledSize(ctx, declared, &model); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
 )
 
@@ -99,14 +100,20 @@ func upgradePostgresServiceStateV0(old postgresServiceResourceModelV0) models.Po
 		Size:                 old.Size,
 		HaType:               old.HaType,
 		Tags:                 old.Tags,
+		DesiredState:         types.StringNull(),
 		PgConfig:             old.PgConfig,
 		PgBouncerConfig:      old.PgBouncerConfig,
+		IpAccess:             types.SetNull(models.PostgresIPAccessModel{}.ObjectType()),
+		PrivateEndpointIDs:   types.SetNull(types.StringType),
+		BackupConfiguration:  types.ObjectNull(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes),
 		State:                old.State,
 		CreatedAt:            old.CreatedAt,
 		IsPrimary:            old.IsPrimary,
 		Hostname:             old.Hostname,
 		Port:                 old.Port,
 		Username:             old.Username,
+		SSLRootCertPath:      types.StringNull(),
+		ConnectionStrings:    connstr.Null(),
 		Password:             password,
 		PasswordWO:           types.StringNull(),
 		PasswordWOVersion:    types.Int64Null(),
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/connstr"
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
 )
 
@@ -220,26 +222,32 @@ func TestPostgresSchema_passwordAttributes(t *testing.T) {
 // tests; every field must be a valid framework value for tfsdk encoding.
 func gateModel(primary bool) models.PostgresServiceResourceModel {
 	return models.PostgresServiceResourceModel{
-		ID:                types.StringValue("pg-1"),
-		Name:              types.StringValue("n"),
-		CloudProvider:     types.StringValue("aws"),
-		Region:            types.StringValue("us-east-1"),
-		PostgresVersion:   types.StringValue("18"),
-		Size:              types.StringValue("m6gd.large"),
-		HaType:            types.StringValue("none"),
-		Tags:              mapTags(),
-		PgConfig:          mapTags(),
-		PgBouncerConfig:   mapTags(),
-		State:             types.StringValue("running"),
-		CreatedAt:         types.StringValue("2026-05-27T00:00:00Z"),
-		IsPrimary:         types.BoolValue(primary),
-		Hostname:          types.StringValue("h.example.com"),
-		Port:              types.Int64Value(5432),
-		Username:          types.StringValue("postgres"),
-		Password:          types.StringNull(),
-		PasswordWO:        types.StringNull(),
-		PasswordWOVersion: types.Int64Null(),
-		ReadReplicaOf:     types.StringNull(),
+		ID:                  types.StringValue("pg-1"),
+		Name:                types.StringValue("n"),
+		CloudProvider:       types.StringValue("aws"),
+		Region:              types.StringValue("us-east-1"),
+		PostgresVersion:     types.StringValue("18"),
+		Size:                types.StringValue("m6gd.large"),
+		HaType:              types.StringValue("none"),
+		Tags:                mapTags(),
+		DesiredState:        types.StringNull(),
+		PgConfig:            mapTags(),
+		PgBouncerConfig:     mapTags(),
+		IpAccess:            types.SetValueMust(models.PostgresIPAccessModel{}.ObjectType(), nil),
+		PrivateEndpointIDs:  types.SetValueMust(types.StringType, nil),
+		BackupConfiguration: types.ObjectNull(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes),
+		State:               types.StringValue("running"),
+		CreatedAt:           types.StringValue("2026-05-27T00:00:00Z"),
+		IsPrimary:           types.BoolValue(primary),
+		Hostname:            types.StringValue("h.example.com"),
+		Port:                types.Int64Value(5432),
+		Username:            types.StringValue("postgres"),
+		SSLRootCertPath:     types.StringNull(),
+		ConnectionStrings:   connstr.Null(),
+		Password:            types.StringNull(),
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   types.Int64Null(),
+		ReadReplicaOf:       types.StringNull(),
 		RestoreToPointInTime: types.ObjectNull(map[string]attr.Type{
 			"source_id":      types.StringType,
 			"restore_target": types.StringType,
@@ -305,6 +313,58 @@ func TestPostgresModifyPlan_updateCredentialGate(t *testing.T) {
 		}
 	})
 
+	t.Run("removing read_replica_of without credential: plan error", func(t *testing.T) {
+		state := gateModel(false)
+		state.ReadReplicaOf = types.StringValue("pg-primary")
+		resp := run(state, gateModel(false), gateModel(false))
+		if resp.Diagnostics.ErrorsCount() != 1 ||
+			!strings.Contains(resp.Diagnostics.Errors()[0].Summary(), "Missing credential") {
+			t.Errorf("want the missing-credential error, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("removing read_replica_of with password: promotion planned", func(t *testing.T) {
+		state := gateModel(false)
+		state.ReadReplicaOf = types.StringValue("pg-primary")
+		cfg := gateModel(false)
+		cfg.Password = types.StringValue("ValidPass1234x")
+		resp := run(state, cfg, cfg)
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+			t.Fatalf("want clean plan, got %v", resp.Diagnostics)
+		}
+		var isPrimary types.Bool
+		resp.Plan.GetAttribute(ctx, path.Root("is_primary"), &isPrimary)
+		if !isPrimary.Equal(types.BoolValue(true)) {
+			t.Errorf("is_primary must be planned true on promotion, got %v", isPrimary)
+		}
+	})
+
+	t.Run("promotion with a version downgrade: plan error", func(t *testing.T) {
+		state := gateModel(false)
+		state.ReadReplicaOf = types.StringValue("pg-primary")
+		cfg := gateModel(false)
+		cfg.Password = types.StringValue("ValidPass1234x")
+		cfg.PostgresVersion = types.StringValue("17")
+		resp := run(state, cfg, cfg)
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("a downgrade planned with a promotion must still be refused, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("promotion of a stopped replica with a resize: plan error", func(t *testing.T) {
+		state := gateModel(false)
+		state.ReadReplicaOf = types.StringValue("pg-primary")
+		state.State = types.StringValue(api.PostgresStateStopped)
+		cfg := gateModel(false)
+		cfg.Password = types.StringValue("ValidPass1234x")
+		cfg.Size = types.StringValue("m6gd.xlarge")
+		cfg.State = state.State
+		resp := run(state, cfg, cfg)
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("a change on a stopped instance planned with a promotion must still be refused, got %v", resp.Diagnostics)
+		}
+	})
+
 	t.Run("imported replica with declared password: warning, no error", func(t *testing.T) {
 		state := gateModel(false)
 		cfg := gateModel(false)
@@ -653,6 +713,271 @@ func TestBuildPostgresUpdate(t *testing.T) {
 	})
 }
 
+func TestIsReplicaPromotion(t *testing.T) {
+	mk := func(replicaOf types.String, isPrimary types.Bool) models.PostgresServiceResourceModel {
+		return models.PostgresServiceResourceModel{ReadReplicaOf: replicaOf, IsPrimary: isPrimary}
+	}
+	primary := types.StringValue("pg-primary")
+	none := types.StringNull()
+	cases := []struct {
+		name        string
+		plan, state models.PostgresServiceResourceModel
+		want        bool
+	}{
+		{"live replica, read_replica_of removed", mk(none, types.BoolNull()), mk(primary, types.BoolValue(false)), true},
+		{"live replica, unchanged", mk(primary, types.BoolNull()), mk(primary, types.BoolValue(false)), false},
+		{"already promoted out-of-band", mk(none, types.BoolNull()), mk(primary, types.BoolValue(true)), false},
+		{"imported replica (no read_replica_of in state)", mk(none, types.BoolNull()), mk(none, types.BoolValue(false)), false},
+		{"is_primary unknown in state", mk(none, types.BoolNull()), mk(primary, types.BoolNull()), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isReplicaPromotion(c.plan, c.state); got != c.want {
+				t.Errorf("isReplicaPromotion = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Network access (ip_access / private_endpoint_ids)
+// ---------------------------------------------------------------------------
+
+func ipAccessSet(t *testing.T, entries ...api.IpAccess) types.Set {
+	t.Helper()
+	set, diags := apiIPAccessToSetValue(entries)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return set
+}
+
+func stringSet(values ...string) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func TestDiffNetworkAccess(t *testing.T) {
+	ctx := context.Background()
+	office := api.IpAccess{Source: "203.0.113.0/24", Description: "office"}
+	vpn := api.IpAccess{Source: "198.51.100.7"}
+
+	t.Run("unchanged produces no diff", func(t *testing.T) {
+		m := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t, office), PrivateEndpointIDs: stringSet("vpce-1")}
+		ip, ep, diags := diffNetworkAccess(ctx, m, m)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if ip != nil || ep != nil {
+			t.Errorf("expected no diff, got %#v %#v", ip, ep)
+		}
+	})
+
+	t.Run("only changed entries are added and removed", func(t *testing.T) {
+		state := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t, office), PrivateEndpointIDs: stringSet("vpce-1", "vpce-2")}
+		plan := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t, office, vpn), PrivateEndpointIDs: stringSet("vpce-2", "vpce-3")}
+		ip, ep, diags := diffNetworkAccess(ctx, plan, state)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if ip == nil || len(ip.Add) != 1 || ip.Add[0] != vpn || len(ip.Remove) != 0 {
+			t.Errorf("ip_access diff: got %#v", ip)
+		}
+		if ep == nil || len(ep.Add) != 1 || ep.Add[0] != "vpce-3" || len(ep.Remove) != 1 || ep.Remove[0] != "vpce-1" {
+			t.Errorf("private_endpoint_ids diff: got %#v", ep)
+		}
+	})
+
+	t.Run("empty set removes everything", func(t *testing.T) {
+		state := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t, office, vpn), PrivateEndpointIDs: stringSet()}
+		plan := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t), PrivateEndpointIDs: stringSet()}
+		ip, ep, _ := diffNetworkAccess(ctx, plan, state)
+		if ip == nil || len(ip.Remove) != 2 || len(ip.Add) != 0 {
+			t.Errorf("ip_access diff: got %#v", ip)
+		}
+		if ep != nil {
+			t.Errorf("private_endpoint_ids unchanged; got %#v", ep)
+		}
+	})
+
+	t.Run("unknown plan is left alone", func(t *testing.T) {
+		state := models.PostgresServiceResourceModel{IpAccess: ipAccessSet(t, office), PrivateEndpointIDs: stringSet("vpce-1")}
+		plan := models.PostgresServiceResourceModel{
+			IpAccess:           types.SetUnknown(models.PostgresIPAccessModel{}.ObjectType()),
+			PrivateEndpointIDs: types.SetUnknown(types.StringType),
+		}
+		ip, ep, _ := diffNetworkAccess(ctx, plan, state)
+		if ip != nil || ep != nil {
+			t.Errorf("expected no diff for unknown plan, got %#v %#v", ip, ep)
+		}
+	})
+
+	t.Run("network-only change is a hot PATCH without re-asserted tags", func(t *testing.T) {
+		state := models.PostgresServiceResourceModel{
+			Size: types.StringValue("c6gd.large"), HaType: types.StringValue("none"), Tags: mapTags("team", "billing"),
+			IpAccess: ipAccessSet(t, office), PrivateEndpointIDs: stringSet(),
+		}
+		plan := state
+		plan.IpAccess = ipAccessSet(t, vpn)
+		result, diags := buildPostgresUpdate(ctx, plan, state)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if result.Body == nil || result.Body.IpAccessList == nil {
+			t.Fatalf("expected ip_access in body, got %#v", result.Body)
+		}
+		if result.Body.Tags != nil || result.Body.PrivateEndpointIds != nil {
+			t.Errorf("unchanged fields must be omitted; got %#v", result.Body)
+		}
+		if result.TransitionExpected {
+			t.Errorf("network access changes are hot; TransitionExpected must be false")
+		}
+	})
+}
+
+func TestSyncPostgresState_networkAccess(t *testing.T) {
+	var got models.PostgresServiceResourceModel
+	diags := syncPostgresState(context.Background(), &api.Postgres{
+		Id: "pg-1", Name: "n", Provider: "aws", Region: "us-east-1",
+		IpAccessList:       []api.IpAccess{{Source: "198.51.100.7"}},
+		PrivateEndpointIds: []string{"vpce-1"},
+	}, &got)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.IpAccess.Equal(ipAccessSet(t, api.IpAccess{Source: "198.51.100.7"})) {
+		t.Errorf("ip_access: got %v", got.IpAccess)
+	}
+	if !got.PrivateEndpointIDs.Equal(stringSet("vpce-1")) {
+		t.Errorf("private_endpoint_ids: got %v", got.PrivateEndpointIDs)
+	}
+
+	// Nothing configured server-side reads back as empty sets, not null.
+	diags = syncPostgresState(context.Background(), &api.Postgres{Id: "pg-2"}, &got)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.IpAccess.Equal(ipAccessSet(t)) || !got.PrivateEndpointIDs.Equal(stringSet()) {
+		t.Errorf("expected empty sets, got %v / %v", got.IpAccess, got.PrivateEndpointIDs)
+	}
+}
+
+func TestForbidPrimaryOnlyOnReplica(t *testing.T) {
+	replica := models.PostgresServiceResourceModel{
+		ReadReplicaOf:       types.StringValue("pg-primary"),
+		IpAccess:            types.SetNull(models.PostgresIPAccessModel{}.ObjectType()),
+		PrivateEndpointIDs:  types.SetNull(types.StringType),
+		BackupConfiguration: apiBackupConfigurationToObject(nil),
+	}
+	if diags := forbidPrimaryOnlyOnReplica(replica); diags.HasError() {
+		t.Errorf("omitted attributes must pass: %v", diags)
+	}
+
+	replica.PrivateEndpointIDs = stringSet("vpce-1")
+	replica.BackupConfiguration = backupObj(7, "02:00")
+	if diags := forbidPrimaryOnlyOnReplica(replica); diags.ErrorsCount() != 2 {
+		t.Errorf("private_endpoint_ids and backup_configuration on a replica must be rejected; got %v", diags)
+	}
+
+	standard := replica
+	standard.ReadReplicaOf = types.StringNull()
+	if diags := forbidPrimaryOnlyOnReplica(standard); diags.HasError() {
+		t.Errorf("a standard create may declare them: %v", diags)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// backup configuration / restore window
+// ---------------------------------------------------------------------------
+
+func backupObj(days int64, start string) types.Object {
+	return models.PostgresBackupConfigurationModel{
+		RetentionDays:     types.Int64Value(days),
+		BackupWindowStart: types.StringValue(start),
+	}.ObjectValue()
+}
+
+func TestBackupConfigurationToAPI(t *testing.T) {
+	null := apiBackupConfigurationToObject(nil)
+
+	t.Run("create sends every known attribute", func(t *testing.T) {
+		body := backupConfigurationToAPI(backupObj(14, "03:30"), null)
+		if body == nil || *body.RetentionDays != 14 || *body.BackupWindowStart != "03:30" {
+			t.Fatalf("unexpected body: %#v", body)
+		}
+	})
+
+	t.Run("update sends only changed attributes", func(t *testing.T) {
+		body := backupConfigurationToAPI(backupObj(14, "03:30"), backupObj(7, "03:30"))
+		if body == nil || body.RetentionDays == nil || *body.RetentionDays != 14 {
+			t.Fatalf("expected retention_days in body; got %#v", body)
+		}
+		if body.BackupWindowStart != nil {
+			t.Errorf("unchanged backup_window_start must be omitted")
+		}
+	})
+
+	t.Run("unknown attribute is left alone", func(t *testing.T) {
+		plan := models.PostgresBackupConfigurationModel{
+			RetentionDays:     types.Int64Value(7),
+			BackupWindowStart: types.StringUnknown(),
+		}.ObjectValue()
+		body := backupConfigurationToAPI(plan, null)
+		if body == nil || body.BackupWindowStart != nil {
+			t.Errorf("unknown backup_window_start must be omitted; got %#v", body)
+		}
+	})
+
+	t.Run("no change or omitted block sends nothing", func(t *testing.T) {
+		if body := backupConfigurationToAPI(backupObj(7, "02:00"), backupObj(7, "02:00")); body != nil {
+			t.Errorf("unchanged: want nil, got %#v", body)
+		}
+		unknown := types.ObjectUnknown(models.PostgresBackupConfigurationModel{}.ObjectType().AttrTypes)
+		if body := backupConfigurationToAPI(unknown, null); body != nil {
+			t.Errorf("unknown block: want nil, got %#v", body)
+		}
+	})
+}
+
+func TestApiBackupConfigurationToObject(t *testing.T) {
+	days, start := int64(7), "02:00"
+	got := apiBackupConfigurationToObject(&api.PostgresBackupConfiguration{RetentionDays: &days, BackupWindowStart: &start})
+	if !got.Equal(backupObj(7, "02:00")) {
+		t.Errorf("got %v", got)
+	}
+	if !apiBackupConfigurationToObject(nil).IsNull() {
+		t.Error("a replica (nil response) must map to null")
+	}
+}
+
+func TestValidateRestoreTarget(t *testing.T) {
+	now := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+	window := &api.PostgresBackups{EarliestRestoreTarget: "2026-06-03T00:00:00Z", LatestRestoreTarget: "2026-06-10T11:59:00Z"}
+	cases := []struct {
+		name    string
+		target  string
+		window  *api.PostgresBackups
+		wantErr bool
+	}{
+		{"inside window", "2026-06-05T08:00:00Z", window, false},
+		{"at earliest", "2026-06-03T00:00:00Z", window, false},
+		{"before earliest", "2026-06-02T23:59:59Z", window, true},
+		{"in the future", "2026-06-11T00:00:00Z", window, true},
+		{"not RFC3339", "2026-06-05 08:00", window, true},
+		{"no backup yet", "2026-06-05T08:00:00Z", &api.PostgresBackups{}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if diags := validateRestoreTarget(c.target, c.window, now); diags.HasError() != c.wantErr {
+				t.Errorf("want error=%v; got %v", c.wantErr, diags)
+			}
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // buildPostgresMatchPredicate
 // ---------------------------------------------------------------------------
@@ -770,3 +1095,73 @@ func modelsEqual(t *testing.T, got, want models.PostgresServiceResourceModel) bo
 	}
 	return ok
 }
+
+func TestCheckMajorVersionChange(t *testing.T) {
+	version := func(v types.String) models.PostgresServiceResourceModel {
+		return models.PostgresServiceResourceModel{PostgresVersion: v}
+	}
+	cases := []struct {
+		name                 string
+		plan, state          types.String
+		wantErr, wantWarning bool
+		wantTarget           string
+	}{
+		{"unchanged", types.StringValue("17"), types.StringValue("17"), false, false, ""},
+		{"upgrade", types.StringValue("18"), types.StringValue("17"), false, true, "18"},
+		{"downgrade", types.StringValue("17"), types.StringValue("18"), true, false, ""},
+		{"plan unknown", types.StringUnknown(), types.StringValue("17"), false, false, ""},
+		{"no prior version", types.StringValue("18"), types.StringNull(), false, false, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := checkMajorVersionChange(version(c.plan), version(c.state))
+			if d.HasError() != c.wantErr || (d.WarningsCount() > 0) != c.wantWarning {
+				t.Errorf("diags = %v; want error=%v warning=%v", d, c.wantErr, c.wantWarning)
+			}
+			target, ok := majorUpgradeTarget(version(c.plan), version(c.state))
+			if ok != (c.wantTarget != "") || target != c.wantTarget {
+				t.Errorf("majorUpgradeTarget = %q, %v; want %q", target, ok, c.wantTarget)
+			}
+		})
+	}
+}
+
+func TestIsUpgradedTo(t *testing.T) {
+	done := isUpgradedTo("18")
+	if done(&api.Postgres{PostgresVersion: "17", State: api.PostgresStateRunning}) {
+		t.Error("still on the old version: not done")
+	}
+	if done(&api.Postgres{PostgresVersion: "18", State: api.PostgresStateUpgrading}) {
+		t.Error("not running yet: not done")
+	}
+	if !done(&api.Postgres{PostgresVersion: "18", State: api.PostgresStateRunning}) {
+		t.Error("new version and running: done")
+	}
+}
+
+func TestNullUnknownState(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&PostgresServiceResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	model := gateModel(true)
+	model.Hostname = types.StringUnknown()
+	model.ConnectionStrings = types.ObjectUnknown(connstr.ObjectType.AttrTypes)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if d := state.Set(ctx, model); d.HasError() {
+		t.Fatal(d)
+	}
+	if d := nullUnknownState(&state); d.HasError() {
+		t.Fatal(d)
+	}
+	if !state.Raw.IsFullyKnown() {
+		t.Fatal("state still has unknown values")
+	}
+	var got models.PostgresServiceResourceModel
+	if d := state.Get(ctx, &got); d.HasError() {
+		t.Fatal(d)
+	}
+	if !got.Hostname.IsNull() || !got.ConnectionStrings.IsNull() || got.ID.ValueString() != "pg-1" {
+		t.Errorf("got hostname=%v connection_strings=%v id=%v", got.Hostname, got.ConnectionStrings, got.ID)
+	}
+}
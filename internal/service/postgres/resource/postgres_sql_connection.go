@@ -0,0 +1,174 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgsql"
+)
+
+// postgresMaintenanceDatabase is the database cluster-wide statements (roles,
+// databases) are run from.
+const postgresMaintenanceDatabase = "postgres"
+
+// configurePostgresSQLResource is the shared Configure body of the resources
+// that manage objects inside an instance over SQL. They still need the Cloud
+// API: connection details and the CA bundle come from it.
+func configurePostgresSQLResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) api.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+	providerData, ok := req.ProviderData.(*service.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("expected *service.ProviderData, got %T. This is a bug in the provider.", req.ProviderData))
+		return nil
+	}
+	if providerData.API == nil {
+		resp.Diagnostics.AddError("ClickHouse Cloud API not configured",
+			"This resource requires ClickHouse Cloud credentials. Set organization_id, token_key and token_secret on the provider (or the corresponding CLICKHOUSE_* environment variables).")
+		return nil
+	}
+	return providerData.API
+}
+
+// postgresSQLConnectionAttributes are the attributes every SQL-managed
+// resource uses to reach its instance.
+func postgresSQLConnectionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"service_id": schema.StringAttribute{
+			Description: "ID of the `clickhouse_postgres_service` to manage the object in.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"superuser_password": schema.StringAttribute{
+			Description: "Password of the service's superuser, used to connect. Typically `clickhouse_postgres_service.<name>.password`. Stored in (sensitive) state: the object is refreshed and destroyed over SQL, so the password is needed outside of apply too. Changing it does not change the object. Exactly one of `superuser_password` and `superuser_password_wo` must be set.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("superuser_password"), path.MatchRoot("superuser_password_wo")),
+			},
+		},
+		"superuser_password_wo": schema.StringAttribute{
+			Description: "Password of the service's superuser, write-only: used to connect during apply but never persisted to Terraform state (requires Terraform >= 1.11). Without a password in state the object is not refreshed, so out-of-band changes are not detected, and destroying the resource removes it from state without dropping it. Increment `superuser_password_wo_version` after rotating the superuser password.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"superuser_password_wo_version": schema.Int64Attribute{
+			Description: "Version number for `superuser_password_wo`. Increment to reconnect with the current `superuser_password_wo` value; the object itself is not changed.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("superuser_password_wo")),
+			},
+		},
+	}
+}
+
+// openPostgresSQL opens a superuser session to database on the service. The
+// hostname and superuser come from GetPostgres, and the server certificate is
+// verified against the service's own CA bundle.
+func openPostgresSQL(ctx context.Context, client api.Client, serviceID, password, database string) (*pgsql.Conn, error) {
+	pg, err := client.GetPostgres(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if pg.Hostname == "" || pg.Username == "" {
+		return nil, fmt.Errorf("postgres service %s has no hostname or superuser yet (state %q)", serviceID, pg.State)
+	}
+	ca, err := client.GetPostgresCaCertificates(ctx, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch CA certificates: %w", err)
+	}
+	return pgsql.Connect(ctx, pgsql.Config{
+		Host:     pg.Hostname,
+		Port:     uint16(postgresDefaultPort),
+		User:     pg.Username,
+		Password: password,
+		Database: database,
+		RootCAs:  ca,
+	})
+}
+
+// superuserPassword returns the password to connect with during apply:
+// superuser_password_wo from config when set, otherwise superuser_password.
+func superuserPassword(configWO, password types.String) string {
+	if !configWO.IsNull() && !configWO.IsUnknown() {
+		return configWO.ValueString()
+	}
+	return password.ValueString()
+}
+
+// openPostgresSQLForRead opens a session for Read, or returns nil with the
+// outcome already applied to resp. Two cases keep the prior state with a
+// warning instead of failing the refresh, so the plan that fixes them can
+// still be made: an imported object, which has no superuser_password in state
+// until the first apply (or ever, with superuser_password_wo), and a rejected password, e.g. after the superuser
+// password was rotated and before the new value reaches this resource's state.
+func openPostgresSQLForRead(ctx context.Context, client api.Client, serviceID string, superuserPassword types.String, database string, resp *resource.ReadResponse) *pgsql.Conn {
+	if superuserPassword.IsNull() || superuserPassword.ValueString() == "" {
+		resp.Diagnostics.AddWarning("Postgres object not refreshed",
+			"No superuser_password in state (right after import, or when superuser_password_wo is used); keeping the prior state.")
+		return nil
+	}
+	conn, err := openPostgresSQL(ctx, client, serviceID, superuserPassword.ValueString(), database)
+	switch {
+	case err == nil:
+		return conn
+	case api.IsNotFound(err):
+		resp.State.RemoveResource(ctx)
+	case pgsql.IsAuthenticationFailure(err):
+		resp.Diagnostics.AddWarning("Postgres object not refreshed",
+			"The superuser_password in state was rejected by service "+serviceID+"; keeping the prior state. Apply with the current password to refresh.")
+	default:
+		resp.Diagnostics.AddError("Error connecting to Postgres service",
+			"Could not connect to Postgres service "+serviceID+": "+err.Error())
+	}
+	return nil
+}
+
+// openPostgresSQLForDelete opens a session for Delete, or returns nil with the
+// outcome already applied to resp. Without a superuser_password in state (only
+// superuser_password_wo was set) there is no way to connect: the object is
+// removed from state, left in place, and a warning says so. An object whose
+// service is already gone is treated as deleted.
+func openPostgresSQLForDelete(ctx context.Context, client api.Client, serviceID string, superuserPassword types.String, database, object string, resp *resource.DeleteResponse) *pgsql.Conn {
+	if superuserPassword.IsNull() || superuserPassword.ValueString() == "" {
+		resp.Diagnostics.AddWarning("Postgres object not dropped",
+			object+" was removed from Terraform state but still exists in service "+serviceID+": there is no superuser_password in state to connect with (superuser_password_wo is not available on destroy). Drop it manually if it is no longer needed.")
+		return nil
+	}
+	conn, err := openPostgresSQL(ctx, client, serviceID, superuserPassword.ValueString(), database)
+	if err != nil {
+		if !isObjectGone(err) {
+			resp.Diagnostics.AddError("Error connecting to Postgres service", "Could not connect to Postgres service "+serviceID+": "+err.Error())
+		}
+		return nil
+	}
+	return conn
+}
+
+// isObjectGone reports whether a read failed because the object, or the whole
+// service, no longer exists.
+func isObjectGone(err error) bool {
+	return errors.Is(err, pgsql.ErrNotFound) || api.IsNotFound(err)
+}
@@ -0,0 +1,122 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/pgsql"
+	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service/postgres/resource/models"
+)
+
+func TestPostgresSQLResources_schema(t *testing.T) {
+	for _, r := range []resource.Resource{&PostgresDatabaseResource{}, &PostgresRoleResource{}, &PostgresExtensionResource{}} {
+		resp := resource.SchemaResponse{}
+		r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+		if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+			t.Errorf("%T: invalid schema: %v", r, diags)
+		}
+		pw, ok := resp.Schema.Attributes["superuser_password"].(schema.StringAttribute)
+		if !ok || !pw.Optional || !pw.Sensitive || pw.WriteOnly {
+			t.Errorf("%T: superuser_password must be Optional+Sensitive and not write-only (Read needs it): %+v", r, pw)
+		}
+		wo, ok := resp.Schema.Attributes["superuser_password_wo"].(schema.StringAttribute)
+		if !ok || !wo.Sensitive || !wo.WriteOnly {
+			t.Errorf("%T: superuser_password_wo must be Sensitive+WriteOnly: %+v", r, wo)
+		}
+	}
+}
+
+func TestSuperuserPassword(t *testing.T) {
+	if got := superuserPassword(types.StringValue("wo"), types.StringNull()); got != "wo" {
+		t.Errorf("superuser_password_wo must be used when set; got %q", got)
+	}
+	if got := superuserPassword(types.StringNull(), types.StringValue("pw")); got != "pw" {
+		t.Errorf("superuser_password must be used without superuser_password_wo; got %q", got)
+	}
+}
+
+func TestOpenPostgresSQLForDelete_noPasswordForgets(t *testing.T) {
+	resp := &resource.DeleteResponse{}
+	if conn := openPostgresSQLForDelete(context.Background(), nil, "svc", types.StringNull(), postgresMaintenanceDatabase, "Database app", resp); conn != nil {
+		t.Fatal("expected no connection without a password in state")
+	}
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a single warning, got %v", resp.Diagnostics)
+	}
+}
+
+func TestApplyPgsqlDatabaseToState_keepsEncodingSpelling(t *testing.T) {
+	state := models.PostgresDatabaseResourceModel{Encoding: types.StringValue("utf8")}
+	applyPgsqlDatabaseToState(&pgsql.Database{Name: "app", Owner: "app", Encoding: "UTF8", ConnectionLimit: -1}, &state)
+	if state.Encoding.ValueString() != "utf8" {
+		t.Errorf("case-only difference must keep the declared spelling; got %s", state.Encoding)
+	}
+
+	state.Encoding = types.StringUnknown()
+	applyPgsqlDatabaseToState(&pgsql.Database{Name: "app", Encoding: "UTF8"}, &state)
+	if state.Encoding.ValueString() != "UTF8" {
+		t.Errorf("omitted encoding must resolve to the server's; got %s", state.Encoding)
+	}
+}
+
+func TestRolePassword(t *testing.T) {
+	role := func(password string, woVersion int64) models.PostgresRoleResourceModel {
+		m := models.PostgresRoleResourceModel{
+			Password:          types.StringNull(),
+			PasswordWO:        types.StringNull(),
+			PasswordWOVersion: types.Int64Null(),
+		}
+		if password != "" {
+			m.Password = types.StringValue(password)
+		}
+		if woVersion != 0 {
+			m.PasswordWOVersion = types.Int64Value(woVersion)
+		}
+		return m
+	}
+	withWO := func(m models.PostgresRoleResourceModel, wo string) models.PostgresRoleResourceModel {
+		m.PasswordWO = types.StringValue(wo)
+		return m
+	}
+
+	t.Run("create", func(t *testing.T) {
+		if got := rolePasswordOnCreate(role("", 0), role("", 0)); got != nil {
+			t.Errorf("no credential: want nil, got %q", *got)
+		}
+		if got := rolePasswordOnCreate(role("a", 0), role("a", 0)); got == nil || *got != "a" {
+			t.Errorf("password: got %v", got)
+		}
+		if got := rolePasswordOnCreate(role("", 1), withWO(role("", 1), "wo")); got == nil || *got != "wo" {
+			t.Errorf("password_wo: got %v", got)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		cases := []struct {
+			name                string
+			plan, state, config models.PostgresRoleResourceModel
+			want                string // "" = nil
+		}{
+			{"password changed", role("b", 0), role("a", 0), role("b", 0), "b"},
+			{"password unchanged", role("a", 0), role("a", 0), role("a", 0), ""},
+			{"password removed", role("", 0), role("a", 0), role("", 0), ""},
+			{"wo version bumped", role("", 2), role("", 1), withWO(role("", 2), "wo"), "wo"},
+			{"wo version unchanged", role("", 1), role("", 1), withWO(role("", 1), "wo"), ""},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				got := rolePasswordOnUpdate(c.plan, c.state, c.config)
+				switch {
+				case c.want == "" && got != nil:
+					t.Errorf("want nil, got %q", *got)
+				case c.want != "" && (got == nil || *got != c.want):
+					t.Errorf("want %q, got %v", c.want, got)
+				}
+			})
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/service"
@@ -16,7 +17,7 @@ import (
 )
 
 // ProviderTypeName is the provider's Terraform type name, the prefix on every
-// resource and data source type (e.g. clickhouse_service).
+// resource, data source and ephemeral resource type (e.g. clickhouse_service).
 const ProviderTypeName = "clickhouse"
 
 func ServicePackages() []service.ServicePackage {
@@ -27,24 +28,25 @@ func ServicePackages() []service.ServicePackage {
 	}
 }
 
-// Kind distinguishes a resource from a data source.
+// Kind distinguishes a resource from a data source or an ephemeral resource.
 type Kind int
 
 const (
 	KindResource Kind = iota
 	KindDataSource
+	KindEphemeralResource
 )
 
-// Component is one resource or data source together with the group that owns
-// it. It is resolved by instantiating the factory and reading its Metadata.
+// Component is one resource, data source or ephemeral resource together with
+// the group that owns it. It is resolved by instantiating the factory and reading its Metadata.
 type Component struct {
 	Group    service.Metadata
 	Kind     Kind
 	TypeName string // Terraform type name, e.g. "clickhouse_service"
 }
 
-// Components walks every registered service package and returns its resources
-// and data sources paired with their owning group's metadata. It is the shared
+// Components walks every registered service package and returns its resources,
+// data sources and ephemeral resources paired with their owning group's metadata. It is the shared
 // source of truth for tooling that needs the type-name -> group mapping (docs
 // subcategory stamping, the registry uniqueness/count test).
 func Components() []Component {
@@ -61,6 +63,11 @@ func Components() []Component {
 			f().Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: ProviderTypeName}, &mr)
 			out = append(out, Component{Group: meta, Kind: KindDataSource, TypeName: mr.TypeName})
 		}
+		for _, f := range sp.EphemeralResources() {
+			var mr ephemeral.MetadataResponse
+			f().Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: ProviderTypeName}, &mr)
+			out = append(out, Component{Group: meta, Kind: KindEphemeralResource, TypeName: mr.TypeName})
+		}
 	}
 	return out
 }
@@ -21,12 +21,16 @@ func TestServicePackages(t *testing.T) {
 		groups[meta.Name] = meta.Owner
 	}
 
-	resTypes := map[string]string{} // resource type name -> group
-	dsTypes := map[string]string{}  // data source type name -> group
+	resTypes := map[string]string{}   // resource type name -> group
+	dsTypes := map[string]string{}    // data source type name -> group
+	ephemTypes := map[string]string{} // ephemeral resource type name -> group
 	for _, c := range Components() {
 		types, label := resTypes, "resource"
-		if c.Kind == KindDataSource {
+		switch c.Kind {
+		case KindDataSource:
 			types, label = dsTypes, "data source"
+		case KindEphemeralResource:
+			types, label = ephemTypes, "ephemeral resource"
 		}
 		if prev, dup := types[c.TypeName]; dup {
 			t.Fatalf("%s type %q registered by both %q and %q", label, c.TypeName, prev, c.Group.Name)
@@ -41,10 +45,11 @@ func TestServicePackages(t *testing.T) {
 	// pre-restructure provider exposed. This catches a factory accidentally
 	// dropped from a group's list (which the uniqueness check above would miss).
 	// Bump these numbers deliberately when a group gains or loses a
-	// resource/data source.
+	// resource/data source/ephemeral resource.
 	const (
-		wantResources   = 25 // 15 clickhouse + 1 postgres + 9 clickstack
-		wantDataSources = 12 // 7 clickhouse + 3 postgres + 2 clickstack
+		wantResources          = 44 // 23 clickhouse + 8 postgres + 13 clickstack
+		wantDataSources        = 22 // 8 clickhouse + 4 postgres + 10 clickstack
+		wantEphemeralResources = 2  // 1 postgres + 1 clickstack
 	)
 	if len(resTypes) != wantResources {
 		t.Errorf("registered resource count = %d, want %d (a factory was added or dropped?)", len(resTypes), wantResources)
@@ -52,4 +57,7 @@ func TestServicePackages(t *testing.T) {
 	if len(dsTypes) != wantDataSources {
 		t.Errorf("registered data source count = %d, want %d (a factory was added or dropped?)", len(dsTypes), wantDataSources)
 	}
+	if len(ephemTypes) != wantEphemeralResources {
+		t.Errorf("registered ephemeral resource count = %d, want %d (a factory was added or dropped?)", len(ephemTypes), wantEphemeralResources)
+	}
 }
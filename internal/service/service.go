@@ -1,10 +1,11 @@
 // Package service defines the contract every service group (clickhouse,
-// postgres, clickstack, ...) implements to contribute resources and data
-// sources to the provider. See docs/rfcs/0001 and decisions/0002.
+// postgres, clickstack, ...) implements to contribute resources, data sources
+// and ephemeral resources to the provider. See docs/rfcs/0001 and decisions/0002.
 package service
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/ClickHouse/terraform-provider-clickhouse/internal/api"
@@ -12,12 +13,13 @@ import (
 )
 
 // ServicePackage is implemented once per service group. A group
-// self-describes its metadata and the resources and data sources it
-// contributes to the provider.
+// self-describes its metadata and the resources, data sources and ephemeral
+// resources it contributes to the provider.
 type ServicePackage interface {
 	Meta() Metadata
 	Resources() []func() resource.Resource
 	DataSources() []func() datasource.DataSource
+	EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 type Stability string
@@ -35,8 +37,8 @@ type Metadata struct {
 	Stability Stability // default maturity for the group
 }
 
-// ProviderData is what the provider's Configure hands to every resource and
-// data source. Each service group reads the client(s) it needs; a nil client
+// ProviderData is what the provider's Configure hands to every resource, data
+// source and ephemeral resource. Each service group reads the client(s) it needs; a nil client
 // means the user did not configure that group.
 //
 // The unwrap invariant (assert *ProviderData, check the client is non-nil,
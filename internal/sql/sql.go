@@ -1,10 +1,592 @@
 package sql
 
 import (
+	"fmt"
+	"slices"
 	"strings"
+	"unicode"
 )
 
 // EscapeBacktick escapes the ` characted in strings to make them safe for use in SQL queries as literal values.
 func EscapeBacktick(s string) string {
 	return strings.ReplaceAll(s, "`", "\\`")
 }
+
+// EscapeSingleQuote escapes backslashes and ' characters so s can be embedded in a single-quoted SQL string literal.
+func EscapeSingleQuote(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", `\'`)
+}
+
+// QuoteIdentifier returns s as a backtick-quoted SQL identifier. Backslashes
+// are escaped first, so a name ending in one cannot escape the closing backtick.
+func QuoteIdentifier(s string) string {
+	return "`" + EscapeBacktick(strings.ReplaceAll(s, `\`, `\\`)) + "`"
+}
+
+// QuoteString returns s as a single-quoted SQL string literal.
+func QuoteString(s string) string {
+	return "'" + EscapeSingleQuote(s) + "'"
+}
+
+// Normalize returns a canonical form of a SQL fragment for equivalence checks.
+// ClickHouse echoes stored expressions and queries back re-formatted (keywords
+// upper-cased, spaces around operators and after commas), so comparing the raw
+// text produces spurious diffs. Outside quoted literals and identifiers,
+// Normalize collapses whitespace runs, drops whitespace next to punctuation and
+// operators and lower-cases keywords. Identifiers are case-sensitive in
+// ClickHouse and are kept as written, as are quoted sections.
+//
+// The result is only meant for comparison; never send it to the server.
+func Normalize(s string) string {
+	var b strings.Builder
+	toks := tokenize(s)
+	for i, t := range toks {
+		if i > 0 && t.space && t.kind != tokPunct && toks[i-1].kind != tokPunct {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// Equivalent reports whether a and b are the same SQL up to formatting. On top
+// of the Normalize rules it ignores parentheses that do not change how the
+// expression groups, such as the ones ClickHouse adds around each operand of
+// AND and OR when it stores a condition.
+func Equivalent(a, b string) bool {
+	ta, tb := stripRedundantParens(tokenize(a)), stripRedundantParens(tokenize(b))
+	return slices.EqualFunc(ta, tb, func(x, y sqlToken) bool { return x.text == y.text })
+}
+
+// Token kinds produced by tokenize.
+const (
+	tokWord   = 'w' // identifier, keyword or number
+	tokQuoted = 'q' // quoted literal or identifier, verbatim
+	tokPunct  = 'p' // punctuation or operator
+	tokOther  = 'o'
+)
+
+// sqlToken is one token of a SQL fragment. space records whether whitespace
+// preceded it.
+type sqlToken struct {
+	text  string
+	kind  byte
+	space bool
+}
+
+// twoCharOperators are the operators tokenize keeps together.
+var twoCharOperators = []string{"<=", ">=", "<>", "!=", "==", "||", "::", "->"}
+
+// tokenize splits s into tokens, lower-casing keywords.
+func tokenize(s string) []sqlToken {
+	var toks []sqlToken
+	space := false
+	for i := 0; i < len(s); {
+		c := s[i]
+		t := sqlToken{space: space}
+		switch {
+		case unicode.IsSpace(rune(c)):
+			space = true
+			i++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			end := quotedEnd(s, i)
+			t.text, t.kind = s[i:end], tokQuoted
+			i = end
+		case isSQLPunct(c):
+			n := 1
+			if i+1 < len(s) && slices.Contains(twoCharOperators, s[i:i+2]) {
+				n = 2
+			}
+			t.text, t.kind = s[i:i+n], tokPunct
+			i += n
+		case isWordByte(c):
+			j := i
+			for j < len(s) && isWordByte(s[j]) {
+				j++
+			}
+			t.text, t.kind = s[i:j], tokWord
+			if lower := strings.ToLower(t.text); isKeyword(lower) {
+				t.text = lower
+			}
+			i = j
+		default:
+			t.text, t.kind = s[i:i+1], tokOther
+			i++
+		}
+		toks = append(toks, t)
+		space = false
+	}
+	return toks
+}
+
+// isWordByte reports whether c continues an identifier, keyword or number.
+// Bytes of multi-byte UTF-8 characters count as word bytes.
+func isWordByte(c byte) bool {
+	return c == '_' || c == '.' || c == '$' || c >= 0x80 ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// Operator precedence levels, loosest first. precAtom marks a parenthesized
+// group without a top-level operator.
+const (
+	precNone = iota
+	precOr
+	precAnd
+	precNot
+	precCompare
+	precConcat
+	precAdd
+	precMul
+	precCast
+	precAtom
+)
+
+// operatorKeywords are the keywords that may appear inside a group whose
+// parentheses are dropped; any other keyword (SELECT, CASE, BETWEEN, ...)
+// keeps the parentheses.
+var operatorKeywords = map[string]int{
+	"or": precOr, "and": precAnd, "not": precNot,
+	"like": precCompare, "ilike": precCompare, "in": precCompare, "is": precCompare,
+	"null": precAtom, "true": precAtom, "false": precAtom,
+}
+
+// clauseKeywords start or end a clause, so a group next to one is not an
+// operand of a surrounding operator.
+var clauseKeywords = []string{
+	"select", "from", "where", "prewhere", "having", "on", "by", "when", "then", "else", "end",
+	"group", "order", "limit", "settings", "format", "union",
+}
+
+func punctPrecedence(op string) (int, bool) {
+	switch op {
+	case "=", "==", "!=", "<>", "<", ">", "<=", ">=":
+		return precCompare, true
+	case "||":
+		return precConcat, true
+	case "+", "-":
+		return precAdd, true
+	case "*", "/", "%":
+		return precMul, true
+	case "::":
+		return precCast, true
+	}
+	return 0, false
+}
+
+// outerPrecedence returns how tightly the token next to a parenthesized group
+// binds, or ok=false when the parentheses may belong to a function call, a
+// tuple or a subquery and must be kept. t is nil at either end of the input.
+func outerPrecedence(t *sqlToken, before bool) (int, bool) {
+	if t == nil {
+		return precNone, true
+	}
+	switch t.kind {
+	case tokPunct:
+		switch t.text {
+		case ",", ";":
+			return precNone, true
+		case "(":
+			return precNone, before
+		case ")":
+			return precNone, !before
+		}
+		return punctPrecedence(t.text)
+	case tokWord:
+		if slices.Contains(clauseKeywords, t.text) {
+			return precNone, true
+		}
+		switch t.text {
+		case "or", "and":
+			return operatorKeywords[t.text], true
+		case "not":
+			if before {
+				return precNot, true
+			}
+			return precCompare, true // x NOT IN / NOT LIKE
+		case "like", "ilike", "in", "is":
+			return precCompare, !before
+		}
+	}
+	return 0, false
+}
+
+// innerPrecedence returns the loosest operator at the top level of toks, or
+// ok=false when toks holds something that needs its parentheses (a comma or a
+// keyword other than an operator).
+func innerPrecedence(toks []sqlToken) (int, bool) {
+	prec, depth := precAtom, 0
+	for _, t := range toks {
+		switch {
+		case t.kind == tokPunct && (t.text == "(" || t.text == "[" || t.text == "{"):
+			depth++
+		case t.kind == tokPunct && (t.text == ")" || t.text == "]" || t.text == "}"):
+			depth--
+		case depth > 0:
+		case t.kind == tokPunct:
+			p, ok := punctPrecedence(t.text)
+			if !ok {
+				return 0, false
+			}
+			prec = min(prec, p)
+		case t.kind == tokWord && isKeyword(t.text):
+			p, ok := operatorKeywords[t.text]
+			if !ok {
+				return 0, false
+			}
+			prec = min(prec, p)
+		case t.kind == tokOther:
+			return 0, false
+		}
+	}
+	return prec, true
+}
+
+// stripRedundantParens drops parenthesized groups that bind tighter than the
+// operators around them, e.g. both pairs in "(a = 1) AND (b = 2)".
+func stripRedundantParens(toks []sqlToken) []sqlToken {
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind != tokPunct || toks[i].text != "(" {
+			continue
+		}
+		j := closingParen(toks, i)
+		if j < 0 {
+			return toks
+		}
+		var before, after *sqlToken
+		if i > 0 {
+			before = &toks[i-1]
+		}
+		if j+1 < len(toks) {
+			after = &toks[j+1]
+		}
+		left, okLeft := outerPrecedence(before, true)
+		right, okRight := outerPrecedence(after, false)
+		inner, okInner := innerPrecedence(toks[i+1 : j])
+		if !okLeft || !okRight || !okInner {
+			continue
+		}
+		outer := max(left, right)
+		if inner > outer || inner == outer && (inner == precAnd || inner == precOr) {
+			toks = slices.Delete(slices.Delete(toks, j, j+1), i, i+1)
+			i = -1 // rescan: outer groups may have become redundant
+		}
+	}
+	return toks
+}
+
+// closingParen returns the index of the ")" matching the "(" at toks[open],
+// or -1.
+func closingParen(toks []sqlToken, open int) int {
+	depth := 0
+	for k := open; k < len(toks); k++ {
+		if toks[k].kind != tokPunct {
+			continue
+		}
+		switch toks[k].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return k
+			}
+		}
+	}
+	return -1
+}
+
+// isKeyword reports whether the lower-cased word w is a SQL keyword.
+func isKeyword(w string) bool {
+	_, op := operatorKeywords[w]
+	return op || slices.Contains(clauseKeywords, w) || slices.Contains(otherKeywords, w)
+}
+
+// otherKeywords completes the list of keywords ClickHouse upper-cases when it
+// stores a query. They are also what keeps subqueries, CASE and BETWEEN
+// expressions parenthesized.
+var otherKeywords = []string{
+	"as", "case", "between", "distinct", "all", "any", "exists", "join", "inner", "left", "right",
+	"full", "outer", "cross", "semi", "anti", "asof", "using", "with", "asc", "desc", "nulls",
+	"first", "last", "offset", "collate", "interval", "cast", "global", "array", "final", "sample",
+	"over", "partition", "window", "rows", "range", "unbounded", "preceding", "following",
+	"current", "row", "except", "intersect", "totals", "fill", "step", "ties", "top", "qualify",
+}
+
+// quotedEnd returns the index just past the quoted section starting at
+// s[start], honouring backslash escapes and doubled quote characters.
+func quotedEnd(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case q:
+			if i+1 < len(s) && s[i+1] == q {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+func isSQLPunct(c byte) bool {
+	return strings.IndexByte("(),;=<>!+-*/%|:[]{}", c) >= 0
+}
+
+// QuoteQualified returns database.name with both parts quoted as identifiers.
+func QuoteQualified(database, name string) string {
+	return QuoteIdentifier(database) + "." + QuoteIdentifier(name)
+}
+
+// StripDatabase removes the database qualifier from every database.name
+// reference to database in s, outside string literals. ClickHouse qualifies
+// bare table names with the current database when it stores a view's query,
+// so comparing a configured query with the stored one needs this first.
+func StripDatabase(s string, database string) string {
+	prefixes := []string{database + ".", QuoteIdentifier(database) + "."}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if i == 0 || !isWordByte(s[i-1]) {
+			if p := prefixOf(s[i:], prefixes); p != "" {
+				i += len(p)
+				continue
+			}
+		}
+		if s[i] == '\'' || s[i] == '"' || s[i] == '`' {
+			end := quotedEnd(s, i)
+			b.WriteString(s[i:end])
+			i = end
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// prefixOf returns the first of prefixes that s starts with, or "".
+func prefixOf(s string, prefixes []string) string {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return p
+		}
+	}
+	return ""
+}
+
+// ReadIdentifier reads one identifier from the start of s, skipping leading
+// whitespace. Backtick- and double-quoted identifiers are unquoted. It returns
+// the identifier and the rest of s, or ok=false when s does not start with one.
+func ReadIdentifier(s string) (ident string, rest string, ok bool) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if s == "" {
+		return "", s, false
+	}
+
+	if s[0] == '`' || s[0] == '"' {
+		end := quotedEnd(s, 0)
+		if end > len(s) || end < 2 || s[end-1] != s[0] {
+			return "", s, false
+		}
+		q := string(s[0])
+		inner := s[1 : end-1]
+		inner = strings.ReplaceAll(inner, q+q, q)
+		inner = strings.ReplaceAll(inner, `\`+q, q)
+		inner = strings.ReplaceAll(inner, `\\`, `\`)
+		return inner, s[end:], true
+	}
+
+	i := 0
+	for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+		i++
+	}
+	if i == 0 {
+		return "", s, false
+	}
+	return s[:i], s[i:], true
+}
+
+// ReadQualifiedIdentifier reads a database.name pair from the start of s. A
+// bare name yields an empty database.
+func ReadQualifiedIdentifier(s string) (database string, name string, rest string, ok bool) {
+	first, rest, ok := ReadIdentifier(s)
+	if !ok {
+		return "", "", s, false
+	}
+	if strings.HasPrefix(rest, ".") {
+		second, after, ok := ReadIdentifier(rest[1:])
+		if !ok {
+			return "", "", s, false
+		}
+		return first, second, after, true
+	}
+	return "", first, rest, true
+}
+
+// RedactStringLiterals replaces the content of every single-quoted string
+// literal in s with placeholder, keeping identifiers and the statement shape.
+// Used to log statements that may carry credentials.
+func RedactStringLiterals(s string, placeholder string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '\'':
+			b.WriteString("'" + placeholder + "'")
+			i = quotedEnd(s, i)
+		case '"', '`':
+			end := quotedEnd(s, i)
+			b.WriteString(s[i:end])
+			i = end
+		default:
+			b.WriteByte(s[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// skipSpaceAndComments returns the index of the first byte at or after i that
+// is neither whitespace nor part of a -- or /* */ comment.
+func skipSpaceAndComments(s string, i int) int {
+	for i < len(s) {
+		switch {
+		case unicode.IsSpace(rune(s[i])):
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				return len(s)
+			}
+			i += end + 1
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return len(s)
+			}
+			i += end + 4
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// CheckReadOnlyQuery returns an error unless s is a single SELECT statement,
+// optionally with a WITH clause and a trailing semicolon. It is a guard against
+// configuring DDL or DML by mistake; the server-side readonly setting remains
+// the actual enforcement.
+func CheckReadOnlyQuery(s string) error {
+	start := skipSpaceAndComments(s, 0)
+	for start < len(s) && s[start] == '(' {
+		start = skipSpaceAndComments(s, start+1)
+	}
+	keyword, _, ok := ReadIdentifier(s[start:])
+	if !ok || !(strings.EqualFold(keyword, "SELECT") || strings.EqualFold(keyword, "WITH")) {
+		return fmt.Errorf("only SELECT queries are allowed, got %q", firstWords(s[start:]))
+	}
+
+	for i := start; i < len(s); {
+		switch {
+		case s[i] == '\'' || s[i] == '"' || s[i] == '`':
+			i = quotedEnd(s, i)
+		case strings.HasPrefix(s[i:], "--") || strings.HasPrefix(s[i:], "/*"):
+			i = skipSpaceAndComments(s, i)
+		case s[i] == ';':
+			if skipSpaceAndComments(s, i+1) != len(s) {
+				return fmt.Errorf("only a single statement is allowed")
+			}
+			return nil
+		default:
+			i++
+		}
+	}
+	return nil
+}
+
+func firstWords(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) > 3 {
+		fields = fields[:3]
+	}
+	return strings.Join(fields, " ")
+}
+
+// TrimStatement removes surrounding whitespace and a trailing semicolon.
+func TrimStatement(s string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), ";"))
+}
+
+// BindParameters substitutes ClickHouse query parameters ({name:Type}) in s
+// with literals built from params. Values are sent as string literals cast to
+// the declared type; {name:Identifier} becomes a quoted identifier. Every
+// placeholder must have a value.
+func BindParameters(s string, params map[string]string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == '\'' || s[i] == '"' || s[i] == '`':
+			end := quotedEnd(s, i)
+			b.WriteString(s[i:end])
+			i = end
+		case strings.HasPrefix(s[i:], "--") || strings.HasPrefix(s[i:], "/*"):
+			end := skipSpaceAndComments(s, i)
+			b.WriteString(s[i:end])
+			i = end
+		case s[i] == '{':
+			end := strings.IndexByte(s[i:], '}')
+			name, typ, found := strings.Cut(s[i+1:i+max(end, 1)], ":")
+			name, typ = strings.TrimSpace(name), strings.TrimSpace(typ)
+			if end < 0 || !found || name == "" || typ == "" {
+				b.WriteByte(s[i])
+				i++
+				continue
+			}
+			value, ok := params[name]
+			if !ok {
+				return "", fmt.Errorf("no value for query parameter %q", name)
+			}
+			if typ == "Identifier" {
+				b.WriteString(QuoteIdentifier(value))
+			} else {
+				b.WriteString("CAST(" + QuoteString(value) + " AS " + typ + ")")
+			}
+			i += end + 1
+		default:
+			b.WriteByte(s[i])
+			i++
+		}
+	}
+	return b.String(), nil
+}
+
+// SplitStatements splits a script on semicolons outside quotes and comments.
+// Empty statements (including comment-only ones) are dropped.
+func SplitStatements(script string) []string {
+	statements := make([]string, 0)
+	flush := func(stmt string) {
+		if skipSpaceAndComments(stmt, 0) < len(stmt) {
+			statements = append(statements, strings.TrimSpace(stmt))
+		}
+	}
+
+	start := 0
+	for i := 0; i < len(script); {
+		switch {
+		case script[i] == '\'' || script[i] == '"' || script[i] == '`':
+			i = quotedEnd(script, i)
+		case strings.HasPrefix(script[i:], "--") || strings.HasPrefix(script[i:], "/*"):
+			i = skipSpaceAndComments(script, i)
+		case script[i] == ';':
+			flush(script[start:i])
+			i++
+			start = i
+		default:
+			i++
+		}
+	}
+	flush(script[start:])
+	return statements
+}
@@ -0,0 +1,151 @@
+package sql
+
+import "testing"
+
+func TestEscapeSingleQuote(t *testing.T) {
+	if got, want := EscapeSingleQuote(`it's a \ test`), `it\'s a \\ test`; got != want {
+		t.Errorf("EscapeSingleQuote() = %q; want %q", got, want)
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{in: "plain", want: "`plain`"},
+		{in: "a`b", want: "`a\\`b`"},
+		{in: `ends\`, want: "`ends\\\\`"},
+		{in: "a\\`b", want: "`a\\\\\\`b`"},
+	}
+	for _, tt := range tests {
+		if got := QuoteIdentifier(tt.in); got != tt.want {
+			t.Errorf("QuoteIdentifier(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+	// The quoted form reads back as the original name.
+	for _, tt := range tests {
+		if got, rest, ok := ReadIdentifier(QuoteIdentifier(tt.in)); !ok || got != tt.in || rest != "" {
+			t.Errorf("ReadIdentifier(QuoteIdentifier(%q)) = %q, %q, %v", tt.in, got, rest, ok)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "parentheses added around AND operands", a: "tenant_id='a' and x>1", b: "(tenant_id = 'a') AND (x > 1)", want: true},
+		{name: "parentheses added by ClickHouse", a: "a = 1 AND b = 2", b: "(a = 1) AND (b = 2)", want: true},
+		{name: "nested AND and OR", a: "a = 1 OR b = 2 AND c = 3", b: "(a = 1) OR ((b = 2) AND (c = 3))", want: true},
+		{name: "grouping parentheses are significant", a: "(a OR b) AND c", b: "a OR b AND c", want: false},
+		{name: "parentheses under NOT are significant", a: "NOT (a AND b)", b: "NOT a AND b", want: false},
+		{name: "parentheses on the right of minus are significant", a: "a - (b - c)", b: "a - b - c", want: false},
+		{name: "tuple parentheses are significant", a: "x IN (1, 2)", b: "x IN 1, 2", want: false},
+		{name: "function call parentheses are kept", a: "f(a) = 1", b: "f a = 1", want: false},
+		{name: "whitespace and keyword case", a: "tenant_id='a'  and x>1", b: "tenant_id = 'a' AND x > 1", want: true},
+		{name: "multi-line query", a: "SELECT a,\n  b\nFROM t", b: "select a, b from t", want: true},
+		{name: "string literal content is significant", a: "x = 'A'", b: "x = 'a'", want: false},
+		{name: "whitespace inside literal is significant", a: "x = 'a  b'", b: "x = 'a b'", want: false},
+		{name: "escaped quote in literal", a: `x = 'it\'s'`, b: `x='it\'s'`, want: true},
+		{name: "different identifiers", a: "a = 1", b: "b = 1", want: false},
+		{name: "identifier case is significant", a: "UserID = 1", b: "userid = 1", want: false},
+		{name: "function name case is kept", a: "toDate(ts) = today()", b: "todate(ts) = today()", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equivalent(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equivalent(%q, %q) = %v; want %v (normalized %q vs %q)", tt.a, tt.b, got, tt.want, Normalize(tt.a), Normalize(tt.b))
+			}
+		})
+	}
+}
+
+func TestStripDatabase(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "SELECT a FROM default.t JOIN `default`.u USING (id)", want: "SELECT a FROM t JOIN u USING (id)"},
+		{in: "SELECT a FROM other.t WHERE x = 'default.t'", want: "SELECT a FROM other.t WHERE x = 'default.t'"},
+		{in: "SELECT a FROM my_default.t", want: "SELECT a FROM my_default.t"},
+	}
+
+	for _, tt := range tests {
+		if got := StripDatabase(tt.in, "default"); got != tt.want {
+			t.Errorf("StripDatabase(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRedactStringLiterals(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "CREATE NAMED COLLECTION `c` AS user = 'u', password = 'p\\'w'", want: "CREATE NAMED COLLECTION `c` AS user = '***', password = '***'"},
+		{in: "SELECT 1 FROM `it's`", want: "SELECT 1 FROM `it's`"},
+		{in: `SELECT "a'b" WHERE x = ''`, want: `SELECT "a'b" WHERE x = '***'`},
+	}
+
+	for _, tt := range tests {
+		if got := RedactStringLiterals(tt.in, "***"); got != tt.want {
+			t.Errorf("RedactStringLiterals(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCheckReadOnlyQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr bool
+	}{
+		{query: "SELECT 1"},
+		{query: "  -- tenants\nwith t AS (SELECT 1) select * from t;"},
+		{query: "(SELECT 1) UNION ALL (SELECT 2)"},
+		{query: "SELECT ';' AS x"},
+		{query: "INSERT INTO t SELECT 1", wantErr: true},
+		{query: "DROP TABLE t", wantErr: true},
+		{query: "SELECT 1; DROP TABLE t", wantErr: true},
+		{query: "/* SELECT */ ALTER TABLE t DELETE WHERE 1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := CheckReadOnlyQuery(tt.query); (err != nil) != tt.wantErr {
+			t.Errorf("CheckReadOnlyQuery(%q) = %v; wantErr %v", tt.query, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBindParameters(t *testing.T) {
+	got, err := BindParameters("SELECT * FROM {table:Identifier} WHERE id = {id: UInt64} AND note = '{id:UInt64}'", map[string]string{
+		"table": "events",
+		"id":    "4'2",
+	})
+	if err != nil {
+		t.Fatalf("BindParameters: %v", err)
+	}
+	want := "SELECT * FROM `events` WHERE id = CAST('4\\'2' AS UInt64) AND note = '{id:UInt64}'"
+	if got != want {
+		t.Errorf("BindParameters() = %q; want %q", got, want)
+	}
+
+	if _, err := BindParameters("SELECT {missing:String}", nil); err == nil {
+		t.Error("BindParameters() with a missing value succeeded; want error")
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := "CREATE TABLE a (x String) ENGINE = MergeTree ORDER BY x;\n-- seed; not a statement\nINSERT INTO a VALUES ('1;2');\n\n;"
+	got := SplitStatements(script)
+	want := []string{
+		"CREATE TABLE a (x String) ENGINE = MergeTree ORDER BY x",
+		"-- seed; not a statement\nINSERT INTO a VALUES ('1;2')",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SplitStatements() = %q; want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("statement %d = %q; want %q", i, got[i], want[i])
+		}
+	}
+}
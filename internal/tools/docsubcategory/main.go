@@ -7,7 +7,7 @@
 //
 // It runs after `tfplugindocs generate` (see the Makefile docs targets) and:
 //   - rewrites `subcategory: ""` -> `subcategory: "<HumanName>"` in every
-//     resource and data-source doc, and
+//     resource, data-source and ephemeral-resource doc, and
 //   - writes the sorted list of allowed subcategories to allowedFile, which CI
 //     pins via `tfplugindocs validate --allowed-resource-subcategories-file`.
 package main
@@ -76,8 +76,11 @@ func run() error {
 // docs/resources/service.md); tfplugindocs strips the provider prefix.
 func docPath(kind registry.Kind, typeName string) string {
 	dir := "resources"
-	if kind == registry.KindDataSource {
+	switch kind {
+	case registry.KindDataSource:
 		dir = "data-sources"
+	case registry.KindEphemeralResource:
+		dir = "ephemeral-resources"
 	}
 	base := strings.TrimPrefix(typeName, registry.ProviderTypeName+"_")
 	return filepath.Join("docs", dir, base+".md")
@@ -108,11 +111,11 @@ func stamp(path, sub string) error {
 	return nil
 }
 
-// unstamped returns any resource/data-source doc still carrying an empty
+// unstamped returns any resource/data-source/ephemeral-resource doc still carrying an empty
 // subcategory frontmatter line after stamping.
 func unstamped() ([]string, error) {
 	var stray []string
-	for _, kind := range []string{"resources", "data-sources"} {
+	for _, kind := range []string{"resources", "data-sources", "ephemeral-resources"} {
 		dir := filepath.Join("docs", kind)
 		entries, err := os.ReadDir(dir)
 		if err != nil {
@@ -19,6 +19,7 @@ func TestDocPath(t *testing.T) {
 	}{
 		{registry.KindResource, "clickhouse_service", filepath.Join("docs", "resources", "service.md")},
 		{registry.KindDataSource, "clickhouse_clickstack_alert", filepath.Join("docs", "data-sources", "clickstack_alert.md")},
+		{registry.KindEphemeralResource, "clickhouse_postgres_connection_strings", filepath.Join("docs", "ephemeral-resources", "postgres_connection_strings.md")},
 	}
 	for _, c := range cases {
 		if got := docPath(c.kind, c.typeName); got != c.want {
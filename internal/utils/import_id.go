@@ -0,0 +1,19 @@
+package utils
+
+import "strings"
+
+// SplitServiceScopedImportID splits an import ID of the form
+// service_id/<parts...>/name into want segments, rejecting empty ones. The
+// entity name comes last so it may itself contain "/".
+func SplitServiceScopedImportID(id string, want int) ([]string, bool) {
+	parts := strings.SplitN(id, "/", want)
+	if len(parts) != want {
+		return nil, false
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, false
+		}
+	}
+	return parts, true
+}
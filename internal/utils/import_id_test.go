@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitServiceScopedImportID(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		want   int
+		parts  []string
+		wantOK bool
+	}{
+		{name: "service and name", id: "svc-1/tenant", want: 2, parts: []string{"svc-1", "tenant"}, wantOK: true},
+		{name: "name may contain slashes", id: "svc-1/default/events/a/b", want: 4, parts: []string{"svc-1", "default", "events", "a/b"}, wantOK: true},
+		{name: "missing name", id: "svc-1", want: 2},
+		{name: "empty segment", id: "svc-1//events/p", want: 4},
+		{name: "empty service", id: "/app", want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, ok := SplitServiceScopedImportID(tt.id, tt.want)
+			if ok != tt.wantOK || !reflect.DeepEqual(parts, tt.parts) {
+				t.Errorf("SplitServiceScopedImportID(%q, %d) = %v, %v; want %v, %v", tt.id, tt.want, parts, ok, tt.parts, tt.wantOK)
+			}
+		})
+	}
+}